@@ -74,7 +74,7 @@ func main() {
 			run, res := d.Result()
 			fmt.Printf("  Run %d:\n", run)
 			for i := 0; i < players; i++ {
-				if d.Active[i] {
+				if d.Active.Has(i) {
 					hi := res.Evals[i].Desc(false)
 					fmt.Printf("    %d: %v %v %s\n", i, hi.Best, hi.Unused, hi)
 					if d.Low || d.Double {
@@ -0,0 +1,204 @@
+// Command repl is an interactive dealer, dealing a type street by street.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cardrank/cardrank"
+)
+
+func main() {
+	typ := cardrank.Holdem
+	flag.TextVar(&typ, "type", cardrank.Holdem, "type")
+	players := flag.Int("players", 4, "players")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "seed")
+	flag.Parse()
+	if err := run(os.Stdin, typ, *players, *seed); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(stdin *os.File, typ cardrank.Type, players int, seed int64) error {
+	// note: use a better pseudo-random number generator
+	r := rand.New(rand.NewSource(seed))
+	d := typ.Dealer(r, 3, players)
+	if d == nil {
+		return cardrank.ErrInvalidType
+	}
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	fmt.Printf("------ %s %d ------\n", typ, seed)
+	fmt.Printf("Eval: %l\n", typ)
+	help()
+	s := bufio.NewScanner(stdin)
+	for prompt(d); s.Scan(); prompt(d) {
+		args := strings.Fields(s.Text())
+		cmd := "next"
+		if len(args) != 0 {
+			cmd, args = args[0], args[1:]
+		}
+		switch cmd {
+		case "n", "next":
+			if !d.Next() {
+				results(d)
+				return nil
+			}
+			show(d)
+		case "f", "fold", "deactivate":
+			v, err := positions(d, args)
+			switch {
+			case err != nil:
+				fmt.Printf("error: %v\n", err)
+			case !d.Deactivate(v...):
+				fmt.Println("unable to deactivate positions")
+			case d.Active.Len() < 2 && typ.Max() != 1:
+				fmt.Printf("Result: %d wins uncontested\n", d.Active.First())
+				return nil
+			}
+		case "r", "runs":
+			n, err := strconv.Atoi(strings.Join(args, ""))
+			switch {
+			case err != nil || n < 2:
+				fmt.Println("error: invalid runs")
+			case !d.ChangeRuns(n):
+				fmt.Println("unable to change runs")
+			default:
+				fmt.Printf("Running it %d times\n", n)
+			}
+		case "o", "odds":
+			odds(d)
+		case "s", "show":
+			show(d)
+		case "h", "help", "?":
+			help()
+		case "q", "quit", "exit":
+			return nil
+		default:
+			fmt.Printf("error: unknown command %q\n", cmd)
+		}
+	}
+	return s.Err()
+}
+
+// prompt displays the prompt.
+func prompt(d *cardrank.Dealer) {
+	if id := d.NextId(); id != 0 {
+		fmt.Printf("[%c]> ", id)
+		return
+	}
+	fmt.Print("> ")
+}
+
+// help displays the available commands.
+func help() {
+	fmt.Println("Commands:")
+	fmt.Println("  next (n, enter)     deal the next street")
+	fmt.Println("  fold (f) <pos>...   deactivate positions")
+	fmt.Println("  runs (r) <n>        run it n times")
+	fmt.Println("  odds (o)            show odds for active positions")
+	fmt.Println("  show (s)            show pockets and board")
+	fmt.Println("  help (h)            show commands")
+	fmt.Println("  quit (q)            quit")
+}
+
+// positions parses the positions.
+func positions(d *cardrank.Dealer, args []string) ([]int, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no positions")
+	}
+	var v []int
+	for _, s := range args {
+		i, err := strconv.Atoi(s)
+		if err != nil || i < 0 || d.Count <= i {
+			return nil, fmt.Errorf("invalid position %q", s)
+		}
+		v = append(v, i)
+	}
+	return v, nil
+}
+
+// show displays the current run's pockets, discards, and board.
+func show(d *cardrank.Dealer) {
+	i, run := d.Run()
+	if run == nil {
+		fmt.Println("Nothing dealt")
+		return
+	}
+	fmt.Printf("Run %d: %s\n", i, d)
+	for j := range d.Count {
+		if d.Active.Has(j) {
+			fmt.Printf("  %d: %v\n", j, run.Pockets[j])
+		} else {
+			fmt.Printf("  %d: inactive\n", j)
+		}
+	}
+	if v := d.Discarded(); len(v) != 0 {
+		fmt.Printf("  Discard: %v\n", v)
+	}
+	if 0 < len(run.Hi) {
+		fmt.Printf("  Board: %v\n", run.Hi)
+		if d.Double {
+			fmt.Printf("         %v\n", run.Lo)
+		}
+	}
+}
+
+// odds displays the odds for the active positions.
+func odds(d *cardrank.Dealer) {
+	if !d.HasCalc() {
+		fmt.Println("Odds not available")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	hi, lo, ok := d.Calc(ctx, false)
+	if !ok {
+		fmt.Println("Odds not available")
+		return
+	}
+	for i := range d.Count {
+		if d.Active.Has(i) {
+			fmt.Printf("  %d: %*v", i, i, hi)
+			if lo != nil {
+				fmt.Printf(" / %*v", i, lo)
+			}
+			fmt.Println()
+		}
+	}
+}
+
+// results displays the results for each run.
+func results(d *cardrank.Dealer) {
+	fmt.Println("Showdown:")
+	for d.NextResult() {
+		i, res := d.Result()
+		fmt.Printf("  Run %d:\n", i)
+		for j := range d.Count {
+			if !d.Active.Has(j) {
+				fmt.Printf("    %d: inactive\n", j)
+				continue
+			}
+			hi := res.Evals[j].Desc(false)
+			fmt.Printf("    %d: %v %v %s\n", j, hi.Best, hi.Unused, hi)
+			if d.Low || d.Double {
+				lo := res.Evals[j].Desc(true)
+				fmt.Printf("       %v %v %s\n", lo.Best, lo.Unused, lo)
+			}
+		}
+		hi, lo := res.Win()
+		fmt.Printf("    Result: %S\n", hi)
+		if lo != nil {
+			fmt.Printf("            %S\n", lo)
+		}
+	}
+}
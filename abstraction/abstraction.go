@@ -0,0 +1,164 @@
+// Package abstraction provides card abstraction utilities for game solvers,
+// such as equity histograms and k-means clustering of hands into buckets.
+//
+// A typical hand abstraction pipeline calculates the [Equity] of each hand
+// dealt on a street, and clusters the hands' histograms into buckets using
+// [KMeans], once for each street. The resulting buckets are stored in an
+// [Abstraction] keyed by each hand's canonical [Index], and can be written to
+// and read from a compact binary format, for sharing between training and
+// inference programs.
+package abstraction
+
+import (
+	"fmt"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Hand is a hand dealt on a street.
+type Hand struct {
+	// Pocket is the hand's pocket.
+	Pocket []cardrank.Card
+	// Board is the hand's board.
+	Board []cardrank.Card
+}
+
+// Equity is a hand's Hi equity distribution over the runouts of the board.
+type Equity struct {
+	// Hist is the normalized histogram of hand strength on the final street,
+	// where hand strength is the share of opponent pockets beaten (ties
+	// counting as half).
+	Hist []float64
+	// EHS is the expected hand strength.
+	EHS float64
+	// EHS2 is the expected squared hand strength, which unlike EHS, is
+	// greater for drawing hands.
+	EHS2 float64
+}
+
+// NewEquity calculates a hand's equity distribution with the specified
+// number of histogram bins, enumerating every runout of the board and every
+// opponent pocket for each runout.
+//
+// Returns [cardrank.ErrInvalidPocket] or [cardrank.ErrInvalidBoard] when the
+// pocket or board length is not valid for the type.
+func NewEquity(typ cardrank.Type, pocket, board []cardrank.Card, bins int) (*Equity, error) {
+	return newEquity(typ, pocket, board, bins, 0, nil)
+}
+
+// SampleEquity calculates a hand's equity distribution with the specified
+// number of histogram bins, using the specified number of random runouts of
+// the board. Useful for earlier streets, where enumerating every runout is
+// expensive.
+//
+// Returns [cardrank.ErrInvalidPocket] or [cardrank.ErrInvalidBoard] when the
+// pocket or board length is not valid for the type.
+func SampleEquity(typ cardrank.Type, pocket, board []cardrank.Card, bins, runouts int, r cardrank.Rand) (*Equity, error) {
+	return newEquity(typ, pocket, board, bins, runouts, r)
+}
+
+// newEquity calculates a hand's equity distribution, enumerating all runouts
+// when r is nil.
+func newEquity(typ cardrank.Type, pocket, board []cardrank.Card, bins, runouts int, r cardrank.Rand) (*Equity, error) {
+	switch {
+	case len(pocket) != typ.Pocket():
+		return nil, cardrank.ErrInvalidPocket
+	case typ.Board() < len(board):
+		return nil, cardrank.ErrInvalidBoard
+	case bins < 1:
+		return nil, fmt.Errorf("invalid bins %d", bins)
+	}
+	e := &Equity{
+		Hist: make([]float64, bins),
+	}
+	unused := typ.DeckType().Exclude(pocket, board)
+	k := typ.Board() - len(board)
+	v := make([]cardrank.Card, len(board), typ.Board())
+	copy(v, board)
+	s := newStrength(typ, pocket)
+	var n float64
+	add := func(runout []cardrank.Card) {
+		hs := s.calc(append(v[:len(board)], runout...), unused)
+		e.Hist[min(int(hs*float64(bins)), bins-1)]++
+		e.EHS, e.EHS2, n = e.EHS+hs, e.EHS2+hs*hs, n+1
+	}
+	switch {
+	case r == nil:
+		for g, runout := cardrank.NewCombinGen(unused, k); g.Next(); {
+			add(runout)
+		}
+	default:
+		g := cardrank.NewHandGen(typ.DeckType(), r, pocket, board)
+		for range runouts {
+			add(g.Cards(k))
+		}
+	}
+	if n != 0 {
+		for i := range e.Hist {
+			e.Hist[i] /= n
+		}
+		e.EHS, e.EHS2 = e.EHS/n, e.EHS2/n
+	}
+	return e, nil
+}
+
+// strength calculates a pocket's hand strength.
+type strength struct {
+	typ    cardrank.Type
+	pocket []cardrank.Card
+	hero   *cardrank.Eval
+	opp    *cardrank.Eval
+}
+
+// newStrength creates a hand strength calculator for the pocket.
+func newStrength(typ cardrank.Type, pocket []cardrank.Card) *strength {
+	return &strength{
+		typ:    typ,
+		pocket: pocket,
+		hero:   cardrank.EvalOf(typ),
+		opp:    cardrank.EvalOf(typ),
+	}
+}
+
+// calc returns the share of opponent pockets beaten on the complete board,
+// with ties counting as half.
+func (s *strength) calc(board, unused []cardrank.Card) float64 {
+	s.hero.Reset(s.typ)
+	s.hero.Eval(s.pocket, board)
+	var win, total float64
+	for g, opp := cardrank.NewCombinGen(cardrank.Exclude(unused, board), len(s.pocket)); g.Next(); {
+		s.opp.Reset(s.typ)
+		s.opp.Eval(opp, board)
+		switch c := s.hero.Comp(s.opp, false); {
+		case c < 0:
+			win++
+		case c == 0:
+			win += 0.5
+		}
+		total++
+	}
+	if total == 0 {
+		return 0
+	}
+	return win / total
+}
+
+// Histograms calculates the equity histograms of the hands, with the
+// specified number of bins. Enumerates every runout when runouts is 0,
+// otherwise samples the specified number of runouts for each hand using a
+// random source seeded with seed.
+func Histograms(typ cardrank.Type, hands []Hand, bins, runouts int, seed uint64) ([][]float64, error) {
+	var r cardrank.Rand
+	if runouts != 0 {
+		r = cardrank.NewRNG(seed)
+	}
+	v := make([][]float64, len(hands))
+	for i, h := range hands {
+		e, err := newEquity(typ, h.Pocket, h.Board, bins, runouts, r)
+		if err != nil {
+			return nil, err
+		}
+		v[i] = e.Hist
+	}
+	return v, nil
+}
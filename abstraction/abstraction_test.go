@@ -0,0 +1,83 @@
+package abstraction
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestNewEquity(t *testing.T) {
+	tests := []struct {
+		typ    cardrank.Type
+		pocket string
+		board  string
+		ehs    float64
+		ehs2   float64
+	}{
+		{cardrank.Kuhn, "Ks", "", 1, 1},
+		{cardrank.Kuhn, "Qs", "", 0.5, 0.25},
+		{cardrank.Kuhn, "Js", "", 0, 0},
+		{cardrank.Leduc, "Ks", "", 0.7, 0.5125},
+		{cardrank.Leduc, "Ks", "Qh", 0.625, 0.390625},
+		{cardrank.Holdem, "As Ah", "Ad Ac Ks Qh 2c", 1, 1},
+	}
+	for i, test := range tests {
+		e, err := NewEquity(test.typ, cardrank.Must(test.pocket), cardrank.Must(test.board), 10)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if math.Abs(e.EHS-test.ehs) > 1e-9 || math.Abs(e.EHS2-test.ehs2) > 1e-9 {
+			t.Errorf("test %d expected %f %f, got: %f %f", i, test.ehs, test.ehs2, e.EHS, e.EHS2)
+		}
+		var sum float64
+		for _, f := range e.Hist {
+			sum += f
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("test %d expected histogram sum of 1, got: %f", i, sum)
+		}
+	}
+}
+
+func TestSampleEquity(t *testing.T) {
+	pocket, board := cardrank.Must("Ah Kh"), cardrank.Must("Qh 7h 2c")
+	exp, err := NewEquity(cardrank.Holdem, pocket, board, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	e, err := SampleEquity(cardrank.Holdem, pocket, board, 10, 300, rand.New(rand.NewPCG(1, 2)))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if math.Abs(e.EHS-exp.EHS) > 0.03 || math.Abs(e.EHS2-exp.EHS2) > 0.03 {
+		t.Errorf("expected ~%f %f, got: %f %f", exp.EHS, exp.EHS2, e.EHS, e.EHS2)
+	}
+	if EMD(e.Hist, exp.Hist) > 0.5 {
+		t.Errorf("expected similar histograms, got: %v %v", exp.Hist, e.Hist)
+	}
+}
+
+func TestNewEquityErrors(t *testing.T) {
+	tests := []struct {
+		typ    cardrank.Type
+		pocket string
+		board  string
+		bins   int
+		err    error
+	}{
+		{cardrank.Holdem, "As", "", 10, cardrank.ErrInvalidPocket},
+		{cardrank.Kuhn, "Ks", "Qs", 10, cardrank.ErrInvalidBoard},
+		{cardrank.Kuhn, "Ks", "", 0, nil},
+	}
+	for i, test := range tests {
+		_, err := NewEquity(test.typ, cardrank.Must(test.pocket), cardrank.Must(test.board), test.bins)
+		switch {
+		case err == nil:
+			t.Errorf("test %d expected error", i)
+		case test.err != nil && err != test.err:
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package abstraction
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"slices"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Abstraction is a card abstraction, mapping the canonical index (see
+// [Index]) of hands to buckets for each of a type's streets. Abstractions can
+// be shared between training and inference programs using the binary
+// format (see [Abstraction.MarshalBinary]).
+type Abstraction struct {
+	// Type is the abstraction's type.
+	Type cardrank.Type
+	// Streets are the bucket mappings for each of the type's streets, keyed
+	// by canonical index.
+	Streets []map[uint64]uint32
+}
+
+// New creates a new, empty card abstraction for the type.
+func New(typ cardrank.Type) *Abstraction {
+	a := &Abstraction{
+		Type:    typ,
+		Streets: make([]map[uint64]uint32, len(typ.Streets())),
+	}
+	for i := range a.Streets {
+		a.Streets[i] = make(map[uint64]uint32)
+	}
+	return a
+}
+
+// Set sets the bucket for the hand's pocket and board.
+func (a *Abstraction) Set(pocket, board []cardrank.Card, bucket uint32) error {
+	index, err := Index(a.Type, pocket, board)
+	if err != nil {
+		return err
+	}
+	a.Streets[street(a.Type, pocket, board)][index] = bucket
+	return nil
+}
+
+// Bucket returns the bucket for the hand's pocket and board, and whether the
+// hand was found.
+func (a *Abstraction) Bucket(pocket, board []cardrank.Card) (uint32, bool) {
+	index, err := Index(a.Type, pocket, board)
+	if err != nil {
+		return 0, false
+	}
+	bucket, ok := a.Streets[street(a.Type, pocket, board)][index]
+	return bucket, ok
+}
+
+// AddClusters sets the buckets for the hands to their assigned clusters (see
+// [KMeans.Buckets]).
+func (a *Abstraction) AddClusters(hands []Hand, c *Clusters) error {
+	if len(hands) != len(c.Assign) {
+		return errors.New("mismatched hands and cluster assignments")
+	}
+	for i, h := range hands {
+		if err := a.Set(h.Pocket, h.Board, uint32(c.Assign[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// magic is the abstraction binary format magic.
+const magic = "CRAB"
+
+// version is the abstraction binary format version.
+const version = 1
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface.
+//
+// The binary format is the magic "CRAB", a version byte, the 2 byte type id,
+// and the number of streets as a uvarint. Each street is the number of
+// mappings as a uvarint, followed by each mapping's index (delta encoded
+// from the previous index, in ascending order) and bucket as uvarints. The
+// format ends with a big endian CRC-32 (IEEE) checksum of the preceding
+// bytes.
+func (a *Abstraction) MarshalBinary() ([]byte, error) {
+	buf := append([]byte(magic), version)
+	buf = append(buf, a.Type.Id()...)
+	buf = binary.AppendUvarint(buf, uint64(len(a.Streets)))
+	for _, m := range a.Streets {
+		keys := make([]uint64, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		buf = binary.AppendUvarint(buf, uint64(len(keys)))
+		var prev uint64
+		for _, k := range keys {
+			buf = binary.AppendUvarint(buf, k-prev)
+			buf = binary.AppendUvarint(buf, uint64(m[k]))
+			prev = k
+		}
+	}
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf)), nil
+}
+
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+// Returns [cardrank.ErrInvalidData] when the data is not a valid abstraction.
+func (a *Abstraction) UnmarshalBinary(buf []byte) error {
+	n := len(buf) - 4
+	switch {
+	case n < len(magic)+3,
+		string(buf[:len(magic)]) != magic,
+		buf[len(magic)] != version,
+		binary.BigEndian.Uint32(buf[n:]) != crc32.ChecksumIEEE(buf[:n]):
+		return cardrank.ErrInvalidData
+	}
+	typ, err := cardrank.IdToType(string(buf[len(magic)+1 : len(magic)+3]))
+	if err != nil {
+		return cardrank.ErrInvalidData
+	}
+	r := bytes.NewReader(buf[len(magic)+3 : n])
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count != uint64(len(typ.Streets())) {
+		return cardrank.ErrInvalidData
+	}
+	streets := make([]map[uint64]uint32, count)
+	for i := range streets {
+		entries, err := binary.ReadUvarint(r)
+		if err != nil || uint64(r.Len()) < 2*entries {
+			return cardrank.ErrInvalidData
+		}
+		streets[i] = make(map[uint64]uint32, entries)
+		var index uint64
+		for range entries {
+			delta, err := binary.ReadUvarint(r)
+			if err != nil {
+				return cardrank.ErrInvalidData
+			}
+			bucket, err := binary.ReadUvarint(r)
+			if err != nil || bucket > 1<<32-1 {
+				return cardrank.ErrInvalidData
+			}
+			index += delta
+			streets[i][index] = uint32(bucket)
+		}
+	}
+	if r.Len() != 0 {
+		return cardrank.ErrInvalidData
+	}
+	a.Type, a.Streets = typ, streets
+	return nil
+}
+
+// WriteTo satisfies the [io.WriterTo] interface, writing the abstraction in
+// its binary format (see [Abstraction.MarshalBinary]).
+func (a *Abstraction) WriteTo(w io.Writer) (int64, error) {
+	buf, err := a.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadFrom satisfies the [io.ReaderFrom] interface, reading the abstraction
+// in its binary format (see [Abstraction.MarshalBinary]) until EOF.
+func (a *Abstraction) ReadFrom(r io.Reader) (int64, error) {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return int64(len(buf)), err
+	}
+	return int64(len(buf)), a.UnmarshalBinary(buf)
+}
@@ -0,0 +1,101 @@
+package abstraction
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestAbstraction(t *testing.T) {
+	a := New(cardrank.Holdem)
+	tests := []struct {
+		pocket string
+		board  string
+		bucket uint32
+	}{
+		{"As Ah", "", 7},
+		{"Ah Kh", "", 3},
+		{"Ah Kh", "Qh 7h 2c", 11},
+		{"Ah Kh", "Qh 7h 2c Ts", 1 << 20},
+		{"Ah Kh", "Qh 7h 2c Ts 9d", 0},
+	}
+	for i, test := range tests {
+		if err := a.Set(cardrank.Must(test.pocket), cardrank.Must(test.board), test.bucket); err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+	}
+	if err := a.Set(cardrank.Must("Ah"), nil, 0); err == nil {
+		t.Errorf("expected error")
+	}
+	var buf bytes.Buffer
+	if _, err := a.WriteTo(&buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	data := bytes.Clone(buf.Bytes())
+	b := new(Abstraction)
+	if _, err := b.ReadFrom(&buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if b.Type != cardrank.Holdem || len(b.Streets) != 4 {
+		t.Fatalf("expected Holdem with 4 streets, got: %s %d", b.Type, len(b.Streets))
+	}
+	for i, test := range tests {
+		// suit isomorphic hands share buckets
+		pocket := cardrank.Must(test.pocket)
+		board := cardrank.Must(test.board)
+		for _, v := range [][]cardrank.Card{pocket, board} {
+			for j, c := range v {
+				switch c.Suit() {
+				case cardrank.Heart:
+					v[j] = cardrank.New(c.Rank(), cardrank.Diamond)
+				case cardrank.Diamond:
+					v[j] = cardrank.New(c.Rank(), cardrank.Heart)
+				}
+			}
+		}
+		if bucket, ok := b.Bucket(pocket, board); !ok || bucket != test.bucket {
+			t.Errorf("test %d expected %d, got: %d %t", i, test.bucket, bucket, ok)
+		}
+	}
+	if _, ok := b.Bucket(cardrank.Must("7c 2d"), nil); ok {
+		t.Errorf("expected no bucket")
+	}
+	// corrupt data
+	for i := range data {
+		v := bytes.Clone(data)
+		v[i] ^= 0x01
+		if err := new(Abstraction).UnmarshalBinary(v); err != cardrank.ErrInvalidData {
+			t.Errorf("byte %d expected %v, got: %v", i, cardrank.ErrInvalidData, err)
+		}
+	}
+	if err := new(Abstraction).UnmarshalBinary(data[:3]); err != cardrank.ErrInvalidData {
+		t.Errorf("expected %v, got: %v", cardrank.ErrInvalidData, err)
+	}
+}
+
+func TestAbstractionClusters(t *testing.T) {
+	var hands []Hand
+	for _, c := range cardrank.DeckLeduc.Unshuffled() {
+		hands = append(hands, Hand{Pocket: []cardrank.Card{c}})
+	}
+	c, err := NewKMeans(3).Buckets(cardrank.Leduc, hands, 10, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	a := New(cardrank.Leduc)
+	if err := a.AddClusters(hands, c); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if n := len(a.Streets[0]); n != 3 {
+		t.Errorf("expected 3, got: %d", n)
+	}
+	for i, h := range hands {
+		if bucket, ok := a.Bucket(h.Pocket, nil); !ok || bucket != uint32(c.Assign[i]) {
+			t.Errorf("expected %d, got: %d %t", c.Assign[i], bucket, ok)
+		}
+	}
+	if err := a.AddClusters(hands[:1], c); err == nil {
+		t.Errorf("expected error")
+	}
+}
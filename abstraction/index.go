@@ -0,0 +1,84 @@
+package abstraction
+
+import (
+	"slices"
+
+	"github.com/cardrank/cardrank"
+	"github.com/cardrank/cardrank/combin"
+)
+
+// maxIndexCards is the maximum number of cards in a canonical index.
+const maxIndexCards = 10
+
+// Index returns the canonical index for a hand's pocket and board, identical
+// for all hands equivalent under suit isomorphism (ie, AhKh with a Qh7h2c
+// board has the same index as AsKs with a Qs7s2d board). Cards dealt on
+// different streets are not interchangeable, and are kept distinct.
+//
+// The index packs the deck index (see [cardrank.DeckType.Index]) of each
+// card into 6 bits, after relabeling suits to minimize the index, and
+// supports hands of up to 10 cards. Returns [cardrank.ErrInvalidCard] when a
+// card is not in the type's deck, or [cardrank.ErrInvalidBoard] when the
+// hand does not have the cards of a complete street, or has more than 10
+// cards.
+func Index(typ cardrank.Type, pocket, board []cardrank.Card) (uint64, error) {
+	if street(typ, pocket, board) == -1 || maxIndexCards < len(pocket)+len(board) {
+		return 0, cardrank.ErrInvalidBoard
+	}
+	deck := typ.DeckType()
+	for _, c := range slices.Concat(pocket, board) {
+		if deck.Index(c) == -1 {
+			return 0, cardrank.ErrInvalidCard
+		}
+	}
+	// group pocket and board cards by street
+	groups := [][]cardrank.Card{pocket}
+	for _, street := range typ.Streets() {
+		if n := street.Board; n != 0 && n <= len(board) {
+			groups, board = append(groups, board[:n]), board[n:]
+		}
+	}
+	var suits []cardrank.Suit
+	for _, c := range deck.Unshuffled() {
+		if !slices.Contains(suits, c.Suit()) {
+			suits = append(suits, c.Suit())
+		}
+	}
+	var index uint64
+	first := true
+	v := make([]int, 0, maxIndexCards)
+	for perm := range combin.Permutations(suits, len(suits)) {
+		var i uint64
+		for _, group := range groups {
+			v = v[:0]
+			for _, c := range group {
+				v = append(v, deck.Index(cardrank.New(c.Rank(), perm[slices.Index(suits, c.Suit())])))
+			}
+			slices.Sort(v)
+			for _, n := range v {
+				i = i<<6 | uint64(n+1)
+			}
+		}
+		if first || i < index {
+			index, first = i, false
+		}
+	}
+	return index, nil
+}
+
+// street returns the street for the pocket and board, based on the count of
+// cards dealt by each of the type's streets, or -1 when the cards do not
+// complete a street.
+func street(typ cardrank.Type, pocket, board []cardrank.Card) int {
+	var p, b int
+	for i, street := range typ.Streets() {
+		p, b = p+street.Pocket, b+street.Board
+		switch {
+		case p == len(pocket) && b == len(board):
+			return i
+		case len(pocket) < p || len(board) < b:
+			return -1
+		}
+	}
+	return -1
+}
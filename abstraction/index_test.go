@@ -0,0 +1,87 @@
+package abstraction
+
+import (
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		typ  cardrank.Type
+		a, b string
+		same bool
+	}{
+		{cardrank.Holdem, "Ah Kh", "As Ks", true},
+		{cardrank.Holdem, "Ah Kh", "Kd Ad", true},
+		{cardrank.Holdem, "Ah Kh", "Ah Kd", false},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c", "As Ks Qs 7s 2d", true},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c", "As Ks Qs 7s 2s", false},
+		{cardrank.Holdem, "Ah Kd Qh 7d 2c", "Ad Kh Qd 7h 2s", true},
+		{cardrank.Holdem, "Ah Kd Qh 7d 2c", "Ah Kd Qd 7h 2c", false},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c 3s", "Ah Kh Qh 7h 3s 2c", false},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c 3s", "Ah Kh Qh 7h 2c 3d", true},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c 3s", "Ah Kh Qh 7h 3c 2s", false},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c 3s 4d", "Ah Kh Qh 7h 2c 3d 4s", true},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c 3s 4d", "Ah Kh Qh 7h 2c 3s 4c", false},
+		{cardrank.Holdem, "Ah Kh Qh 7h 2c 3s 4d", "Ah Kh Qh 7h 2c 4d 3s", false},
+		{cardrank.Omaha, "As Ah Ks Kh", "Ad Ac Kd Kc", true},
+		{cardrank.Omaha, "As Ah Ks Kh", "As Ah Kh Kd", false},
+		{cardrank.Leduc, "Ks Qh", "Kh Qs", true},
+		{cardrank.Leduc, "Ks Qh", "Ks Qs", false},
+	}
+	for i, test := range tests {
+		split := func(s string) ([]cardrank.Card, []cardrank.Card) {
+			v := cardrank.Must(s)
+			n := test.typ.Streets()[0].Pocket
+			return v[:n], v[n:]
+		}
+		p0, b0 := split(test.a)
+		p1, b1 := split(test.b)
+		i0, err := Index(test.typ, p0, b0)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		i1, err := Index(test.typ, p1, b1)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if (i0 == i1) != test.same {
+			t.Errorf("test %d expected same %t, got: %d %d", i, test.same, i0, i1)
+		}
+	}
+}
+
+func TestIndexCount(t *testing.T) {
+	// there are 169 canonical starting pockets
+	m := make(map[uint64]bool)
+	for g, v := cardrank.NewCombinGen(cardrank.DeckFrench.Unshuffled(), 2); g.Next(); {
+		i, err := Index(cardrank.Holdem, v, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		m[i] = true
+	}
+	if n := len(m); n != 169 {
+		t.Errorf("expected 169, got: %d", n)
+	}
+}
+
+func TestIndexErrors(t *testing.T) {
+	tests := []struct {
+		typ    cardrank.Type
+		pocket string
+		board  string
+		err    error
+	}{
+		{cardrank.Holdem, "As", "", cardrank.ErrInvalidBoard},
+		{cardrank.Holdem, "As Ks", "Qs Js", cardrank.ErrInvalidBoard},
+		{cardrank.Short, "As 2s", "", cardrank.ErrInvalidCard},
+		{cardrank.OmahaSix, "As Ks Qs Js Ts 9s", "8s 7s 6s 5s 4s", cardrank.ErrInvalidBoard},
+	}
+	for i, test := range tests {
+		if _, err := Index(test.typ, cardrank.Must(test.pocket), cardrank.Must(test.board)); err != test.err {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
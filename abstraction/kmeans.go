@@ -0,0 +1,272 @@
+package abstraction
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Distance is a distance func between two points.
+type Distance func(a, b []float64) float64
+
+// Euclidean returns the Euclidean distance between a and b.
+func Euclidean(a, b []float64) float64 {
+	var d float64
+	for i := range a {
+		d += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(d)
+}
+
+// EMD returns the earth mover's distance between the histograms a and b,
+// having equally spaced bins. Preferred for equity histograms, as it
+// accounts for the distance between bins.
+func EMD(a, b []float64) float64 {
+	var d, carry float64
+	for i := range a {
+		carry += a[i] - b[i]
+		d += math.Abs(carry)
+	}
+	return d
+}
+
+// KMeans is a k-means clusterer, using k-means++ initialization.
+type KMeans struct {
+	k     int
+	iters int
+	seed  uint64
+	dist  Distance
+}
+
+// NewKMeans creates a k-means clusterer for k clusters.
+func NewKMeans(k int, opts ...Option) *KMeans {
+	km := &KMeans{
+		k:     k,
+		iters: 100,
+		seed:  1,
+		dist:  EMD,
+	}
+	for _, o := range opts {
+		o(km)
+	}
+	return km
+}
+
+// Cluster clusters the points, returning the clusters. Results are
+// deterministic for the same points and seed.
+func (km *KMeans) Cluster(points [][]float64) (*Clusters, error) {
+	switch {
+	case km.k < 1:
+		return nil, fmt.Errorf("invalid k %d", km.k)
+	case len(points) < km.k:
+		return nil, fmt.Errorf("expected at least %d points, got: %d", km.k, len(points))
+	}
+	for _, p := range points {
+		if len(p) != len(points[0]) {
+			return nil, errors.New("mismatched point dimensions")
+		}
+	}
+	c := &Clusters{
+		Centroids: km.init(points),
+		Assign:    make([]int, len(points)),
+		dist:      km.dist,
+	}
+	for i := range c.Assign {
+		c.Assign[i] = -1
+	}
+	counts := make([]int, km.k)
+	for range km.iters {
+		// assign
+		var changed bool
+		for i, p := range points {
+			if j := c.Nearest(p); j != c.Assign[i] {
+				c.Assign[i], changed = j, true
+			}
+		}
+		if !changed {
+			break
+		}
+		// update
+		clear(counts)
+		for _, v := range c.Centroids {
+			clear(v)
+		}
+		for i, p := range points {
+			j := c.Assign[i]
+			for k := range p {
+				c.Centroids[j][k] += p[k]
+			}
+			counts[j]++
+		}
+		for j, v := range c.Centroids {
+			for k := range v {
+				v[k] /= float64(max(counts[j], 1))
+			}
+		}
+		// reseed empty clusters with the point farthest from its centroid
+		for j, v := range c.Centroids {
+			if counts[j] == 0 {
+				i := c.farthest(points)
+				copy(v, points[i])
+				c.Assign[i] = j
+			}
+		}
+	}
+	return c, nil
+}
+
+// Buckets clusters the equity histograms of the hands dealt on a street (see
+// [Histograms]), returning the clusters with each hand's assigned bucket.
+// Call once for each street to build a per-street hand abstraction.
+func (km *KMeans) Buckets(typ cardrank.Type, hands []Hand, bins, runouts int) (*Clusters, error) {
+	for _, h := range hands {
+		if len(h.Board) != len(hands[0].Board) {
+			return nil, errors.New("mismatched board lengths")
+		}
+	}
+	points, err := Histograms(typ, hands, bins, runouts, km.seed)
+	if err != nil {
+		return nil, err
+	}
+	return km.Cluster(points)
+}
+
+// init returns the initial centroids using k-means++ seeding.
+func (km *KMeans) init(points [][]float64) [][]float64 {
+	r := cardrank.NewRNG(km.seed)
+	centroids := make([][]float64, 0, km.k)
+	centroids = append(centroids, slices.Clone(points[r.IntN(len(points))]))
+	d := make([]float64, len(points))
+	for i := range d {
+		d[i] = math.Inf(1)
+	}
+	for len(centroids) < km.k {
+		var total float64
+		last := centroids[len(centroids)-1]
+		for i, p := range points {
+			if v := km.dist(p, last); v*v < d[i] {
+				d[i] = v * v
+			}
+			total += d[i]
+		}
+		i := 0
+		if 0 < total {
+			f := r.Float64() * total
+			for ; i < len(d)-1 && d[i] <= f; i++ {
+				f -= d[i]
+			}
+		} else {
+			i = r.IntN(len(points))
+		}
+		centroids = append(centroids, slices.Clone(points[i]))
+	}
+	return centroids
+}
+
+// Clusters are clustered points.
+type Clusters struct {
+	// Centroids are the cluster centroids.
+	Centroids [][]float64
+	// Assign is the assigned cluster (ie, bucket) for each point.
+	Assign []int
+	dist   Distance
+}
+
+// Nearest returns the nearest centroid for the point.
+func (c *Clusters) Nearest(p []float64) int {
+	dist := c.dist
+	if dist == nil {
+		dist = EMD
+	}
+	n, d := 0, math.Inf(1)
+	for i, v := range c.Centroids {
+		if x := dist(p, v); x < d {
+			n, d = i, x
+		}
+	}
+	return n
+}
+
+// farthest returns the point farthest from its assigned centroid.
+func (c *Clusters) farthest(points [][]float64) int {
+	n, d := 0, -1.0
+	for i, p := range points {
+		if x := c.dist(p, c.Centroids[c.Assign[i]]); d < x {
+			n, d = i, x
+		}
+	}
+	return n
+}
+
+// WriteCentroids writes the centroids to w as CSV, one centroid per line.
+func (c *Clusters) WriteCentroids(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	for _, v := range c.Centroids {
+		rec := make([]string, len(v))
+		for i, f := range v {
+			rec[i] = strconv.FormatFloat(f, 'g', -1, 64)
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCentroids reads centroids written by [Clusters.WriteCentroids] from r,
+// returning clusters without assignments, for assigning buckets to new points
+// with [Clusters.Nearest]. Uses [EMD] when dist is nil.
+func ReadCentroids(r io.Reader, dist Distance) (*Clusters, error) {
+	recs, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	c := &Clusters{
+		Centroids: make([][]float64, len(recs)),
+		dist:      dist,
+	}
+	for i, rec := range recs {
+		c.Centroids[i] = make([]float64, len(rec))
+		for j, s := range rec {
+			if c.Centroids[i][j], err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// Option is a k-means clusterer option.
+type Option func(*KMeans)
+
+// WithIterations is a k-means clusterer option to set the maximum number of
+// iterations. Defaults to 100.
+func WithIterations(iters int) Option {
+	return func(km *KMeans) {
+		km.iters = iters
+	}
+}
+
+// WithSeed is a k-means clusterer option to set the random seed used for
+// initialization and for sampling runouts (see [KMeans.Buckets]). Defaults to
+// 1.
+func WithSeed(seed uint64) Option {
+	return func(km *KMeans) {
+		km.seed = seed
+	}
+}
+
+// WithDistance is a k-means clusterer option to set the distance func.
+// Defaults to [EMD].
+func WithDistance(dist Distance) Option {
+	return func(km *KMeans) {
+		km.dist = dist
+	}
+}
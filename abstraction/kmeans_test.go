@@ -0,0 +1,129 @@
+package abstraction
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b []float64
+		emd  float64
+		l2   float64
+	}{
+		{[]float64{1, 0, 0}, []float64{1, 0, 0}, 0, 0},
+		{[]float64{1, 0, 0}, []float64{0, 1, 0}, 1, math.Sqrt2},
+		{[]float64{1, 0, 0}, []float64{0, 0, 1}, 2, math.Sqrt2},
+		{[]float64{0.5, 0, 0.5}, []float64{0, 1, 0}, 1, math.Sqrt(1.5)},
+	}
+	for i, test := range tests {
+		if d := EMD(test.a, test.b); math.Abs(d-test.emd) > 1e-9 {
+			t.Errorf("test %d expected emd %f, got: %f", i, test.emd, d)
+		}
+		if d := Euclidean(test.a, test.b); math.Abs(d-test.l2) > 1e-9 {
+			t.Errorf("test %d expected euclidean %f, got: %f", i, test.l2, d)
+		}
+	}
+}
+
+func TestKMeans(t *testing.T) {
+	var points [][]float64
+	for i := range 30 {
+		f := float64(i%10) / 100
+		switch i / 10 {
+		case 0:
+			points = append(points, []float64{f, 0})
+		case 1:
+			points = append(points, []float64{10 + f, 10})
+		case 2:
+			points = append(points, []float64{f, 20})
+		}
+	}
+	for _, dist := range []Distance{EMD, Euclidean} {
+		km := NewKMeans(3, WithSeed(7), WithDistance(dist))
+		c, err := km.Cluster(points)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		for i := range points {
+			if exp := c.Assign[i/10*10]; c.Assign[i] != exp {
+				t.Errorf("expected point %d in bucket %d, got: %d", i, exp, c.Assign[i])
+			}
+		}
+		if c.Assign[0] == c.Assign[10] || c.Assign[10] == c.Assign[20] || c.Assign[0] == c.Assign[20] {
+			t.Errorf("expected distinct buckets, got: %v", c.Assign)
+		}
+		// deterministic
+		d, err := km.Cluster(points)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if !slices.Equal(c.Assign, d.Assign) {
+			t.Errorf("expected %v, got: %v", c.Assign, d.Assign)
+		}
+	}
+	if _, err := NewKMeans(0).Cluster(points); err == nil {
+		t.Errorf("expected error")
+	}
+	if _, err := NewKMeans(31).Cluster(points); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestCentroids(t *testing.T) {
+	c := &Clusters{
+		Centroids: [][]float64{{0.25, 0.75}, {1, 0}, {0.1, 0.9}},
+	}
+	var buf bytes.Buffer
+	if err := c.WriteCentroids(&buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if s, exp := buf.String(), "0.25,0.75\n1,0\n0.1,0.9\n"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	d, err := ReadCentroids(&buf, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for i, v := range c.Centroids {
+		if !slices.Equal(v, d.Centroids[i]) {
+			t.Errorf("expected %v, got: %v", v, d.Centroids[i])
+		}
+	}
+	if n := d.Nearest([]float64{0.9, 0.1}); n != 1 {
+		t.Errorf("expected 1, got: %d", n)
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	var hands []Hand
+	for _, c := range cardrank.DeckLeduc.Unshuffled() {
+		hands = append(hands, Hand{Pocket: []cardrank.Card{c}})
+	}
+	c, err := NewKMeans(3).Buckets(cardrank.Leduc, hands, 10, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// suit isomorphic pockets share a bucket
+	m := make(map[cardrank.Rank]int)
+	for i, h := range hands {
+		r := h.Pocket[0].Rank()
+		if b, ok := m[r]; ok && b != c.Assign[i] {
+			t.Errorf("expected %s in bucket %d, got: %d", h.Pocket[0], b, c.Assign[i])
+		}
+		m[r] = c.Assign[i]
+	}
+	if len(m) != 3 || m[cardrank.King] == m[cardrank.Queen] || m[cardrank.Queen] == m[cardrank.Jack] {
+		t.Errorf("expected distinct buckets, got: %v", m)
+	}
+	if _, err := NewKMeans(3).Buckets(cardrank.Leduc, append(hands, Hand{
+		Pocket: cardrank.Must("Ks"),
+		Board:  cardrank.Must("Qs"),
+	}), 10, 0); err == nil {
+		t.Errorf("expected error")
+	}
+}
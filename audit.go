@@ -0,0 +1,248 @@
+package cardrank
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// AuditKind is an audit log entry kind.
+type AuditKind uint8
+
+// Audit log entry kinds.
+const (
+	// AuditCommit is a commitment to a deck's order.
+	AuditCommit AuditKind = iota
+	// AuditDraw is a draw of cards from a deck.
+	AuditDraw
+	// AuditReveal is a reveal of a committed deck's order.
+	AuditReveal
+)
+
+// String satisfies the [fmt.Stringer] interface.
+func (kind AuditKind) String() string {
+	switch kind {
+	case AuditCommit:
+		return "commit"
+	case AuditDraw:
+		return "draw"
+	case AuditReveal:
+		return "reveal"
+	}
+	return fmt.Sprintf("AuditKind(%d)", int(kind))
+}
+
+// AuditEntry is an audit log entry.
+type AuditEntry struct {
+	// Seq is the entry's sequence number.
+	Seq int
+	// Kind is the entry kind.
+	Kind AuditKind
+	// Pos is the deck position of the first drawn card of a draw.
+	Pos int
+	// Cards are the drawn cards of a draw, or the deck's cards of a reveal.
+	Cards []Card
+	// Data is the commitment of a commit, or the salt of a reveal.
+	Data []byte
+	// Prev is the previous entry's hash.
+	Prev [sha256.Size]byte
+	// Hash is the entry's hash, chaining the previous entry's hash with the
+	// entry's contents.
+	Hash [sha256.Size]byte
+}
+
+// hash returns the entry's hash.
+func (entry AuditEntry) hash() [sha256.Size]byte {
+	h := sha256.New()
+	buf := binary.BigEndian.AppendUint64(entry.Prev[:], uint64(entry.Seq))
+	buf = append(buf, byte(entry.Kind))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(entry.Pos))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(len(entry.Cards)))
+	for _, c := range entry.Cards {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(c))
+	}
+	buf = binary.BigEndian.AppendUint64(buf, uint64(len(entry.Data)))
+	_, _ = h.Write(append(buf, entry.Data...))
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// AuditLog is a hash-chained log of deck commitments, draws, and reveals,
+// allowing an operator to prove after a hand that the hand was dealt from a
+// committed deck order.
+//
+// Prior to dealing, the deck's order is committed by publishing the hash of a
+// secret salt and the deck's cards (see [AuditLog.Commit]). Draws from the
+// deck are recorded as they are made (see [Deck.Audit]). After the hand, the
+// salt and deck's cards are revealed (see [AuditLog.Reveal]), allowing anyone
+// to verify the commitment, and that each draw matches the deck (see
+// [AuditLog.Verify]). Each entry includes the previous entry's hash, so the
+// log cannot be altered without changing all subsequent hashes.
+//
+// Safe for concurrent use.
+type AuditLog struct {
+	mu      sync.Mutex
+	entries []AuditEntry
+}
+
+// NewAuditLog creates a new audit log.
+func NewAuditLog() *AuditLog {
+	return &AuditLog{}
+}
+
+// Entries returns a copy of the log's entries.
+func (l *AuditLog) Entries() []AuditEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.entries)
+}
+
+// Head returns the hash of the last entry, or the zero hash when the log is
+// empty. Publishing the head commits to all entries in the log.
+func (l *AuditLog) Head() [sha256.Size]byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if n := len(l.entries); n != 0 {
+		return l.entries[n-1].Hash
+	}
+	return [sha256.Size]byte{}
+}
+
+// Commit adds a commitment to the deck's cards and the secret salt to the
+// log, returning the commitment. The salt should be random, and kept secret
+// until the deck is revealed.
+func (l *AuditLog) Commit(cards []Card, salt []byte) []byte {
+	commitment := AuditCommitment(cards, salt)
+	l.add(AuditEntry{
+		Kind: AuditCommit,
+		Data: commitment,
+	})
+	return commitment
+}
+
+// Draw adds a draw of the cards from the deck position to the log.
+func (l *AuditLog) Draw(pos int, cards []Card) {
+	l.add(AuditEntry{
+		Kind:  AuditDraw,
+		Pos:   pos,
+		Cards: slices.Clone(cards),
+	})
+}
+
+// Reveal adds a reveal of the committed deck's cards and secret salt to the
+// log.
+func (l *AuditLog) Reveal(cards []Card, salt []byte) {
+	l.add(AuditEntry{
+		Kind:  AuditReveal,
+		Cards: slices.Clone(cards),
+		Data:  slices.Clone(salt),
+	})
+}
+
+// add adds the entry to the log.
+func (l *AuditLog) add(entry AuditEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry.Seq = len(l.entries)
+	if entry.Seq != 0 {
+		entry.Prev = l.entries[entry.Seq-1].Hash
+	}
+	entry.Hash = entry.hash()
+	l.entries = append(l.entries, entry)
+}
+
+// Verify verifies the log (see [VerifyAudit]).
+func (l *AuditLog) Verify() error {
+	return VerifyAudit(l.Entries())
+}
+
+// VerifyAudit verifies the audit log entries, checking that each entry's hash
+// is chained to the previous entry, that each reveal matches the prior
+// commitment, and that each draw after a commitment matches the revealed
+// deck's cards at the draw's position. Draws must be followed by a reveal of
+// the prior commitment.
+//
+// Returns [ErrInvalidAudit] wrapped with the sequence number of the first
+// invalid entry.
+func VerifyAudit(entries []AuditEntry) error {
+	var prev [sha256.Size]byte
+	commit := -1
+	for i, entry := range entries {
+		switch {
+		case entry.Seq != i, entry.Prev != prev, entry.hash() != entry.Hash:
+			return fmt.Errorf("entry %d: %w: broken chain", i, ErrInvalidAudit)
+		case entry.Kind == AuditCommit:
+			if commit != -1 {
+				return fmt.Errorf("entry %d: %w: unrevealed commit %d", i, ErrInvalidAudit, commit)
+			}
+			commit = i
+		case entry.Kind == AuditDraw && commit == -1:
+			return fmt.Errorf("entry %d: %w: draw without commit", i, ErrInvalidAudit)
+		case entry.Kind == AuditReveal:
+			if commit == -1 || !bytes.Equal(entries[commit].Data, AuditCommitment(entry.Cards, entry.Data)) {
+				return fmt.Errorf("entry %d: %w: reveal does not match commit", i, ErrInvalidAudit)
+			}
+			for _, draw := range entries[commit+1 : i] {
+				if draw.Kind != AuditDraw {
+					continue
+				}
+				if draw.Pos < 0 || len(entry.Cards) < draw.Pos+len(draw.Cards) || !slices.Equal(draw.Cards, entry.Cards[draw.Pos:draw.Pos+len(draw.Cards)]) {
+					return fmt.Errorf("entry %d: %w: draw does not match deck", draw.Seq, ErrInvalidAudit)
+				}
+			}
+			commit = -1
+		case AuditReveal < entry.Kind:
+			return fmt.Errorf("entry %d: %w: unknown kind %d", i, ErrInvalidAudit, entry.Kind)
+		}
+		prev = entry.Hash
+	}
+	if commit != -1 {
+		return fmt.Errorf("entry %d: %w: unrevealed commit", commit, ErrInvalidAudit)
+	}
+	return nil
+}
+
+// AuditCommitment returns the SHA-256 commitment for the deck's cards and
+// the salt.
+func AuditCommitment(cards []Card, salt []byte) []byte {
+	h := sha256.New()
+	buf := binary.BigEndian.AppendUint64(nil, uint64(len(salt)))
+	buf = append(buf, salt...)
+	for _, c := range cards {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(c))
+	}
+	_, _ = h.Write(buf)
+	return h.Sum(nil)
+}
+
+// Audit commits the deck's cards with the salt to the audit log, recording
+// all subsequent draws from the deck to the log. After dealing, reveal the
+// deck's cards (see [Deck.All]) and the salt with [AuditLog.Reveal].
+func (d *Deck) Audit(log *AuditLog, salt []byte) []byte {
+	d.audit = log
+	return log.Commit(d.All(), salt)
+}
+
+// Commit returns the commitment for the deck's cards and the secret salt (see
+// [AuditCommitment]), for publishing prior to dealing. After the hand, reveal
+// the salt and deck's cards, allowing anyone to check the deck's order with
+// [Deck.Verify]. The salt should be random, such as the seed used to shuffle
+// the deck with [DeckType.ShuffleSeed], and kept secret until the deck is
+// revealed.
+func (d *Deck) Commit(salt []byte) []byte {
+	return AuditCommitment(d.All(), salt)
+}
+
+// Verify verifies the deck's cards and the revealed salt match the
+// commitment (see [Deck.Commit]). Returns [ErrInvalidAudit] when the
+// commitment does not match.
+func (d *Deck) Verify(commitment, salt []byte) error {
+	if !bytes.Equal(commitment, d.Commit(salt)) {
+		return fmt.Errorf("%w: deck does not match commit", ErrInvalidAudit)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package cardrank
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+	salt := []byte("salt")
+	newDeal := func() (*AuditLog, *Deck) {
+		log, d := NewAuditLog(), DeckFrench.Shuffle(rand.New(rand.NewPCG(1, 2)), 1)
+		d.Audit(log, salt)
+		dealer := NewDealer(Holdem.Desc(), d, 4)
+		for dealer.Next() {
+		}
+		log.Reveal(d.All(), salt)
+		return log, d
+	}
+	log, _ := newDeal()
+	if err := log.Verify(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	entries := log.Entries()
+	if n, exp := len(entries), 1+8+3*2+1; n != exp {
+		t.Fatalf("expected %d entries, got: %d", exp, n)
+	}
+	if log.Head() != entries[len(entries)-1].Hash {
+		t.Errorf("expected head to be last hash")
+	}
+	tests := []struct {
+		f   func([]AuditEntry) []AuditEntry
+		exp bool
+	}{
+		{func(v []AuditEntry) []AuditEntry { return v }, true},
+		{func(v []AuditEntry) []AuditEntry { return v[:len(v)-1] }, false},
+		{func(v []AuditEntry) []AuditEntry { return v[1:] }, false},
+		{func(v []AuditEntry) []AuditEntry { v[3].Cards[0] = v[4].Cards[0]; return v }, false},
+		{func(v []AuditEntry) []AuditEntry { v[0].Data[0]++; return v }, false},
+		{func(v []AuditEntry) []AuditEntry { v[2], v[3] = v[3], v[2]; return v }, false},
+		{func(v []AuditEntry) []AuditEntry {
+			// rewrite a draw, rehashing the chain
+			v[3].Cards[0] = v[len(v)-1].Cards[51]
+			for i := 3; i < len(v); i++ {
+				v[i].Prev = v[i-1].Hash
+				v[i].Hash = v[i].hash()
+			}
+			return v
+		}, false},
+		{func(v []AuditEntry) []AuditEntry {
+			// reveal a different salt, rehashing the chain
+			v[len(v)-1].Data = []byte("other")
+			v[len(v)-1].Hash = v[len(v)-1].hash()
+			return v
+		}, false},
+	}
+	for i, test := range tests {
+		log, _ := newDeal()
+		err := VerifyAudit(test.f(log.Entries()))
+		switch {
+		case test.exp && err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case !test.exp && !errors.Is(err, ErrInvalidAudit):
+			t.Errorf("test %d expected %v, got: %v", i, ErrInvalidAudit, err)
+		}
+	}
+}
+
+func TestAuditLogShuffled(t *testing.T) {
+	// shuffling after committing changes the dealt cards
+	log, d := NewAuditLog(), DeckFrench.New()
+	d.Audit(log, []byte("salt"))
+	v := d.All()
+	d.Shuffle(rand.New(rand.NewPCG(1, 2)), 1)
+	d.Draw(5)
+	log.Reveal(v, []byte("salt"))
+	if err := log.Verify(); !errors.Is(err, ErrInvalidAudit) {
+		t.Errorf("expected %v, got: %v", ErrInvalidAudit, err)
+	}
+}
+
+func TestDeckCommit(t *testing.T) {
+	var seed [32]byte
+	seed[0] = 1
+	d := DeckFrench.ShuffleSeed(seed)
+	commitment := d.Commit(seed[:])
+	d.Draw(10)
+	if err := d.Verify(commitment, seed[:]); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	// the revealed deck can be reproduced from the seed
+	if err := DeckFrench.ShuffleSeed(seed).Verify(commitment, seed[:]); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	tests := []struct {
+		d    *Deck
+		salt []byte
+	}{
+		{d, []byte("other")},
+		{DeckFrench.New(), seed[:]},
+		{DeckFrench.ShuffleSeed([32]byte{}), seed[:]},
+		{DeckOf(d.All()[1:]...), seed[:]},
+	}
+	for i, test := range tests {
+		if err := test.d.Verify(commitment, test.salt); !errors.Is(err, ErrInvalidAudit) {
+			t.Errorf("test %d expected %v, got: %v", i, ErrInvalidAudit, err)
+		}
+	}
+}
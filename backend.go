@@ -0,0 +1,97 @@
+package cardrank
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// twoPlusTwoLen is the number of entries in the Two-Plus-Two lookup table.
+const twoPlusTwoLen = 32487834
+
+// SetEvalBackend sets the 7 card backend used by Cactus evals (see
+// [NewHybridEval]), re-creating the evals for all registered types. The
+// backend must return the same rank as the best [RankCactus] of any 5 of the 7
+// cards. Pass nil to rank 7 cards using only [RankCactus].
+//
+// The Two-Plus-Two lookup table (see [NewTwoPlusTwoEval]) is the default
+// backend, except when built with the portable or embedded build tags. Use
+// [LoadTwoPlusTwo] to load the lookup table from disk when the table is not
+// embedded, trading ~130MB of memory for a dramatic speedup when evaluating 7
+// card hands:
+//
+//	f, err := os.Open("twoplustwo.dat")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//	backend, err := cardrank.LoadTwoPlusTwo(f)
+//	if err != nil {
+//		return err
+//	}
+//	cardrank.SetEvalBackend(backend)
+//
+// Not safe for concurrent use. Must be called prior to evaluating, and before
+// creating calcs or dealers.
+func SetEvalBackend(backend func([]Card) EvalRank) {
+	twoPlusTwo = backend
+	for typ, desc := range descs {
+		calcs[typ] = desc.Eval.New(desc.board, false, desc.Low)
+		evals[typ] = desc.Eval.New(desc.board, true, desc.Low)
+	}
+}
+
+// LoadTwoPlusTwo loads a Two-Plus-Two lookup table from the reader, returning
+// a 7 card eval backend for use with [SetEvalBackend]. The table is read as
+// 32,487,834 little endian uint32 values, the same as the table generated by
+// the reference implementation, or the concatenation of the package's
+// twoplustwo*.dat files.
+//
+// Returns [ErrInvalidData] when the table is short or has trailing data.
+func LoadTwoPlusTwo(r io.Reader) (func([]Card) EvalRank, error) {
+	br := bufio.NewReader(r)
+	tbl := make([]uint32, twoPlusTwoLen)
+	if err := binary.Read(br, binary.LittleEndian, tbl); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		return nil, fmt.Errorf("%w: trailing data", ErrInvalidData)
+	}
+	return newTwoPlusTwoEval(tbl), nil
+}
+
+// newTwoPlusTwoEval creates a Two-Plus-Two rank eval func for the lookup
+// table.
+func newTwoPlusTwoEval(tbl []uint32) func([]Card) EvalRank {
+	// build card map
+	m := make(map[Card]uint32, 52)
+	for i, r := uint32(0), Two; r <= Ace; r++ {
+		for _, s := range []Suit{Spade, Heart, Club, Diamond} {
+			m[New(r, s)] = i + 1
+			i++
+		}
+	}
+	ranks := [10]uint32{
+		uint32(Invalid),
+		uint32(HighCard),
+		uint32(Pair),
+		uint32(TwoPair),
+		uint32(ThreeOfAKind),
+		uint32(Straight),
+		uint32(Flush),
+		uint32(FullHouse),
+		uint32(FourOfAKind),
+		uint32(StraightFlush),
+	}
+	return func(v []Card) EvalRank {
+		i := uint32(53)
+		for _, c := range v {
+			i = tbl[i+m[c]]
+		}
+		if len(v) < 7 {
+			i = tbl[i]
+		}
+		return EvalRank(ranks[i>>12] - i&0xfff + 1)
+	}
+}
@@ -0,0 +1,84 @@
+package cardrank
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetEvalBackend(t *testing.T) {
+	orig := twoPlusTwo
+	defer SetEvalBackend(orig)
+	var count int
+	tests := []struct {
+		name    string
+		backend func([]Card) EvalRank
+		calls   bool
+	}{
+		{"nil", nil, false},
+		{"custom", func(v []Card) EvalRank {
+			count++
+			return best5(Cactus, v)
+		}, true},
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetEvalBackend(test.backend)
+			count = 0
+			for range 100 {
+				v := DeckFrench.Shuffle(r, 1).Draw(7)
+				exp := best5(Cactus, v)
+				if ev := Holdem.Eval(v[:2], v[2:]); ev.HiRank != exp || len(ev.HiBest) != 5 {
+					t.Fatalf("expected %d, got: %d %v", exp, ev.HiRank, ev.HiBest)
+				}
+				if ranks := EvalRanks(Holdem, [][]Card{v}); ranks[0] != exp {
+					t.Fatalf("expected %d, got: %d", exp, ranks[0])
+				}
+			}
+			if test.calls && count == 0 || !test.calls && count != 0 {
+				t.Errorf("expected backend calls %t, got: %d", test.calls, count)
+			}
+		})
+	}
+}
+
+func TestLoadTwoPlusTwo(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping loading lookup table in short mode")
+	}
+	files, err := filepath.Glob("twoplustwo[0-9][0-9].dat")
+	switch {
+	case err != nil:
+		t.Fatalf("expected no error, got: %v", err)
+	case len(files) == 0:
+		t.Skip("lookup table not available")
+	}
+	var readers []io.Reader
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+	backend, err := LoadTwoPlusTwo(io.MultiReader(readers...))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := range 2000 {
+		v := DeckFrench.Shuffle(r, 1).Draw(5 + i%3)
+		if rank, exp := backend(v), best5(Cactus, v); rank != exp {
+			t.Fatalf("%v expected %d, got: %d", v, exp, rank)
+		}
+	}
+	if _, err := LoadTwoPlusTwo(bytes.NewReader(make([]byte, 1024))); !errors.Is(err, ErrInvalidData) {
+		t.Errorf("expected %v, got: %v", ErrInvalidData, err)
+	}
+}
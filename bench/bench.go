@@ -0,0 +1,238 @@
+// Package bench is a benchmark and validation harness for cardrank types and
+// evaluators.
+//
+// Measures evals per second, odds calc throughput, and allocations per type,
+// and cross-checks results between evaluator implementations. Useful for
+// continuous integration and for tuning deployments.
+package bench
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"time"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Result is a benchmark result.
+type Result struct {
+	// Type is the benchmarked type.
+	Type cardrank.Type
+	// Name is the benchmark name (eval or calc).
+	Name string
+	// N is the number of operations.
+	N int
+	// Duration is the total duration.
+	Duration time.Duration
+	// Allocs is the total number of heap allocations.
+	Allocs uint64
+	// Bytes is the total number of heap allocated bytes.
+	Bytes uint64
+}
+
+// NsPerOp returns the nanoseconds per operation.
+func (res Result) NsPerOp() float64 {
+	if res.N == 0 {
+		return 0
+	}
+	return float64(res.Duration.Nanoseconds()) / float64(res.N)
+}
+
+// OpsPerSec returns the operations per second.
+func (res Result) OpsPerSec() float64 {
+	if res.Duration == 0 {
+		return 0
+	}
+	return float64(res.N) / res.Duration.Seconds()
+}
+
+// AllocsPerOp returns the heap allocations per operation.
+func (res Result) AllocsPerOp() float64 {
+	if res.N == 0 {
+		return 0
+	}
+	return float64(res.Allocs) / float64(res.N)
+}
+
+// BytesPerOp returns the heap allocated bytes per operation.
+func (res Result) BytesPerOp() float64 {
+	if res.N == 0 {
+		return 0
+	}
+	return float64(res.Bytes) / float64(res.N)
+}
+
+// String satisfies the [fmt.Stringer] interface.
+func (res Result) String() string {
+	return fmt.Sprintf(
+		"%s/%s\t%d\t%.1f ns/op\t%.0f ops/s\t%.1f B/op\t%.1f allocs/op",
+		res.Type, res.Name, res.N, res.NsPerOp(), res.OpsPerSec(), res.BytesPerOp(), res.AllocsPerOp(),
+	)
+}
+
+// Bench is a benchmark harness.
+type Bench struct {
+	types   []cardrank.Type
+	evals   int
+	calcs   int
+	players int
+	seed    uint64
+}
+
+// New creates a new benchmark harness.
+func New(opts ...Option) *Bench {
+	b := &Bench{
+		evals:   100000,
+		calcs:   10,
+		players: 2,
+		seed:    1,
+	}
+	for _, o := range opts {
+		o(b)
+	}
+	if b.types == nil {
+		b.types = cardrank.Types()
+	}
+	return b
+}
+
+// Run runs the eval and calc benchmarks for each type, returning the
+// results. Calc benchmarks are skipped for double board types.
+func (b *Bench) Run(ctx context.Context) ([]Result, error) {
+	var results []Result
+	for _, typ := range b.types {
+		if 0 < b.evals {
+			results = append(results, b.Eval(typ))
+		}
+		if 0 < b.calcs && !typ.Double() {
+			res, err := b.Calc(ctx, typ)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, res)
+		}
+	}
+	return results, nil
+}
+
+// Eval benchmarks evals for the type, evaluating pre-dealt random hands.
+func (b *Bench) Eval(typ cardrank.Type) Result {
+	pockets, boards := b.deal(typ, min(b.evals, 1024), typ.Board())
+	ev := cardrank.EvalOf(typ)
+	return measure(typ, "eval", b.evals, func(i int) {
+		ev.Reset(typ)
+		ev.Eval(pockets[i%len(pockets)], boards[i%len(boards)])
+	})
+}
+
+// Calc benchmarks odds calcs for the type, calculating odds for pre-dealt
+// random pockets and a partial board (ie, the Flop).
+func (b *Bench) Calc(ctx context.Context, typ cardrank.Type) (Result, error) {
+	if typ.Double() {
+		return Result{}, fmt.Errorf("%s: odds calc not supported for double board types", typ)
+	}
+	var board int
+	if streets := typ.Streets(); 1 < len(streets) {
+		board = streets[0].Board + streets[1].Board
+	}
+	var err error
+	res := measure(typ, "calc", b.calcs, func(i int) {
+		if err != nil {
+			return
+		}
+		pockets, v := b.dealPlayers(typ, uint64(i), board)
+		if _, _, ok := typ.Odds(ctx, pockets, v); !ok {
+			err = fmt.Errorf("%s: unable to calc odds for %v %v", typ, pockets, v)
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+		}
+	})
+	return res, err
+}
+
+// deal deals n random pockets and boards of the specified board length.
+func (b *Bench) deal(typ cardrank.Type, n, board int) ([][]cardrank.Card, [][]cardrank.Card) {
+	r := rand.New(rand.NewPCG(b.seed, 0))
+	pockets, boards := make([][]cardrank.Card, n), make([][]cardrank.Card, n)
+	for i := range n {
+		p, v := typ.Deal(r, 1, 2)
+		pockets[i], boards[i] = p[0], v[:min(board, len(v))]
+	}
+	return pockets, boards
+}
+
+// dealPlayers deals pockets for the harness's players and a board of the
+// specified length.
+func (b *Bench) dealPlayers(typ cardrank.Type, i uint64, board int) ([][]cardrank.Card, []cardrank.Card) {
+	pockets, v := typ.Deal(rand.New(rand.NewPCG(b.seed, i)), 1, max(2, min(b.players, typ.Max())))
+	return pockets, v[:min(board, len(v))]
+}
+
+// measure measures n calls of f.
+func measure(typ cardrank.Type, name string, n int, f func(int)) Result {
+	// warm up (and initialize lazy lookup tables)
+	f(0)
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for i := range n {
+		f(i)
+	}
+	d := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return Result{
+		Type:     typ,
+		Name:     name,
+		N:        n,
+		Duration: d,
+		Allocs:   after.Mallocs - before.Mallocs,
+		Bytes:    after.TotalAlloc - before.TotalAlloc,
+	}
+}
+
+// Option is a benchmark harness option.
+type Option func(*Bench)
+
+// WithTypes is a benchmark harness option to set the types to benchmark.
+// Defaults to all registered types.
+func WithTypes(types ...cardrank.Type) Option {
+	return func(b *Bench) {
+		b.types = types
+	}
+}
+
+// WithEvals is a benchmark harness option to set the number of evals per
+// type. A value of 0 disables the eval benchmarks.
+func WithEvals(evals int) Option {
+	return func(b *Bench) {
+		b.evals = evals
+	}
+}
+
+// WithCalcs is a benchmark harness option to set the number of odds calcs
+// per type. A value of 0 disables the calc benchmarks.
+func WithCalcs(calcs int) Option {
+	return func(b *Bench) {
+		b.calcs = calcs
+	}
+}
+
+// WithPlayers is a benchmark harness option to set the number of players
+// for odds calcs.
+func WithPlayers(players int) Option {
+	return func(b *Bench) {
+		b.players = players
+	}
+}
+
+// WithSeed is a benchmark harness option to set the random seed used to
+// deal hands.
+func WithSeed(seed uint64) Option {
+	return func(b *Bench) {
+		b.seed = seed
+	}
+}
@@ -0,0 +1,60 @@
+package bench
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestBench(t *testing.T) {
+	b := New(
+		WithTypes(cardrank.Holdem, cardrank.OmahaHiLo, cardrank.OmahaDouble, cardrank.Razz),
+		WithEvals(1000),
+		WithCalcs(2),
+		WithPlayers(3),
+	)
+	results, err := b.Run(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if n, exp := len(results), 7; n != exp {
+		t.Fatalf("expected %d results, got: %d", exp, n)
+	}
+	for _, res := range results {
+		t.Logf("%s", res)
+		if res.N == 0 || res.Duration == 0 || res.OpsPerSec() == 0 {
+			t.Errorf("expected non-zero %s/%s result", res.Type, res.Name)
+		}
+	}
+}
+
+func TestCalcDouble(t *testing.T) {
+	if _, err := New().Calc(context.Background(), cardrank.OmahaDouble); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestCrossCheck(t *testing.T) {
+	for _, typ := range []cardrank.Type{cardrank.Holdem, cardrank.Stud} {
+		if v := CrossCheck(typ, cardrank.NewEval(cardrank.Cactus), 10000, 1); len(v) != 0 {
+			t.Errorf("%s expected no mismatches, got: %d (%s)", typ, len(v), v[0])
+		}
+	}
+	v := CrossCheck(cardrank.Holdem, cardrank.NewEval(cardrank.RankRazz), 100, 1)
+	if len(v) == 0 {
+		t.Errorf("expected mismatches")
+	}
+}
+
+func TestCrossCheckRank(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	if v := CrossCheckRank(cardrank.DeckFrench, cardrank.Cactus, cardrank.RankCactus); len(v) != 0 {
+		t.Errorf("expected no mismatches, got: %d (%s)", len(v), v[0])
+	}
+	if v := CrossCheckRank(cardrank.DeckShort, cardrank.Cactus, cardrank.RankShort); len(v) == 0 {
+		t.Errorf("expected mismatches")
+	}
+}
@@ -0,0 +1,66 @@
+package bench
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Mismatch is a cross-check mismatch between two evaluator implementations.
+type Mismatch struct {
+	Pocket []cardrank.Card
+	Board  []cardrank.Card
+	Exp    cardrank.EvalRank
+	Got    cardrank.EvalRank
+}
+
+// String satisfies the [fmt.Stringer] interface.
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%v %v: expected %d, got: %d", m.Pocket, m.Board, m.Exp, m.Got)
+}
+
+// CrossCheck evaluates n random hands for the type using both the type's
+// registered eval and the reference eval, returning any mismatched Hi or Lo
+// ranks.
+//
+// Example:
+//
+//	// compare Holdem's registered eval with a best-5 Cactus eval
+//	v := bench.CrossCheck(cardrank.Holdem, cardrank.NewEval(cardrank.Cactus), 1000000, 1)
+func CrossCheck(typ cardrank.Type, ref cardrank.EvalFunc, n int, seed uint64) []Mismatch {
+	r := rand.New(rand.NewPCG(seed, 0))
+	var v []Mismatch
+	a, b := cardrank.EvalOf(typ), cardrank.EvalOf(typ)
+	for range n {
+		pockets, board := typ.Deal(r, 1, 2)
+		a.Reset(typ)
+		b.Reset(typ)
+		a.Eval(pockets[0], board)
+		ref(b, pockets[0], board)
+		switch {
+		case a.HiRank != b.HiRank:
+			v = append(v, Mismatch{pockets[0], board, b.HiRank, a.HiRank})
+		case a.LoRank != b.LoRank:
+			v = append(v, Mismatch{pockets[0], board, b.LoRank, a.LoRank})
+		}
+	}
+	return v
+}
+
+// CrossCheckRank compares the rank funcs for every possible 5 card hand from
+// the deck type, returning any mismatched ranks.
+//
+// Example:
+//
+//	// compare the map based Cactus eval with the default
+//	v := bench.CrossCheckRank(cardrank.DeckFrench, cardrank.Cactus, cardrank.RankCactus)
+func CrossCheckRank(typ cardrank.DeckType, exp, got cardrank.RankFunc) []Mismatch {
+	var v []Mismatch
+	for g, c := cardrank.NewCombinGen(typ.Unshuffled(), 5); g.Next(); {
+		if r0, r1 := exp(c[0], c[1], c[2], c[3], c[4]), got(c[0], c[1], c[2], c[3], c[4]); r0 != r1 {
+			v = append(v, Mismatch{nil, append([]cardrank.Card(nil), c...), r0, r1})
+		}
+	}
+	return v
+}
@@ -1,7 +1,9 @@
 package cardrank
 
 import (
+	"context"
 	"fmt"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -162,3 +164,49 @@ var (
 	benchR EvalRank
 	benchE EvalRank
 )
+
+func BenchmarkEvalRanks(b *testing.B) {
+	v := shuffled(DeckFrench)
+	hands := make([][]Card, len(v)-7)
+	for i := range hands {
+		hands[i] = v[i : i+7]
+	}
+	b.Run("ranks", func(b *testing.B) {
+		ranks := make([]EvalRank, len(hands))
+		for range b.N {
+			ranks = EvalRanksInto(ranks, Holdem, hands)
+		}
+		benchR = ranks[0]
+	})
+	b.Run("loop", func(b *testing.B) {
+		for range b.N {
+			for _, hand := range hands {
+				benchR = rankCactusBest(hand)
+			}
+		}
+	})
+	b.Run("eval", func(b *testing.B) {
+		ev := EvalOf(Holdem)
+		for range b.N {
+			for _, hand := range hands {
+				Holdem.EvalInto(ev, hand, nil)
+				benchR = ev.HiRank
+			}
+		}
+	})
+}
+
+func BenchmarkOddsCalc(b *testing.B) {
+	for _, typ := range []Type{Holdem, Omaha, OmahaHiLo, OmahaFive} {
+		b.Run(typ.Name(), func(b *testing.B) {
+			b.ReportAllocs()
+			pockets, board := typ.Deal(rand.New(rand.NewPCG(7, 8)), 1, 4)
+			board = board[:3]
+			for range b.N {
+				if _, _, ok := typ.Odds(context.Background(), pockets, board); !ok {
+					b.Fatalf("expected ok")
+				}
+			}
+		})
+	}
+}
@@ -1,17 +1,21 @@
 package cardrank
 
-import "slices"
+import (
+	"slices"
+	"sync"
+)
 
+// Lookup maps, lazily built on first use.
 var (
 	flush5        map[uint32]EvalRank
 	unique5       map[uint32]EvalRank
 	sokoFlush4    map[uint32]EvalRank
 	sokoStraight4 map[uint32]EvalRank
+	cactusOnce    sync.Once
+	sokoOnce      sync.Once
 )
 
 func init() {
-	flush5, unique5 = cactusMaps()
-	sokoFlush4, sokoStraight4 = sokoMaps()
 	cactus = Cactus
 }
 
@@ -20,6 +24,9 @@ func init() {
 //
 // See: https://archive.is/G6GZg
 func Cactus(c0, c1, c2, c3, c4 Card) EvalRank {
+	cactusOnce.Do(func() {
+		flush5, unique5 = cactusMaps()
+	})
 	if c0&c1&c2&c3&c4&0xf000 != 0 {
 		return flush5[primeProductBits(uint32(c0|c1|c2|c3|c4)>>16)]
 	}
@@ -137,6 +144,9 @@ func RankSoko(c0, c1, c2, c3, c4 Card) EvalRank {
 	if rank <= TwoPair {
 		return rank
 	}
+	sokoOnce.Do(func() {
+		sokoFlush4, sokoStraight4 = sokoMaps()
+	})
 	r, v := Invalid, []Card{c0, c1, c2, c3, c4}
 	for c, i := EvalRank(0), 0; i < 5; i++ {
 		c0, c1, c2, c3, c4 = v[i%5], v[(i+1)%5], v[(i+2)%5], v[(i+3)%5], v[(i+4)%5]
@@ -6,10 +6,17 @@ import (
 	_ "embed"
 	"encoding/csv"
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"regexp"
+	"runtime"
+	"slices"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/cardrank/cardrank/combin"
 )
 
 // OddsCalc calculates run odds.
@@ -17,15 +24,21 @@ type OddsCalc struct {
 	typ     Type
 	deep    bool
 	runs    []*Run
-	active  map[int]bool
+	active  Positions
 	folded  bool
 	discard bool
+	r       Rand
+	ranges  map[int]Range
+	samples int
+	workers int
 }
 
 // NewOddsCalc creates a new run odds calc.
 func NewOddsCalc(typ Type, opts ...CalcOption) *OddsCalc {
 	c := &OddsCalc{
-		typ: typ,
+		typ:     typ,
+		active:  AllPositions,
+		workers: 1,
 	}
 	for _, o := range opts {
 		o(c)
@@ -41,11 +54,11 @@ func (c *OddsCalc) u() []Card {
 			ex = append(ex, run.Discard)
 		}
 		ex = append(ex, run.Hi, run.Lo)
-		if c.active == nil || c.folded {
+		if c.active == AllPositions || c.folded {
 			ex = append(ex, run.Pockets...)
 		} else {
 			for i := range len(run.Pockets) {
-				if c.active[i] {
+				if c.active.Has(i) {
 					ex = append(ex, run.Pockets[i])
 				}
 			}
@@ -54,12 +67,28 @@ func (c *OddsCalc) u() []Card {
 	return c.typ.DeckType().Exclude(ex...)
 }
 
-// Calc calculates odds.
+// Calc calculates odds, enumerating all runouts of the remaining board, or
+// sampling random runouts (see [WithMonteCarlo]). Returns the Hi and Lo odds,
+// and true when all runouts were enumerated or sampled.
+//
+// When the context is cancelled, returns the odds calculated from the
+// runouts enumerated prior to cancellation and false. When the context has a
+// deadline, runouts are enumerated in randomly ordered blocks, making the
+// returned odds an estimate from an approximately random sample of the
+// runouts, with the margin of error available from [Odds.Margin]. This allows
+// calculating the best available odds within a time budget:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+//	defer cancel()
+//	hi, lo, exact := c.Calc(ctx)
 func (c *OddsCalc) Calc(ctx context.Context) (*Odds, *Odds, bool) {
 	// check runs and pocket count
 	n := len(c.runs)
-	if n == 0 {
+	switch {
+	case n == 0:
 		return nil, nil, false
+	case len(c.ranges) != 0:
+		return c.calcRanges(ctx)
 	}
 	// ensure at least 1 pocket pair has been dealt
 	count := len(c.runs[n-1].Pockets)
@@ -70,8 +99,8 @@ func (c *OddsCalc) Calc(ctx context.Context) (*Odds, *Odds, bool) {
 	run := c.runs[n-1].Dupe()
 	k, u := b-len(run.Hi), c.u()
 	// if pocket == 2, board == 0, use lookup
-	if !c.deep && b == k {
-		hi, lo := run.CalcStart(low || double)
+	if !c.deep && c.samples == 0 && b == k {
+		hi, lo := run.CalcStartType(c.typ, low || double)
 		return hi, lo, true
 	}
 	// expand hi + lo boards
@@ -86,13 +115,93 @@ func (c *OddsCalc) Calc(ctx context.Context) (*Odds, *Odds, bool) {
 		lo = NewOdds(count, u)
 	}
 	hiSuits, loSuits := countRunSuits(run, double)
-	// iterate combinations
+	// remove positions drawing dead
 	offset := b - k
-	for g, v := NewCombinGen(u, k); g.Next(); {
+	active := c.active
+	if !low && !double {
+		active = c.live(run, u, offset, k)
+	}
+	hi.Runouts = combin.Binomial(len(u), k)
+	if lo != nil {
+		lo.Runouts = hi.Runouts
+	}
+	next := runouts(ctx, c.r, u, k, c.samples)
+	workers := c.workers
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers == 1 {
+		ok := c.calcRunouts(ctx, run, offset, active, hi, lo, hiSuits, loSuits, next)
+		return hi, lo, ok
+	}
+	// split runouts between workers, pulling batches of runouts
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	his, los, oks := make([]*Odds, workers), make([]*Odds, workers), make([]bool, workers)
+	for i := range workers {
+		his[i] = NewOdds(count, u)
+		if lo != nil {
+			los[i] = NewOdds(count, u)
+		}
+		buf, j, l := make([]Card, 0, k*runoutBatch), 0, 0
+		pull := func() ([]Card, bool) {
+			if j == l {
+				mu.Lock()
+				for buf, j, l = buf[:0], 0, 0; l < runoutBatch; l++ {
+					v, ok := next()
+					if !ok {
+						break
+					}
+					buf = append(buf, v...)
+				}
+				mu.Unlock()
+				if l == 0 {
+					return nil, false
+				}
+			}
+			j++
+			return buf[(j-1)*k : j*k], true
+		}
+		wg.Add(1)
+		go func(r *Run) {
+			defer wg.Done()
+			oks[i] = c.calcRunouts(ctx, r, offset, active, his[i], los[i], hiSuits, loSuits, pull)
+		}(run.Dupe())
+	}
+	wg.Wait()
+	ok := true
+	for i := range workers {
+		hi.merge(his[i], 1)
+		if lo != nil {
+			lo.merge(los[i], 1)
+		}
+		ok = ok && oks[i]
+	}
+	return hi, lo, ok
+}
+
+// calcRunouts adds the odds for each of the runouts to the Hi and Lo odds,
+// returning false when the context is done before all runouts are
+// calculated. The run's boards must be expanded to the type's board size.
+func (c *OddsCalc) calcRunouts(ctx context.Context, run *Run, offset int, active Positions, hi, lo *Odds, hiSuits, loSuits [][4]int, next func() ([]Card, bool)) bool {
+	low, double := c.typ.Low(), c.typ.Double()
+	// reuse evals across combinations
+	evs := make([]*Eval, len(run.Pockets))
+	defer PutEval(evs...)
+	// only one position can win the Hi, and there is no Lo
+	var live Positions
+	for i := range len(run.Pockets) {
+		if active.Has(i) {
+			live = live.With(i)
+		}
+	}
+	single := !low && !double && live.Len() == 1
+	// iterate combinations
+	for v, ok := next(); ok; v, ok = next() {
 		// check context
 		select {
 		case <-ctx.Done():
-			return hi, lo, false
+			return false
 		default:
 		}
 		// populate hi + lo boards
@@ -100,8 +209,12 @@ func (c *OddsCalc) Calc(ctx context.Context) (*Odds, *Odds, bool) {
 		if double {
 			copy(run.Lo[offset:], v)
 		}
+		if single {
+			hi.addWin(live.First(), run.Hi[offset:])
+			continue
+		}
 		// eval
-		evs := run.Eval(c.typ, c.active, true)
+		run.evalInto(evs, c.typ, active, true)
 		// add to odds
 		hi.Add(evs, hiSuits, run.Hi[offset:], false)
 		switch {
@@ -111,19 +224,369 @@ func (c *OddsCalc) Calc(ctx context.Context) (*Odds, *Odds, bool) {
 			lo.Add(evs, loSuits, run.Lo[offset:], true)
 		}
 	}
-	return hi, lo, true
+	return true
+}
+
+// calcRanges calculates the odds for each non-conflicting assignment of
+// combos to the positions having a range, merging the odds weighted by the
+// product of the combos' weights. When sampling, assignments are sampled
+// along with the runouts (see [OddsCalc.sampleRanges]).
+func (c *OddsCalc) calcRanges(ctx context.Context) (*Odds, *Odds, bool) {
+	if c.typ.Pocket() != 2 {
+		return nil, nil, false
+	}
+	var positions []int
+	for pos := range c.ranges {
+		positions = append(positions, pos)
+	}
+	slices.Sort(positions)
+	if positions[0] < 0 {
+		return nil, nil, false
+	}
+	// copy runs, replacing the last run's pockets
+	runs := slices.Clone(c.runs)
+	run := runs[len(runs)-1].Dupe()
+	runs[len(runs)-1] = run
+	pockets := make([][]Card, max(len(run.Pockets), positions[len(positions)-1]+1))
+	copy(pockets, run.Pockets)
+	run.Pockets = pockets
+	// remove combos blocked by known cards
+	var dead [][]Card
+	for _, r := range runs {
+		dead = append(dead, r.Hi, r.Lo, r.Discard)
+	}
+	for i, pocket := range pockets {
+		if _, ok := c.ranges[i]; !ok {
+			dead = append(dead, pocket)
+		}
+	}
+	combos := make([][]Combo, len(positions))
+	for i, pos := range positions {
+		combos[i] = c.ranges[pos].Remaining(dead...).Combos()
+	}
+	// calc each assignment
+	sub := *c
+	sub.runs, sub.ranges, sub.deep = runs, nil, true
+	hi := NewOdds(len(pockets), nil)
+	var lo *Odds
+	if c.typ.Low() || c.typ.Double() {
+		lo = NewOdds(len(pockets), nil)
+	}
+	add := func(weight float64) bool {
+		h, l, ok := sub.Calc(ctx)
+		if h != nil {
+			hi.merge(h, weight)
+		}
+		if l != nil && lo != nil {
+			lo.merge(l, weight)
+		}
+		return ok
+	}
+	if 0 < c.samples {
+		return hi, lo, c.sampleRanges(positions, combos, pockets, &sub, add)
+	}
+	used := make(map[Card]bool)
+	var calc func(int, float64) bool
+	calc = func(i int, weight float64) bool {
+		if i == len(positions) {
+			return add(weight)
+		}
+		for _, combo := range combos[i] {
+			if used[combo[0]] || used[combo[1]] {
+				continue
+			}
+			used[combo[0]], used[combo[1]] = true, true
+			pockets[positions[i]] = combo.Cards()
+			ok := calc(i+1, weight*c.ranges[positions[i]][combo])
+			delete(used, combo[0])
+			delete(used, combo[1])
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+	ok := calc(0, 1)
+	return hi, lo, ok
+}
+
+// sampleRanges samples random non-conflicting assignments of combos to the
+// positions having a range, calculating the runouts sampled for each
+// assignment with add. Combos are sampled uniformly, with the calculated odds
+// weighted by the product of the combos' weights.
+func (c *OddsCalc) sampleRanges(positions []int, combos [][]Combo, pockets [][]Card, sub *OddsCalc, add func(float64) bool) bool {
+	intn := rand.IntN
+	if c.r != nil {
+		intn = func(n int) int {
+			return int(c.r.Float64() * float64(n))
+		}
+	}
+	// count the samples for each assignment, keyed by the combo indices
+	counts := make(map[string]int)
+	key, used := make([]byte, 2*len(positions)), make(map[Card]bool)
+	for i := range positions {
+		if len(combos[i]) == 0 {
+			return true
+		}
+	}
+	for n, attempts := 0, 0; n < c.samples && attempts < 100*c.samples; attempts++ {
+		clear(used)
+		ok := true
+		for i := range positions {
+			j := intn(len(combos[i]))
+			combo := combos[i][j]
+			if used[combo[0]] || used[combo[1]] {
+				ok = false
+				break
+			}
+			used[combo[0]], used[combo[1]] = true, true
+			key[2*i], key[2*i+1] = byte(j>>8), byte(j)
+		}
+		if ok {
+			counts[string(key)]++
+			n++
+		}
+	}
+	// calc each sampled assignment in order, so that sampling is
+	// reproducible with a random source
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		weight := 1.0
+		for i, pos := range positions {
+			combo := combos[i][int(k[2*i])<<8|int(k[2*i+1])]
+			pockets[pos] = combo.Cards()
+			weight *= c.ranges[pos][combo]
+		}
+		sub.samples = counts[k]
+		if !add(weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// runoutBatch is the number of runouts pulled at a time by each calc worker.
+const runoutBatch = 64
+
+// runoutBlock is the number of consecutive runouts enumerated from each
+// randomly ordered block of runouts when the context has a deadline.
+const runoutBlock = 4
+
+// runouts returns a func returning each combination of k cards of u. When
+// sampling, combinations are returned in a random order using the random
+// source (or the global source when nil), and no more than samples
+// combinations are returned. When the context has a deadline, combinations
+// are returned in randomly ordered blocks of consecutive combinations, so
+// that the combinations returned prior to the deadline approximate a random
+// sample of all combinations.
+func runouts(ctx context.Context, r Rand, u []Card, k, samples int) func() ([]Card, bool) {
+	_, deadline := ctx.Deadline()
+	switch {
+	case 0 < samples:
+		return sampleRunouts(r, u, k, samples)
+	case deadline:
+		return blockRunouts(r, u, k)
+	}
+	g, v := NewCombinGen(u, k)
+	return func() ([]Card, bool) {
+		return v, g.Next()
+	}
+}
+
+// sampleRunouts returns a func returning up to samples random combinations
+// of k cards of u, without replacement.
+func sampleRunouts(r Rand, u []Card, k, samples int) func() ([]Card, bool) {
+	// lazy fisher-yates shuffle of the combination ranks
+	n, i, m := combin.Binomial(len(u), k), 0, make(map[int]int)
+	last := min(n, samples)
+	get := func(j int) int {
+		if r, ok := m[j]; ok {
+			return r
+		}
+		return j
+	}
+	v := make([]Card, k)
+	return func() ([]Card, bool) {
+		if last <= i {
+			return nil, false
+		}
+		var j int
+		if r != nil {
+			j = i + int(r.Float64()*float64(n-i))
+		} else {
+			j = i + rand.IntN(n-i)
+		}
+		rank := get(j)
+		m[j] = get(i)
+		delete(m, i)
+		i++
+		for l, x := range combin.Unrank(rank, len(u), k) {
+			v[l] = u[x]
+		}
+		return v, true
+	}
+}
+
+// blockRunouts returns a func returning each combination of k cards of u,
+// enumerating blocks of [runoutBlock] consecutive combinations in a random
+// order.
+func blockRunouts(r Rand, u []Card, k int) func() ([]Card, bool) {
+	n := combin.Binomial(len(u), k)
+	blocks := make([]int32, (n+runoutBlock-1)/runoutBlock)
+	for i := range blocks {
+		blocks[i] = int32(i)
+	}
+	swap := func(i, j int) {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	if r != nil {
+		r.Shuffle(len(blocks), swap)
+	} else {
+		rand.Shuffle(len(blocks), swap)
+	}
+	idx, v := make([]int, k), make([]Card, k)
+	b, rank, end := 0, 0, 0
+	return func() ([]Card, bool) {
+		if rank == end {
+			if len(blocks) <= b {
+				return nil, false
+			}
+			rank = int(blocks[b]) * runoutBlock
+			end = min(rank+runoutBlock, n)
+			combin.UnrankInto(idx, rank, len(u))
+			b++
+		} else {
+			combin.Next(idx, len(u))
+		}
+		rank++
+		for l, x := range idx {
+			v[l] = u[x]
+		}
+		return v, true
+	}
+}
+
+// live returns the active positions that can still win or tie on any of the
+// remaining runouts of k cards from the unused cards u, with the run's board
+// already containing offset cards.
+//
+// A position is drawing dead when its best possible rank across all runouts
+// is worse than the current rank of any other position. As this relies on a
+// position's rank never worsening as board cards are added, positions are
+// only removed for Hi only types using Cactus, Short, ShortTrips, Manila,
+// Spanish, or Omaha evals after the Flop.
+func (c *OddsCalc) live(run *Run, u []Card, offset, k int) Positions {
+	var active Positions
+	for i := range len(run.Pockets) {
+		if c.active.Has(i) {
+			active = active.With(i)
+		}
+	}
+	desc := c.typ.Desc()
+	switch desc.Eval {
+	case EvalCactus, EvalShort, EvalShortTrips, EvalManila, EvalSpanish, EvalOmaha:
+	default:
+		return c.active
+	}
+	if k == 0 || offset < 3 || active.Len() < 2 {
+		return c.active
+	}
+	ev := GetEval(c.typ)
+	defer PutEval(ev)
+	// determine the current ranks, and the best two ranks
+	f := desc.Eval.New(0, false, false)
+	ranks := make([]EvalRank, len(run.Pockets))
+	best, i0, second := Invalid, -1, Invalid
+	for _, i := range active.Slice() {
+		ev.Reset(c.typ)
+		f(ev, run.Pockets[i], run.Hi[:offset])
+		if ev.HiRank == 0 || ev.HiRank == Invalid {
+			return c.active
+		}
+		switch ranks[i] = ev.HiRank; {
+		case ranks[i] < best:
+			best, i0, second = ranks[i], i, best
+		case ranks[i] < second:
+			second = ranks[i]
+		}
+	}
+	// determine best possible rank for positions currently behind
+	f = calcs[c.typ]
+	board := make([]Card, offset+k)
+	copy(board, run.Hi[:offset])
+	for _, i := range active.Slice() {
+		r := best
+		if i == i0 {
+			r = second
+		}
+		if ranks[i] <= r {
+			continue
+		}
+		dead := true
+		for g, v := NewCombinGen(u, k); dead && g.Next(); {
+			copy(board[offset:], v)
+			ev.Reset(c.typ)
+			f(ev, run.Pockets[i], board)
+			dead = r < ev.HiRank
+		}
+		if dead {
+			active = active.Without(i)
+		}
+	}
+	return active
 }
 
 // Odds are calculated run odds.
 type Odds struct {
-	// Total is the total number of outcomes.
+	// Total is the total number of outcomes. When calculating with a range
+	// (see [WithRange]), Total, Counts, Outcomes, Wins, and Chops are the
+	// unweighted counts, while [Odds.Percent], [Odds.Float32],
+	// [Odds.Outright], and [Odds.AtLeastChop] use the range weighted counts.
 	Total int
 	// Counts is each position's outcome count for wins and splits.
 	Counts []int
 	// Outs are map of the available outs for a position.
 	Outs []map[Card]bool
+	// Outcomes is the number of outcomes (ie, runouts). Outcomes, wins, and
+	// chops are not calculated for starting pockets (see [Run.CalcStartType]).
+	Outcomes int
+	// Wins is each position's outright win count.
+	Wins []int
+	// Chops is each position's split count.
+	Chops []int
+	// Equity is each position's weighted share of the outcomes, with split
+	// outcomes divided equally between the splitting positions. Outcomes are
+	// weighted by the weights of the positions' range combos (see
+	// [WithRange]), or 1. Equity is not calculated for starting pockets.
+	Equity []float64
+	// Weight is the total weight of the outcomes (see [Odds.Share]).
+	Weight float64
+	// Runouts is the total number of runouts when calculating by enumerating
+	// runouts. When there are fewer outcomes than runouts, the calc was
+	// cancelled, and the odds are estimated from the enumerated runouts (see
+	// [Odds.Exact] and [Odds.Margin]).
+	Runouts int
 	// Suits [][]Suit
 	// Dead  bool
+
+	// order is the reused order buffer.
+	order []int
+	// weighted is the range weighted counts, set when calculating with a
+	// range (see [WithRange]).
+	weighted *oddsWeights
+}
+
+// oddsWeights are the range weighted totals and counts of odds.
+type oddsWeights struct {
+	total    float64
+	outcomes float64
+	counts   []float64
+	wins     []float64
+	chops    []float64
 }
 
 // NewOdds creates a new odds.
@@ -131,6 +594,9 @@ func NewOdds(count int, u []Card) *Odds {
 	odds := &Odds{
 		Counts: make([]int, count),
 		Outs:   make([]map[Card]bool, count),
+		Wins:   make([]int, count),
+		Chops:  make([]int, count),
+		Equity: make([]float64, count),
 		// Suits: make([][]Suit, count),
 	}
 	for i := range count {
@@ -141,7 +607,9 @@ func NewOdds(count int, u []Card) *Odds {
 
 // Add adds the eval results to the odds.
 func (odds *Odds) Add(evs []*Eval, suits [][4]int, v []Card, low bool) {
-	indices, pivot := Order(evs, low)
+	var pivot int
+	odds.order, pivot = OrderInto(odds.order, evs, low)
+	indices := odds.order
 	s := make([][4]int, len(suits))
 	copy(s, suits)
 	for i := range pivot {
@@ -149,8 +617,80 @@ func (odds *Odds) Add(evs []*Eval, suits [][4]int, v []Card, low bool) {
 		for j := range len(v) {
 			odds.Outs[indices[i]][v[j]] = true
 		}
+		if pivot == 1 {
+			odds.Wins[indices[i]]++
+		} else {
+			odds.Chops[indices[i]]++
+		}
+		if odds.Equity != nil {
+			odds.Equity[indices[i]] += 1 / float64(pivot)
+		}
 	}
 	odds.Total += pivot
+	odds.Outcomes++
+	odds.Weight++
+}
+
+// addWin adds an outright win for position i with the cards v.
+func (odds *Odds) addWin(i int, v []Card) {
+	odds.Counts[i]++
+	for _, c := range v {
+		odds.Outs[i][c] = true
+	}
+	odds.Total++
+	odds.Wins[i]++
+	if odds.Equity != nil {
+		odds.Equity[i]++
+	}
+	odds.Outcomes++
+	odds.Weight++
+}
+
+// merge merges the odds into the odds, with the odds' equity and weighted
+// counts scaled by the weight.
+func (odds *Odds) merge(o *Odds, weight float64) {
+	if odds.weighted == nil && weight != 1 {
+		// prior merges were unweighted
+		odds.weighted = &oddsWeights{
+			total:    float64(odds.Total),
+			outcomes: float64(odds.Outcomes),
+			counts:   floats(odds.Counts),
+			wins:     floats(odds.Wins),
+			chops:    floats(odds.Chops),
+		}
+	}
+	w := odds.weighted
+	for i := range o.Counts {
+		if w != nil {
+			w.counts[i] += float64(o.Counts[i]) * weight
+			w.wins[i] += float64(o.Wins[i]) * weight
+			w.chops[i] += float64(o.Chops[i]) * weight
+		}
+		odds.Counts[i] += o.Counts[i]
+		odds.Wins[i] += o.Wins[i]
+		odds.Chops[i] += o.Chops[i]
+		odds.Equity[i] += o.Equity[i] * weight
+		for c := range o.Outs[i] {
+			odds.Outs[i][c] = true
+		}
+	}
+	odds.Total += o.Total
+	odds.Outcomes += o.Outcomes
+	if w != nil {
+		w.total += float64(o.Total) * weight
+		w.outcomes += float64(o.Outcomes) * weight
+	}
+	odds.Runouts += o.Runouts
+	odds.Weight += o.Weight * weight
+}
+
+// floats returns v as a slice of float64.
+func floats(v []int) []float64 {
+	f := make([]float64, len(v))
+	for i, n := range v {
+		f[i] = float64(n)
+	}
+	return f
 }
 
 // Float32 returns the odds as a slice of float32.
@@ -158,14 +698,94 @@ func (odds *Odds) Float32() []float32 {
 	n := len(odds.Counts)
 	v := make([]float32, len(odds.Counts))
 	for i := range n {
-		v[i] = float32(odds.Counts[i]) / float32(max(odds.Total, 1))
+		v[i] = float32(odds.ratio(i))
 	}
 	return v
 }
 
 // Percent returns the odds for pos calculated as a percent.
 func (odds *Odds) Percent(pos int) float32 {
-	return float32(odds.Counts[pos]) / float32(max(odds.Total, 1)) * 100
+	return float32(odds.ratio(pos) * 100)
+}
+
+// ratio returns the ratio of the outcomes won or split by pos, weighted by
+// the range weights when calculated with a range.
+func (odds *Odds) ratio(pos int) float64 {
+	if w := odds.weighted; w != nil {
+		if w.total == 0 {
+			return 0
+		}
+		return w.counts[pos] / w.total
+	}
+	return float64(odds.Counts[pos]) / float64(max(odds.Total, 1))
+}
+
+// Outright returns the probability that pos wins outright.
+func (odds *Odds) Outright(pos int) float64 {
+	switch w := odds.weighted; {
+	case odds.Outcomes == 0 || len(odds.Wins) <= pos,
+		w != nil && w.outcomes == 0:
+		return 0
+	case w != nil:
+		return w.wins[pos] / w.outcomes
+	}
+	return float64(odds.Wins[pos]) / float64(odds.Outcomes)
+}
+
+// AtLeastChop returns the probability that pos wins or splits (ie, at least
+// chops).
+func (odds *Odds) AtLeastChop(pos int) float64 {
+	switch w := odds.weighted; {
+	case odds.Outcomes == 0 || len(odds.Wins) <= pos || len(odds.Chops) <= pos,
+		w != nil && w.outcomes == 0:
+		return 0
+	case w != nil:
+		return (w.wins[pos] + w.chops[pos]) / w.outcomes
+	}
+	return float64(odds.Wins[pos]+odds.Chops[pos]) / float64(odds.Outcomes)
+}
+
+// Share returns the weighted share of the outcomes for pos (see
+// [Odds.Equity]).
+func (odds *Odds) Share(pos int) float64 {
+	if odds.Weight == 0 || len(odds.Equity) <= pos {
+		return 0
+	}
+	return odds.Equity[pos] / odds.Weight
+}
+
+// Exact returns true when the odds were calculated from all runouts.
+func (odds *Odds) Exact() bool {
+	return odds.Outcomes == odds.Runouts
+}
+
+// Margin returns the margin of error for the odds for pos as a percent, with
+// the z-score of the confidence level (ie, 1.96 for a 95% confidence
+// interval). Returns 0 when the odds are exact, and 100 when there are no
+// outcomes.
+//
+// The margin is approximated using the normal approximation of the binomial
+// proportion, with a finite population correction for the total runouts. Only
+// valid when the runouts were enumerated in a random order, as when sampling
+// (see [WithMonteCarlo]), and approximate when calculating with a context
+// deadline (see [OddsCalc.Calc]).
+func (odds *Odds) Margin(pos int, z float64) float64 {
+	switch n, runouts := float64(odds.Outcomes), float64(odds.Runouts); {
+	case odds.Exact():
+		return 0
+	case n == 0:
+		return 100
+	default:
+		p := float64(odds.Percent(pos)) / 100
+		return z * math.Sqrt(p*(1-p)/n*(runouts-n)/max(runouts-1, 1)) * 100
+	}
+}
+
+// Interval returns the confidence interval for the odds for pos as percents,
+// with the z-score of the confidence level (see [Odds.Margin]).
+func (odds *Odds) Interval(pos int, z float64) (float64, float64) {
+	p, margin := float64(odds.Percent(pos)), odds.Margin(pos, z)
+	return max(0, p-margin), min(100, p+margin)
 }
 
 /*
@@ -289,7 +909,7 @@ func (c *ExpValueCalc) Calc(ctx context.Context) (*ExpValue, bool) {
 	u, b, nb := c.u(), c.typ.Board(), len(c.board)
 	switch np := len(c.pocket); {
 	case !c.deep && 1 < np && np < 7 && nb == 0:
-		return StartingExpValue(c.pocket), true
+		return c.typ.StartingExpValue(c.pocket), true
 	case nb == 0:
 		return NewExpValue(1), false
 	}
@@ -335,9 +955,9 @@ func (c *ExpValueCalc) do(_ context.Context, expv *ExpValue, board, avail []Card
 	var win bool
 	for g, v := NewCombinGen(avail, c.typ.Pocket()); g.Next(); {
 		// eval and order
-		evs[1].HiRank = Invalid
+		evs[1].Reset(c.typ)
 		f(evs[1], v, board)
-		indices, pivot = Order(evs, false)
+		indices, pivot = OrderInto(indices, evs, false)
 		// determine if win
 		for i, win = 0, false; i < pivot; i++ {
 			win = win || indices[i] == 0
@@ -451,9 +1071,9 @@ func WithPocketsBoard(pockets [][]Card, board []Card) CalcOption {
 	}
 }
 
-// WithActive is a calc option to run with the active map and whether or not
-// folded positions should be included.
-func WithActive(active map[int]bool, folded bool) CalcOption {
+// WithActive is a calc option to run with the active positions and whether or
+// not folded positions should be included.
+func WithActive(active Positions, folded bool) CalcOption {
 	return func(v interface{}) {
 		if c, ok := v.(*OddsCalc); ok {
 			c.active, c.folded = active, folded
@@ -489,7 +1109,71 @@ func WithOpponents(opponents int) CalcOption {
 	}
 }
 
-// BinGen is a binomial combination generator.
+// WithWorkers is a calc option to set the number of goroutines used to
+// calculate runouts. Uses [runtime.GOMAXPROCS] goroutines when less than 1.
+// Defaults to 1.
+func WithWorkers(workers int) CalcOption {
+	return func(v interface{}) {
+		if c, ok := v.(*OddsCalc); ok {
+			c.workers = workers
+		}
+	}
+}
+
+// WithMonteCarlo is a calc option to sample the number of random runouts
+// (without replacement) instead of enumerating all runouts, for fast
+// approximate odds when there are many runouts. The margin of error of the
+// sampled odds is available from [Odds.Margin]. All runouts are enumerated
+// when there are fewer runouts than samples. Use [WithRand] to set the
+// random source.
+//
+// Starting pockets are sampled instead of using the starting pocket lookup
+// (see [WithDeep]).
+func WithMonteCarlo(samples int) CalcOption {
+	return func(v interface{}) {
+		if c, ok := v.(*OddsCalc); ok {
+			c.samples = samples
+		}
+	}
+}
+
+// WithRange is a calc option to set the range of pockets for the position,
+// calculating the odds for each of the range's combos not conflicting with
+// the other pockets, the board, or the combos of other ranged positions. The
+// position's pocket is ignored, and can be nil. Outcomes are weighted by the
+// range's weights in the Hi and Lo odds' equity and percents (see
+// [Odds.Share] and [Odds.Percent]).
+//
+// All runouts of every assignment of combos are enumerated (see [WithDeep]),
+// which can be slow when there are many assignments and runouts (ie,
+// preflop). With [WithMonteCarlo], assignments are instead sampled together
+// with the runouts, with the samples divided between the sampled
+// assignments. When the context has a deadline, assignments are calculated
+// in order, with each assignment's runouts enumerated as with
+// [OddsCalc.Calc]. Only supported for types having 2 card pockets.
+func WithRange(pos int, r Range) CalcOption {
+	return func(v interface{}) {
+		if c, ok := v.(*OddsCalc); ok {
+			if c.ranges == nil {
+				c.ranges = make(map[int]Range)
+			}
+			c.ranges[pos] = r
+		}
+	}
+}
+
+// WithRand is a calc option to set the random source used when sampling
+// runouts. See [RNG] for reproducible random sources.
+func WithRand(r Rand) CalcOption {
+	return func(v interface{}) {
+		if c, ok := v.(*OddsCalc); ok {
+			c.r = r
+		}
+	}
+}
+
+// BinGen is a binomial combination generator. See the [combin] package for
+// combination and permutation sequences, and ranking.
 type BinGen[T any] struct {
 	s []T
 	i int
@@ -561,21 +1245,9 @@ func (g *BinGen[T]) Next() bool {
 		g.i = -1
 		return false
 	case g.v == nil:
-		g.v = make([]int, g.k)
-		for i := range g.v {
-			g.v[i] = i
-		}
+		g.v = combin.First(g.k)
 	default:
-		for i := g.k - 1; 0 <= i; i-- {
-			if g.v[i] == g.n+i-g.k {
-				continue
-			}
-			g.v[i]++
-			for j := i + 1; j < g.k; j++ {
-				g.v[j] = g.v[i] + j - i
-			}
-			break
-		}
+		combin.Next(g.v, g.n)
 	}
 	g.i--
 	g.f()
@@ -604,18 +1276,24 @@ func (g *BinGen[T]) unused() {
 	}
 }
 
-// startingExpValue is the preloaded map of starting expected value
-// calculations.
-var startingExpValue map[string]ExpValue
-
-// startingCactus is the preloaded map of starting cactus values.
-var startingCactus map[string]EvalRank
+// Starting pocket maps, lazily loaded on first use.
+var (
+	// startingExpValue is the map of starting expected value calculations.
+	startingExpValue map[string]ExpValue
+	// startingCactus is the map of starting cactus values.
+	startingCactus map[string]EvalRank
+	// startingOnce guards loading the starting pocket maps.
+	startingOnce sync.Once
+)
 
-func init() {
-	var err error
-	if startingExpValue, startingCactus, err = holdemStarting(); err != nil {
-		panic(err)
-	}
+// loadStarting loads the starting pocket maps.
+func loadStarting() {
+	startingOnce.Do(func() {
+		var err error
+		if startingExpValue, startingCactus, err = holdemStarting(); err != nil {
+			panic(err)
+		}
+	})
 }
 
 // StartingExpValue returns the starting pocket expected value.
@@ -635,6 +1313,7 @@ func StartingExpValue(pocket []Card) *ExpValue {
 	default:
 		return nil
 	}
+	loadStarting()
 	pockets, n := f(pocket)
 	expv := NewExpValue(1)
 	for i := range n {
@@ -650,7 +1329,6 @@ func StartingExpValue(pocket []Card) *ExpValue {
 // For example, a single [King] would be the highest non-[Straight] and
 // non-[Flush] value between [Pair] and [HighCard].
 func StartingEvalRank(pocket []Card) EvalRank {
-	var f func([]Card) ([][]Card, int)
 	switch n := len(pocket); n {
 	case 0:
 		return Nothing
@@ -683,25 +1361,17 @@ func StartingEvalRank(pocket []Card) EvalRank {
 		case Two:
 			return cactusTwo
 		}
-	case 2:
-		return startingCactus[HashKey(pocket[0], pocket[1])]
-	case 3:
-		f = take3c2
-	case 4:
-		f = take4c2
-	case 5:
-		f = take5c2
-	case 6:
-		f = take6c2
-	default:
-		return Invalid
-	}
-	pockets, n := f(pocket)
-	r := Invalid
-	for i := range n {
-		r = min(r, startingCactus[HashKey(pockets[i][0], pockets[i][1])])
+	case 2, 3, 4, 5, 6:
+		loadStarting()
+		r := Invalid
+		for i := range n {
+			for j := i + 1; j < n; j++ {
+				r = min(r, startingCactus[HashKey(pocket[i], pocket[j])])
+			}
+		}
+		return r
 	}
-	return r
+	return Invalid
 }
 
 // HashKey returns the hash key of the pocket cards.
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
+	"math/rand"
 	"os"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -376,22 +379,16 @@ func TestOddsCalc(t *testing.T) {
 			for i := range len(test.pockets) {
 				pockets[i] = Must(test.pockets[i])
 			}
-			var active map[int]bool
+			active := AllPositions
 			if len(test.inactive) != 0 {
-				active = make(map[int]bool)
-				for i := range len(test.pockets) {
-					active[i] = true
-				}
-				for i := range len(test.inactive) {
-					active[test.inactive[i]] = false
-				}
+				active = active.Without(test.inactive...)
 			}
 			testOddsCalc(t, ctx, test.typ, pockets, Must(test.board), test.v, test.n, active)
 		})
 	}
 }
 
-func testOddsCalc(t *testing.T, ctx context.Context, typ Type, pockets [][]Card, board []Card, v []int, n int, active map[int]bool) {
+func testOddsCalc(t *testing.T, ctx context.Context, typ Type, pockets [][]Card, board []Card, v []int, n int, active Positions) {
 	t.Helper()
 	odds, _, ok := NewOddsCalc(
 		typ,
@@ -420,6 +417,362 @@ func testOddsCalc(t *testing.T, ctx context.Context, typ Type, pockets [][]Card,
 	}
 }
 
+func TestOddsCalcDeadline(t *testing.T) {
+	pockets, board := [][]Card{Must("As Ad"), Must("Ks Kd")}, Must("Qh 7c 2d")
+	exp, _, _ := NewOddsCalc(Holdem, WithPocketsBoard(pockets, board)).Calc(context.Background())
+	// random order enumerates all runouts
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	odds, _, ok := NewOddsCalc(Holdem, WithPocketsBoard(pockets, board)).Calc(ctx)
+	switch {
+	case !ok || !odds.Exact():
+		t.Fatalf("expected exact odds")
+	case !reflect.DeepEqual(odds.Counts, exp.Counts), odds.Outcomes != exp.Outcomes, odds.Runouts != 990:
+		t.Errorf("expected %v %d, got: %v %d", exp.Counts, exp.Outcomes, odds.Counts, odds.Outcomes)
+	case odds.Margin(0, 1.96) != 0:
+		t.Errorf("expected 0 margin, got: %f", odds.Margin(0, 1.96))
+	}
+	// cancelled after a sample of the runouts
+	exp, _, _ = NewOddsCalc(Holdem, WithPocketsBoard(pockets, nil), WithDeep(true)).Calc(context.Background())
+	odds, _, ok = NewOddsCalc(Holdem, WithPocketsBoard(pockets, nil), WithDeep(true)).Calc(&sampleContext{Context: context.Background(), n: 5000})
+	switch {
+	case ok || odds.Exact():
+		t.Fatalf("expected estimated odds")
+	case odds.Outcomes != 4999 || odds.Runouts != 1712304:
+		t.Fatalf("expected 4999 of 1712304 outcomes, got: %d of %d", odds.Outcomes, odds.Runouts)
+	}
+	for i := range pockets {
+		p, e, margin := float64(odds.Percent(i)), float64(exp.Percent(i)), odds.Margin(i, 4.5)
+		t.Logf("%d: %.2f%% ±%.2f%% (exact: %.2f%%)", i, p, odds.Margin(i, 1.96), e)
+		if margin <= 0 || 3 < margin || margin < math.Abs(p-e) {
+			t.Errorf("expected %.2f%% to be within %.2f%% of %.2f%%", p, margin, e)
+		}
+	}
+	// reproducible with a random source
+	var counts [][]int
+	for range 2 {
+		odds, _, _ := NewOddsCalc(Holdem, WithPocketsBoard(pockets, nil), WithDeep(true), WithRand(NewRNG(1))).Calc(&sampleContext{Context: context.Background(), n: 100})
+		counts = append(counts, odds.Counts)
+	}
+	if !reflect.DeepEqual(counts[0], counts[1]) {
+		t.Errorf("expected %v, got: %v", counts[0], counts[1])
+	}
+	if m := (&Odds{Counts: []int{0}, Runouts: 10}).Margin(0, 1.96); m != 100 {
+		t.Errorf("expected 100 margin with no outcomes, got: %f", m)
+	}
+}
+
+// sampleContext is a context with a deadline that is done after n checks.
+type sampleContext struct {
+	context.Context
+	n int
+}
+
+func (ctx *sampleContext) Deadline() (time.Time, bool) {
+	return time.Now().Add(time.Minute), true
+}
+
+func (ctx *sampleContext) Done() <-chan struct{} {
+	ch := make(chan struct{})
+	if ctx.n--; ctx.n <= 0 {
+		close(ch)
+	}
+	return ch
+}
+
+func (ctx *sampleContext) Err() error {
+	if ctx.n <= 0 {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+func TestOddsCalcRange(t *testing.T) {
+	board := Must("Qh 7c 2d 9s")
+	calc := func(opts ...CalcOption) *Odds {
+		t.Helper()
+		odds, _, ok := NewOddsCalc(Holdem, opts...).Calc(context.Background())
+		if !ok || odds == nil {
+			t.Fatalf("expected odds")
+		}
+		return odds
+	}
+	// single combo range is the same as the pocket
+	exp := calc(WithPocketsBoard([][]Card{Must("As Ad"), Must("Ks Kd")}, board))
+	odds := calc(WithPocketsBoard([][]Card{nil, Must("Ks Kd")}, board), WithRange(0, Range{NewCombo(Must("As")[0], Must("Ad")[0]): 1}))
+	if !reflect.DeepEqual(odds.Counts, exp.Counts) || odds.Share(0) != exp.Share(0) {
+		t.Errorf("expected %v %f, got: %v %f", exp.Counts, exp.Share(0), odds.Counts, odds.Share(0))
+	}
+	// weighted combos
+	r, err := ParseRange("QQ:0.5, AA, 99, 72o")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var equity, weight float64
+	for _, c := range r.Remaining(board, Must("Ks Kd")).Combos() {
+		o := calc(WithPocketsBoard([][]Card{c.Cards(), Must("Ks Kd")}, board))
+		equity, weight = equity+o.Share(1)*r[c], weight+r[c]
+	}
+	odds = calc(WithPocketsBoard([][]Card{nil, Must("Ks Kd")}, board), WithRange(0, r))
+	switch n := len(r.Remaining(board, Must("Ks Kd"))); {
+	case odds.Outcomes != n*44 || odds.Runouts != n*44:
+		t.Errorf("expected %d outcomes, got: %d", n*44, odds.Outcomes)
+	case math.Abs(odds.Share(1)-equity/weight) > 1e-9:
+		t.Errorf("expected %f, got: %f", equity/weight, odds.Share(1))
+	case math.Abs(odds.Share(0)+odds.Share(1)-1) > 1e-9:
+		t.Errorf("expected shares to sum to 1, got: %f", odds.Share(0)+odds.Share(1))
+	}
+	// asymmetric weights change the percents
+	even, err := ParseRange("AA, 72o")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	uneven, err := ParseRange("AA:0.25, 72o")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var count, total, wins, outcomes float64
+	for _, c := range uneven.Remaining(board, Must("Ks Kd")).Combos() {
+		o := calc(WithPocketsBoard([][]Card{c.Cards(), Must("Ks Kd")}, board))
+		count, total = count+float64(o.Counts[0])*uneven[c], total+float64(o.Total)*uneven[c]
+		wins, outcomes = wins+float64(o.Wins[0])*uneven[c], outcomes+float64(o.Outcomes)*uneven[c]
+	}
+	a := calc(WithPocketsBoard([][]Card{nil, Must("Ks Kd")}, board), WithRange(0, even))
+	b := calc(WithPocketsBoard([][]Card{nil, Must("Ks Kd")}, board), WithRange(0, uneven))
+	switch {
+	case !reflect.DeepEqual(a.Counts, b.Counts):
+		t.Errorf("expected equal counts %v, got: %v", a.Counts, b.Counts)
+	case b.Percent(0) >= a.Percent(0):
+		t.Errorf("expected %f < %f", b.Percent(0), a.Percent(0))
+	case math.Abs(float64(b.Percent(0))-count/total*100) > 1e-3:
+		t.Errorf("expected %f, got: %f", count/total*100, b.Percent(0))
+	case math.Abs(float64(b.Float32()[0])-count/total) > 1e-5:
+		t.Errorf("expected %f, got: %f", count/total, b.Float32()[0])
+	case math.Abs(b.Outright(0)-wins/outcomes) > 1e-9:
+		t.Errorf("expected %f, got: %f", wins/outcomes, b.Outright(0))
+	}
+	// range vs range skips conflicting combos
+	kk, _ := ParseRange("KK")
+	aks, _ := ParseRange("AKs")
+	odds = calc(WithPocketsBoard(nil, board), WithRange(0, kk), WithRange(1, aks))
+	if n := (6*4 - 4*3) * 44; odds.Outcomes != n {
+		t.Errorf("expected %d outcomes, got: %d", n, odds.Outcomes)
+	}
+	t.Logf("KK vs AKs: %.4f %.4f", odds.Share(0), odds.Share(1))
+	// sampled assignments approximate the enumerated odds
+	exp = calc(WithPocketsBoard([][]Card{nil, Must("Ks Kd")}, Must("Qh 7c 2d")), WithRange(0, r))
+	odds = calc(WithPocketsBoard([][]Card{nil, Must("Ks Kd")}, Must("Qh 7c 2d")), WithRange(0, r), WithMonteCarlo(5000), WithRand(NewRNG(1)))
+	switch {
+	case odds.Outcomes != 5000:
+		t.Errorf("expected 5000 outcomes, got: %d", odds.Outcomes)
+	case math.Abs(odds.Share(0)-exp.Share(0)) > 0.03:
+		t.Errorf("expected %f, got: %f", exp.Share(0), odds.Share(0))
+	}
+	// preflop range vs range samples assignments
+	odds = calc(WithPocketsBoard(nil, nil), WithRange(0, kk), WithRange(1, aks), WithMonteCarlo(5000), WithRand(NewRNG(1)))
+	if odds.Outcomes != 5000 || odds.Share(0) < 0.6 || 0.72 < odds.Share(0) {
+		t.Errorf("expected 5000 outcomes and KK to be ahead, got: %d %f", odds.Outcomes, odds.Share(0))
+	}
+	// unsupported type
+	if hi, _, ok := NewOddsCalc(Omaha, WithPocketsBoard(nil, board), WithRange(0, kk)).Calc(context.Background()); hi != nil || ok {
+		t.Errorf("expected no odds")
+	}
+}
+
+func TestOddsCalcMonteCarlo(t *testing.T) {
+	pockets := [][]Card{Must("As Ad"), Must("Ks Kd"), Must("7h 8h")}
+	exp, _, _ := NewOddsCalc(Holdem, WithPocketsBoard(pockets, nil), WithDeep(true)).Calc(context.Background())
+	odds, _, ok := NewOddsCalc(
+		Holdem,
+		WithPocketsBoard(pockets, nil),
+		WithMonteCarlo(20000),
+		WithRand(NewRNG(1)),
+	).Calc(context.Background())
+	switch {
+	case !ok || odds.Exact():
+		t.Fatalf("expected completed sampled odds")
+	case odds.Outcomes != 20000 || odds.Runouts != 1370754:
+		t.Fatalf("expected 20000 of 1370754 outcomes, got: %d of %d", odds.Outcomes, odds.Runouts)
+	}
+	for i := range pockets {
+		lo, hi := odds.Interval(i, 4.5)
+		t.Logf("%d: %.2f%% [%.2f%%, %.2f%%] (exact: %.2f%%)", i, odds.Percent(i), lo, hi, exp.Percent(i))
+		if e := float64(exp.Percent(i)); e < lo || hi < e {
+			t.Errorf("expected %.2f%% to be within [%.2f%%, %.2f%%]", e, lo, hi)
+		}
+	}
+	// fewer runouts than samples are enumerated
+	odds, _, ok = NewOddsCalc(Holdem, WithPocketsBoard(pockets, Must("Qh 7c 2d 9s")), WithMonteCarlo(1000)).Calc(context.Background())
+	if !ok || !odds.Exact() || odds.Outcomes != 42 {
+		t.Errorf("expected exact odds with 42 outcomes, got: %t %d", odds.Exact(), odds.Outcomes)
+	}
+}
+
+func TestOddsCalcWorkers(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		pockets []string
+		board   string
+	}{
+		{Holdem, []string{"As Ad", "Ks Kd", "7h 8h"}, "Qh 7c 2d"},
+		{Holdem, []string{"As Ad", "Ks Kd"}, ""},
+		{OmahaHiLo, []string{"As 2s Kd Kc", "Ah 3h Qd Qc"}, "Kh 4d 8c"},
+		{Holdem, []string{"As Ad", "Ks Kd"}, "Qh 7c 2d 9s 3h"},
+	}
+	for i, test := range tests {
+		pockets := make([][]Card, len(test.pockets))
+		for j, s := range test.pockets {
+			pockets[j] = Must(s)
+		}
+		calc := func(opts ...CalcOption) (*Odds, *Odds) {
+			hi, lo, ok := NewOddsCalc(test.typ, append(opts, WithPocketsBoard(pockets, Must(test.board)), WithDeep(true))...).Calc(context.Background())
+			if !ok {
+				t.Fatalf("test %d expected ok", i)
+			}
+			return hi, lo
+		}
+		hi, lo := calc()
+		for _, workers := range []int{0, 2, 16} {
+			h, l := calc(WithWorkers(workers))
+			switch {
+			case !reflect.DeepEqual(h.Counts, hi.Counts), !reflect.DeepEqual(h.Wins, hi.Wins), !reflect.DeepEqual(h.Outs, hi.Outs):
+				t.Errorf("test %d %d workers expected hi %v, got: %v", i, workers, hi.Counts, h.Counts)
+			case h.Outcomes != hi.Outcomes, h.Runouts != hi.Runouts, math.Abs(h.Share(0)-hi.Share(0)) > 1e-9:
+				t.Errorf("test %d %d workers expected hi %d/%d outcomes, got: %d/%d", i, workers, hi.Outcomes, hi.Runouts, h.Outcomes, h.Runouts)
+			case lo != nil && !reflect.DeepEqual(l.Counts, lo.Counts):
+				t.Errorf("test %d %d workers expected lo %v, got: %v", i, workers, lo.Counts, l.Counts)
+			}
+		}
+	}
+}
+
+func TestOddsCalcDrawingDead(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		pockets []string
+		board   string
+		exp     []int
+	}{
+		{Holdem, []string{"Ac Ad", "Ks Kd"}, "Ah As 7c", []int{0}},
+		{Holdem, []string{"Ac Ad", "Ks Kd", "7h 7d"}, "Ah As 7c", []int{0}},
+		{Holdem, []string{"Ac Ad", "Ks Kd", "8c 9c"}, "Ah As 7c", []int{0, 2}},
+		{Holdem, []string{"Ac Ad", "Ks Kd"}, "Ah As 7c 2d", []int{0}},
+		{Omaha, []string{"Ac Ad Kc Kd", "7s 7d 6s 6d"}, "Ah As 2c", []int{0}},
+		{Omaha, []string{"Ac Ad Kc Kd", "Qs Qd Js Jd"}, "Ah Ts Kh", []int{0, 1}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			pockets := make([][]Card, len(test.pockets))
+			for j, s := range test.pockets {
+				pockets[j] = Must(s)
+			}
+			c := NewOddsCalc(test.typ, WithPocketsBoard(pockets, Must(test.board)))
+			run := c.runs[0].Dupe()
+			offset, k := len(run.Hi), test.typ.Board()-len(run.Hi)
+			run.Hi = append(run.Hi, make([]Card, k)...)
+			if v := c.live(run, c.u(), offset, k).Slice(); !slices.Equal(v, test.exp) {
+				t.Errorf("expected %v, got: %v", test.exp, v)
+			}
+		})
+	}
+}
+
+func TestOddsCalcSingleActive(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		pockets [][]Card
+	}{
+		{Holdem, [][]Card{Must("As 2s"), Must("Ks Kd")}},
+		{OmahaHiLo, [][]Card{Must("As 2s 3d Kc"), Must("Ks Kd 7h 8h")}},
+	}
+	board := Must("4c 5h Jd")
+	for _, test := range tests {
+		typ, pockets := test.typ, test.pockets
+		var exp [2]int
+		for i, active := range []Positions{PositionsOf(0), AllPositions.Without(1)} {
+			hi, lo, ok := typ.Odds(context.Background(), pockets, board, WithActive(active, false))
+			switch {
+			case !ok:
+				t.Fatalf("%s %d expected ok", typ, i)
+			case hi.Counts[0] != hi.Total || hi.Counts[1] != 0:
+				t.Errorf("%s %d expected position 0 to win every hi, got: %v/%d", typ, i, hi.Counts, hi.Total)
+			case typ.Low() && (lo == nil || lo.Total == 0 || lo.Counts[1] != 0):
+				t.Errorf("%s %d expected position 0 lo only, got: %v", typ, i, lo)
+			}
+			if i == 0 {
+				exp[0] = hi.Total
+				if lo != nil {
+					exp[1] = lo.Total
+				}
+			} else if hi.Total != exp[0] || lo != nil && lo.Total != exp[1] {
+				t.Errorf("%s expected totals %v, got: %d %v", typ, exp, hi.Total, lo)
+			}
+		}
+	}
+}
+
+func TestOddsCalcBrute(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, typ := range []Type{Holdem, Short, Omaha, Manila} {
+		for n := range 20 {
+			pockets, board := typ.Deal(r, 1, 2+n%4)
+			board = board[:3+n%2]
+			odds, _, ok := typ.Odds(context.Background(), pockets, board)
+			if !ok {
+				t.Fatalf("%s expected ok", typ)
+			}
+			counts, total := make([]int, len(pockets)), 0
+			wins, chops, outcomes := make([]int, len(pockets)), make([]int, len(pockets)), 0
+			u := typ.DeckType().Exclude(append(pockets, board)...)
+			for g, v := NewCombinGen(u, typ.Board()-len(board)); g.Next(); {
+				b := append(slices.Clone(board), v...)
+				order, pivot := Order(typ.EvalPockets(pockets, b), false)
+				for _, i := range order[:pivot] {
+					counts[i]++
+					if pivot == 1 {
+						wins[i]++
+					} else {
+						chops[i]++
+					}
+				}
+				total, outcomes = total+pivot, outcomes+1
+			}
+			if !slices.Equal(odds.Counts, counts) || odds.Total != total {
+				t.Errorf("%s %v %v expected %v/%d, got: %v/%d", typ, pockets, board, counts, total, odds.Counts, odds.Total)
+			}
+			if !slices.Equal(odds.Wins, wins) || !slices.Equal(odds.Chops, chops) || odds.Outcomes != outcomes {
+				t.Errorf("%s %v %v expected %v/%v/%d, got: %v/%v/%d", typ, pockets, board, wins, chops, outcomes, odds.Wins, odds.Chops, odds.Outcomes)
+			}
+			for i := range pockets {
+				if f, exp := odds.AtLeastChop(i), float64(wins[i]+chops[i])/float64(outcomes); f != exp {
+					t.Errorf("%s expected %f, got: %f", typ, exp, f)
+				}
+			}
+		}
+	}
+}
+
+func TestOddsOutright(t *testing.T) {
+	pockets, board := [][]Card{Must("As Kd"), Must("Ac Kh")}, Must("2s 3s Qd")
+	hi, lo, ok := Holdem.Odds(context.Background(), pockets, board)
+	if !ok || lo != nil {
+		t.Fatalf("expected ok")
+	}
+	// As Kd wins outright only with 2 spades
+	if f, exp := hi.Outright(0), 45.0/990; math.Abs(f-exp) > 1e-9 {
+		t.Errorf("expected %f, got: %f", exp, f)
+	}
+	if f, exp := hi.Outright(1), 0.0; f != exp {
+		t.Errorf("expected %f, got: %f", exp, f)
+	}
+	if f, exp := hi.AtLeastChop(1), 1-45.0/990; math.Abs(f-exp) > 1e-9 {
+		t.Errorf("expected %f, got: %f", exp, f)
+	}
+	if f := hi.AtLeastChop(0); f != 1 {
+		t.Errorf("expected 1, got: %f", f)
+	}
+}
+
 func TestExpValueCalc(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -2,10 +2,12 @@ package cardrank
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 )
 
@@ -17,6 +19,36 @@ var AlternateEmoji = [4]string{
 	"☘️",
 }
 
+// twoColorANSI are the ANSI terminal color escape sequences for each suit
+// (spade, heart, diamond, club) in a traditional 2-color deck.
+var twoColorANSI = [4]string{
+	"\x1b[39m",
+	"\x1b[31m",
+	"\x1b[31m",
+	"\x1b[39m",
+}
+
+// fourColorANSI are the ANSI terminal color escape sequences for each suit
+// (spade, heart, diamond, club) in a 4-color deck.
+var fourColorANSI = [4]string{
+	"\x1b[39m",
+	"\x1b[31m",
+	"\x1b[34m",
+	"\x1b[32m",
+}
+
+// blackGlyphs are the black unicode pip glyphs for each suit (spade, heart,
+// diamond, club).
+var blackGlyphs = [4]string{
+	"♠",
+	"♥",
+	"♦",
+	"♣",
+}
+
+// ANSIReset is the ANSI terminal escape sequence to reset colors.
+const ANSIReset = "\x1b[0m"
+
 // Rank is a card rank.
 type Rank uint8
 
@@ -118,6 +150,9 @@ func (rank Rank) Index() int {
 
 // Name returns the card rank name.
 func (rank Rank) Name() string {
+	if translator != nil {
+		return translator.RankName(rank, false)
+	}
 	switch rank {
 	case Ace:
 		return "Ace"
@@ -151,6 +186,9 @@ func (rank Rank) Name() string {
 
 // PluralName returns the card rank plural name.
 func (rank Rank) PluralName() string {
+	if translator != nil {
+		return translator.RankName(rank, true)
+	}
 	if rank == Six {
 		return "Sixes"
 	}
@@ -250,6 +288,9 @@ func (suit Suit) Index() int {
 
 // Name returns the card suit name.
 func (suit Suit) Name() string {
+	if translator != nil {
+		return translator.SuitName(suit, false)
+	}
 	switch suit {
 	case Spade:
 		return "Spade"
@@ -265,6 +306,9 @@ func (suit Suit) Name() string {
 
 // PluralName returns the card suit plural name.
 func (suit Suit) PluralName() string {
+	if translator != nil {
+		return translator.SuitName(suit, true)
+	}
 	return suit.Name() + "s"
 }
 
@@ -338,6 +382,7 @@ func New(rank Rank, suit Suit) Card {
 
 // FromRune creates a card from a unicode playing card rune.
 func FromRune(r rune) Card {
+	loadRanges()
 	switch {
 	case unicode.Is(rangeS, r):
 		return New(runeCardRank(r, UnicodeSpadeAce), Spade)
@@ -379,25 +424,105 @@ func FromIndex(i int) Card {
 // Accepts the following:
 //   - a rank followed by a suit (ex: "Ah", "ks", "10s", "Tc", "8d", "6c")
 //   - a rank followed by a white or black unicode suit pip (ex: "J♤", "K♠")
+//   - a rank followed by an emoji suit pip (ex: "A♥️")
 //   - unicode playing card runes (ex: "🃆", "🂣").
 //
-// Returns a single slice of all cards from all strings in v.
+// Cards may be separated by whitespace or commas, and lists may be enclosed
+// in brackets (ex: "[Ah Kd]", "Ah, Kd").
+//
+// Returns a single slice of all cards from all strings in v. Errors are of
+// type [*ParseError], with the position of the invalid card.
 func Parse(v ...string) ([]Card, error) {
+	return parse(ParseOptions{}, v...)
+}
+
+// ParseStrict parses the canonical string representation of [Card]'s
+// contained in v, as a rank (23456789TJQKA) followed by a suit (shdc) (ex:
+// "Ah", "Ks", "Tc"), with cards optionally separated by whitespace.
+//
+// Returns a single slice of all cards from all strings in v. Errors are of
+// type [*ParseError], with the position of the invalid card, and wrap
+// [ErrDuplicateCard] when a card is repeated.
+func ParseStrict(v ...string) ([]Card, error) {
+	return parse(ParseOptions{Strict: true, Unique: true}, v...)
+}
+
+// ParseOptions are card parsing options (see [ParseCards]).
+type ParseOptions struct {
+	// Strict accepts only the canonical string representation of cards (see
+	// [ParseStrict]). When false, all common representations are accepted,
+	// ignoring case (see [Parse]).
+	Strict bool
+	// Unique returns an error wrapping [ErrDuplicateCard] when a card is
+	// repeated.
+	Unique bool
+}
+
+// ParseCard parses a single card from s using the options, accepting the same
+// representations as [ParseCards] (ex: "Ah", "10h", "a♥", "🂱"). Returns a
+// [*ParseError] wrapping [ErrInvalidCard] when s does not contain exactly one
+// card.
+func ParseCard(opts ParseOptions, s string) (Card, error) {
+	v, err := parse(opts, s)
+	switch {
+	case err != nil:
+		return InvalidCard, err
+	case len(v) != 1:
+		return InvalidCard, &ParseError{
+			S:   s,
+			Err: ErrInvalidCard,
+		}
+	}
+	return v[0], nil
+}
+
+// ParseCards parses the cards contained in v using the options, allowing
+// tolerant parsing of cards from external sources, such as hand histories,
+// and strict parsing of canonical cards with the same API. See [Parse] for
+// the representations accepted when not strict, and [ParseStrict] for the
+// representation accepted when strict.
+//
+// Returns a single slice of all cards from all strings in v. Errors are of
+// type [*ParseError], with the position of the invalid card.
+func ParseCards(opts ParseOptions, v ...string) ([]Card, error) {
+	return parse(opts, v...)
+}
+
+// parse parses the cards in v.
+func parse(opts ParseOptions, v ...string) ([]Card, error) {
+	loadRanges()
+	strict := opts.Strict
 	var cards []Card
+	var seen map[Card]bool
+	if opts.Unique {
+		seen = make(map[Card]bool)
+	}
 	for n, s := range v {
 		for i, r := 0, []rune(s); i < len(r); i++ {
 			switch {
 			case unicode.IsSpace(r[i]):
 				continue
-			case unicode.Is(rangeA, r[i]):
+			case !strict && (r[i] == '[' || r[i] == ']' || r[i] == ','):
+				continue
+			case !strict && unicode.Is(rangeA, r[i]):
 				c := FromRune(r[i])
-				if c == InvalidCard {
+				switch {
+				case c == InvalidCard:
 					return nil, &ParseError{
 						S:   s,
 						N:   n,
 						I:   i,
 						Err: ErrInvalidCard,
 					}
+				case seen != nil && seen[c]:
+					return nil, &ParseError{
+						S:   s,
+						N:   n,
+						I:   i,
+						Err: ErrDuplicateCard,
+					}
+				case seen != nil:
+					seen[c] = true
 				}
 				cards = append(cards, c)
 				continue
@@ -409,22 +534,37 @@ func Parse(v ...string) ([]Card, error) {
 					Err: ErrInvalidCard,
 				}
 			}
-			c := r[i]
+			j, c := i, r[i]
 			// parse '10'
-			if 2 < len(r)-i && c == '1' && r[i+1] == '0' {
+			if !strict && 2 < len(r)-i && c == '1' && r[i+1] == '0' {
 				c, i = 'T', i+1
 			}
 			card := New(RankFromRune(c), SuitFromRune(r[i+1]))
-			if card == InvalidCard {
+			if card == InvalidCard || (strict && (c != rune(card.RankByte()) || r[i+1] != rune(card.SuitByte()))) {
 				return nil, &ParseError{
 					S:   s,
 					N:   n,
-					I:   i,
+					I:   j,
 					Err: ErrInvalidCard,
 				}
 			}
+			if seen != nil {
+				if seen[card] {
+					return nil, &ParseError{
+						S:   s,
+						N:   n,
+						I:   j,
+						Err: ErrDuplicateCard,
+					}
+				}
+				seen[card] = true
+			}
 			cards = append(cards, card)
 			i++
+			// skip emoji variation selector
+			if !strict && i+1 < len(r) && r[i+1] == '\ufe0f' {
+				i++
+			}
 		}
 	}
 	return cards, nil
@@ -485,6 +625,10 @@ func (c Card) AceRank() int {
 }
 
 // Rune returns the card's unicode playing card rune.
+//
+// The unicode playing card block contains a Knight between the [Jack] and
+// [Queen], and a Joker after the [King] for each suit, which are skipped for
+// all deck types (see [Card.KnightRune]).
 func (c Card) Rune() rune {
 	if c == InvalidCard {
 		return '0'
@@ -543,6 +687,11 @@ func (c Card) KnightRune() rune {
 	return v
 }
 
+// Valid returns true when the card is a valid card.
+func (c Card) Valid() bool {
+	return c != InvalidCard && New(c.Rank(), c.Suit()) == c
+}
+
 // UnmarshalText satisfies the [encoding.TextUnmarshaler] interface.
 func (c *Card) UnmarshalText(buf []byte) error {
 	if *c = FromString(string(buf)); *c == InvalidCard {
@@ -559,6 +708,55 @@ func (c Card) MarshalText() ([]byte, error) {
 	return nil, ErrInvalidCard
 }
 
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+func (c *Card) UnmarshalBinary(buf []byte) error {
+	if len(buf) != 1 || 52 <= buf[0] {
+		return ErrInvalidCard
+	}
+	*c = FromIndex(int(buf[0]))
+	return nil
+}
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface. The card
+// is encoded as a single byte containing the card's index (see [Card.Index]).
+func (c Card) MarshalBinary() ([]byte, error) {
+	if !c.Valid() {
+		return nil, ErrInvalidCard
+	}
+	return []byte{byte(c.Index())}, nil
+}
+
+// Scan satisfies the [sql.Scanner] interface. Scans the card from its binary
+// (see [Card.MarshalBinary]) or text representation, or from its integer
+// index. A NULL value scans as [InvalidCard].
+func (c *Card) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*c = InvalidCard
+		return nil
+	case []byte:
+		if len(v) == 1 {
+			return c.UnmarshalBinary(v)
+		}
+		return c.UnmarshalText(v)
+	case string:
+		return c.UnmarshalText([]byte(v))
+	case int64:
+		if v < 0 || 52 <= v {
+			return ErrInvalidCard
+		}
+		*c = FromIndex(int(v))
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into card: %w", src, ErrInvalidCard)
+}
+
+// Value satisfies the [driver.Valuer] interface. The card is stored using its
+// binary representation (see [Card.MarshalBinary]).
+func (c Card) Value() (driver.Value, error) {
+	return c.MarshalBinary()
+}
+
 // String satisfies the [fmt.Stringer] interface.
 func (c Card) String() string {
 	return string(c.RankByte()) + string(c.SuitByte())
@@ -594,6 +792,12 @@ func (c Card) String() string {
 //	L - plural suit name, title cased (Spades Hearts Diamonds Clubs)
 //	d - base 10 integer value
 //	F - straight flush rank name
+//
+// The '+' flag wraps the output with the suit's 2-color ANSI terminal color
+// (see [TwoColorStyle]), and the '#' flag wraps the output with the suit's
+// 4-color ANSI terminal color (see [FourColorStyle]). The color flags are
+// ignored for the d verb and for invalid cards. Use a [CardStyle] for other
+// colors or suit glyphs.
 func (c Card) Format(f fmt.State, verb rune) {
 	var buf []byte
 	switch verb {
@@ -659,6 +863,14 @@ func (c Card) Format(f fmt.State, verb rune) {
 			string(c.RankByte())+string(c.SuitByte()))...,
 		)
 	}
+	if verb != 'd' && c.Valid() {
+		switch {
+		case f.Flag('#'):
+			buf = append(append([]byte(fourColorANSI[c.Suit().Index()]), buf...), ANSIReset...)
+		case f.Flag('+'):
+			buf = append(append([]byte(twoColorANSI[c.Suit().Index()]), buf...), ANSIReset...)
+		}
+	}
 	_, _ = f.Write(buf)
 }
 
@@ -667,6 +879,9 @@ func (c Card) Format(f fmt.State, verb rune) {
 type Formatter []Card
 
 // Format satisfies the [fmt.Formatter] interface.
+//
+// Supports the same verbs and flags as [Card.Format], writing each card
+// separated by a space and enclosed in brackets.
 func (v Formatter) Format(f fmt.State, verb rune) {
 	_, _ = f.Write([]byte{'['})
 	for i, c := range v {
@@ -678,6 +893,74 @@ func (v Formatter) Format(f fmt.State, verb rune) {
 	_, _ = f.Write([]byte{']'})
 }
 
+// CardStyle is a card style, holding the ANSI terminal colors and suit glyphs
+// used to format cards. A zero style formats cards as with [Card.String].
+type CardStyle struct {
+	// Colors are the ANSI terminal color escape sequences for each suit
+	// (spade, heart, diamond, club). Empty colors are not written.
+	Colors [4]string
+	// Glyphs are the glyphs for each suit (spade, heart, diamond, club).
+	// Empty glyphs are written as the suit's byte (shdc).
+	Glyphs [4]string
+}
+
+// TwoColorStyle returns a card style using the traditional 2-color deck ANSI
+// terminal colors and the black unicode pip glyphs (♠♥♦♣).
+func TwoColorStyle() CardStyle {
+	return CardStyle{
+		Colors: twoColorANSI,
+		Glyphs: blackGlyphs,
+	}
+}
+
+// FourColorStyle returns a card style using the 4-color deck ANSI terminal
+// colors and the black unicode pip glyphs (♠♥♦♣).
+func FourColorStyle() CardStyle {
+	return CardStyle{
+		Colors: fourColorANSI,
+		Glyphs: blackGlyphs,
+	}
+}
+
+// Format formats the card's rank and suit glyph, wrapped with the suit's color
+// (ex: "\x1b[31mA♥\x1b[0m"). Invalid cards are formatted as with
+// [Card.String].
+func (style CardStyle) Format(c Card) string {
+	return string(style.append(nil, c))
+}
+
+// FormatCards formats the cards as with [CardStyle.Format], separated by a
+// space and enclosed in brackets.
+func (style CardStyle) FormatCards(v []Card) string {
+	buf := []byte{'['}
+	for i, c := range v {
+		if i != 0 {
+			buf = append(buf, ' ')
+		}
+		buf = style.append(buf, c)
+	}
+	return string(append(buf, ']'))
+}
+
+// append appends the formatted card to buf.
+func (style CardStyle) append(buf []byte, c Card) []byte {
+	if !c.Valid() {
+		return append(buf, c.String()...)
+	}
+	i := c.Suit().Index()
+	buf = append(buf, style.Colors[i]...)
+	buf = append(buf, c.RankByte())
+	if style.Glyphs[i] != "" {
+		buf = append(buf, style.Glyphs[i]...)
+	} else {
+		buf = append(buf, c.SuitByte())
+	}
+	if style.Colors[i] != "" {
+		buf = append(buf, ANSIReset...)
+	}
+	return buf
+}
+
 // Combine combines the cards in v.
 func (v Formatter) Combine() Card {
 	n := len(v)
@@ -763,6 +1046,10 @@ const (
 	UnicodeDiamondWhite rune = '♢'
 	UnicodeClubBlack    rune = '♣'
 	UnicodeClubWhite    rune = '♧'
+	UnicodeBack         rune = '🂠'
+	UnicodeRedJoker     rune = '🂿'
+	UnicodeBlackJoker   rune = '🃏'
+	UnicodeWhiteJoker   rune = '🃟'
 )
 
 // Exclude is returns v excluding any specified cards.
@@ -799,33 +1086,37 @@ func runeCardRank(rank, ace rune) Rank {
 	return r - 1
 }
 
-func init() {
-	s, h, d, c := make([]rune, 14), make([]rune, 14), make([]rune, 14), make([]rune, 14)
-	for i := range 14 {
-		s[i] = UnicodeSpadeAce + rune(i)
-		h[i] = UnicodeHeartAce + rune(i)
-		d[i] = UnicodeDiamondAce + rune(i)
-		c[i] = UnicodeClubAce + rune(i)
-	}
-	rangeS = newRangeTable(s...)
-	rangeH = newRangeTable(h...)
-	rangeD = newRangeTable(d...)
-	rangeC = newRangeTable(c...)
-	a := make([]rune, 14*4)
-	copy(a[0:14], s)
-	copy(a[14:28], h)
-	copy(a[28:42], d)
-	copy(a[42:56], c)
-	rangeA = newRangeTable(a...)
+// loadRanges loads the unicode playing card range tables on first use.
+func loadRanges() {
+	rangeOnce.Do(func() {
+		s, h, d, c := make([]rune, 14), make([]rune, 14), make([]rune, 14), make([]rune, 14)
+		for i := range 14 {
+			s[i] = UnicodeSpadeAce + rune(i)
+			h[i] = UnicodeHeartAce + rune(i)
+			d[i] = UnicodeDiamondAce + rune(i)
+			c[i] = UnicodeClubAce + rune(i)
+		}
+		rangeS = newRangeTable(s...)
+		rangeH = newRangeTable(h...)
+		rangeD = newRangeTable(d...)
+		rangeC = newRangeTable(c...)
+		a := make([]rune, 14*4)
+		copy(a[0:14], s)
+		copy(a[14:28], h)
+		copy(a[28:42], d)
+		copy(a[42:56], c)
+		rangeA = newRangeTable(a...)
+	})
 }
 
 // range tables for unicode playing card runes.
 var (
-	rangeS *unicode.RangeTable // spadees
-	rangeH *unicode.RangeTable // hearts
-	rangeD *unicode.RangeTable // diamonds
-	rangeC *unicode.RangeTable // clubs
-	rangeA *unicode.RangeTable // all
+	rangeS    *unicode.RangeTable // spadees
+	rangeH    *unicode.RangeTable // hearts
+	rangeD    *unicode.RangeTable // diamonds
+	rangeC    *unicode.RangeTable // clubs
+	rangeA    *unicode.RangeTable // all
+	rangeOnce sync.Once
 )
 
 // newRangeTable creates a range table for the passed runes.
@@ -25,6 +25,11 @@ func TestParse(t *testing.T) {
 		{"As Ks", []Card{New(Ace, Spade), New(King, Spade)}, nil},
 		{" 🂬   a♣  🃚  🂸  td ", []Card{New(Jack, Spade), New(Ace, Club), New(Ten, Club), New(Eight, Heart), New(Ten, Diamond)}, nil},
 		{"10D 10C 10S 10h", []Card{New(Ten, Diamond), New(Ten, Club), New(10, Spade), New(10, Heart)}, nil},
+		{"[Ah Kd]", []Card{New(Ace, Heart), New(King, Diamond)}, nil},
+		{"[Ah, 10d,qc]", []Card{New(Ace, Heart), New(Ten, Diamond), New(Queen, Club)}, nil},
+		{"A♥️ K♠️ 2♦", []Card{New(Ace, Heart), New(King, Spade), New(Two, Diamond)}, nil},
+		{"[Ah Kd", []Card{New(Ace, Heart), New(King, Diamond)}, nil},
+		{"[Ah Kx]", nil, ErrInvalidCard},
 	}
 	for i, test := range tests {
 		v, err := Parse(test.s)
@@ -45,6 +50,115 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+		i   int
+		err error
+	}{
+		{"", "", 0, nil},
+		{"AsKs", "As Ks", 0, nil},
+		{" Ah  Td 2c ", "Ah Td 2c", 0, nil},
+		{"Ah Kh Ah", "", 6, ErrDuplicateCard},
+		{"Ah 10d", "", 3, ErrInvalidCard},
+		{"Ah kd", "", 3, ErrInvalidCard},
+		{"Ah KD", "", 3, ErrInvalidCard},
+		{"Ah K♦", "", 3, ErrInvalidCard},
+		{"[Ah]", "", 0, ErrInvalidCard},
+		{"Ah,Kd", "", 2, ErrInvalidCard},
+		{"Ah 🂡", "", 3, ErrInvalidCard},
+		{"Ah K", "", 3, ErrInvalidCard},
+	}
+	for i, test := range tests {
+		v, err := ParseStrict(test.s)
+		switch {
+		case test.err == nil && err != nil:
+			t.Fatalf("test %d %q expected no error, got: %v", i, test.s, err)
+		case test.err != nil:
+			var perr *ParseError
+			switch {
+			case !errors.Is(err, test.err):
+				t.Errorf("test %d %q expected error %v, got: %v", i, test.s, test.err, err)
+			case !errors.As(err, &perr):
+				t.Errorf("test %d %q expected parse error, got: %T", i, test.s, err)
+			case perr.I != test.i:
+				t.Errorf("test %d %q expected position %d, got: %d", i, test.s, test.i, perr.I)
+			}
+			continue
+		}
+		if s := fmt.Sprintf("%s", Formatter(v)); s != "["+test.exp+"]" {
+			t.Errorf("test %d %q expected [%s], got: %s", i, test.s, test.exp, s)
+		}
+	}
+	var perr *ParseError
+	if _, err := Parse("Ah 10x"); !errors.As(err, &perr) || perr.I != 3 {
+		t.Errorf("expected parse error at position 3, got: %v", err)
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	tests := []struct {
+		s    string
+		opts ParseOptions
+		exp  string
+		err  error
+	}{
+		{"Ah 10h a♥ 🂱", ParseOptions{}, "Ah Th Ah Ah", nil},
+		{"Ah 10h a♥ 🂱", ParseOptions{Unique: true}, "", ErrDuplicateCard},
+		{"🂱 🂱", ParseOptions{Unique: true}, "", ErrDuplicateCard},
+		{"[kS, 10♣️, 2d]", ParseOptions{Unique: true}, "Ks Tc 2d", nil},
+		{"Ah Ah", ParseOptions{Strict: true}, "Ah Ah", nil},
+		{"Ah Ah", ParseOptions{Strict: true, Unique: true}, "", ErrDuplicateCard},
+		{"Ah 10h", ParseOptions{Strict: true}, "", ErrInvalidCard},
+		{"Ah Kx", ParseOptions{}, "", ErrInvalidCard},
+	}
+	for i, test := range tests {
+		v, err := ParseCards(test.opts, test.s)
+		switch {
+		case test.err != nil:
+			if !errors.Is(err, test.err) {
+				t.Errorf("test %d %q expected error %v, got: %v", i, test.s, test.err, err)
+			}
+			continue
+		case err != nil:
+			t.Fatalf("test %d %q expected no error, got: %v", i, test.s, err)
+		}
+		if s := fmt.Sprintf("%s", Formatter(v)); s != "["+test.exp+"]" {
+			t.Errorf("test %d %q expected [%s], got: %s", i, test.s, test.exp, s)
+		}
+	}
+}
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		s    string
+		opts ParseOptions
+		exp  Card
+		err  error
+	}{
+		{"Ah", ParseOptions{}, New(Ace, Heart), nil},
+		{" 10d ", ParseOptions{}, New(Ten, Diamond), nil},
+		{"q♠", ParseOptions{}, New(Queen, Spade), nil},
+		{"🃞", ParseOptions{}, New(King, Club), nil},
+		{"Td", ParseOptions{Strict: true}, New(Ten, Diamond), nil},
+		{"10d", ParseOptions{Strict: true}, InvalidCard, ErrInvalidCard},
+		{"", ParseOptions{}, InvalidCard, ErrInvalidCard},
+		{"Ah Kd", ParseOptions{}, InvalidCard, ErrInvalidCard},
+	}
+	for i, test := range tests {
+		c, err := ParseCard(test.opts, test.s)
+		switch {
+		case test.err != nil && !errors.Is(err, test.err):
+			t.Errorf("test %d %q expected error %v, got: %v", i, test.s, test.err, err)
+		case test.err == nil && err != nil:
+			t.Errorf("test %d %q expected no error, got: %v", i, test.s, err)
+		case c != test.exp:
+			t.Errorf("test %d %q expected %s, got: %s", i, test.s, test.exp, c)
+		}
+	}
+}
+
 func TestCardUnmarshal(t *testing.T) {
 	z := struct {
 		Card Card
@@ -278,3 +392,108 @@ func TestFormatterRanks(t *testing.T) {
 		}
 	}
 }
+
+func TestCardUnicode(t *testing.T) {
+	for _, typ := range []DeckType{DeckFrench, DeckShort, DeckManila, DeckSpanish, DeckEuchre, DeckRoyal, DeckKuhn, DeckLeduc} {
+		v := typ.Unshuffled()
+		var s string
+		for _, c := range v {
+			s += fmt.Sprintf("%c", c)
+		}
+		if n, exp := len([]rune(s)), len(v); n != exp {
+			t.Fatalf("%s expected %d runes, got: %d", typ, exp, n)
+		}
+		for _, r := range s {
+			switch r - (r & ^rune(0xf)) {
+			case 0x0, 0xc, 0xf:
+				t.Errorf("%s expected no back, knight, or joker rune, got: %c (%U)", typ, r, r)
+			}
+		}
+		u, err := Parse(s)
+		if err != nil {
+			t.Fatalf("%s expected no error, got: %v", typ, err)
+		}
+		if !slices.Equal(u, v) {
+			t.Errorf("%s expected %v, got: %v", typ, v, u)
+		}
+	}
+	if s, exp := fmt.Sprintf("%c", Formatter([]Card{New(Ace, Spade), New(King, Heart)})), "[🂡 🂾]"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := fmt.Sprintf("%C", New(Jack, Club)), "🃜"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	for _, r := range []rune{UnicodeBack, UnicodeRedJoker, UnicodeBlackJoker, UnicodeWhiteJoker} {
+		if c := FromRune(r); c != InvalidCard {
+			t.Errorf("expected %c to be invalid, got: %s", r, c)
+		}
+	}
+}
+
+func TestCardFormatANSI(t *testing.T) {
+	tests := []struct {
+		s   string
+		f   string
+		exp string
+	}{
+		{"As", "%+b", "\x1b[39mA♠\x1b[0m"},
+		{"Kh", "%+s", "\x1b[31mKh\x1b[0m"},
+		{"Qd", "%+h", "\x1b[31mQ♢\x1b[0m"},
+		{"Jc", "%+b", "\x1b[39mJ♣\x1b[0m"},
+		{"As", "%#b", "\x1b[39mA♠\x1b[0m"},
+		{"Kh", "%#b", "\x1b[31mK♥\x1b[0m"},
+		{"Qd", "%#e", "\x1b[34mQ♦️\x1b[0m"},
+		{"Jc", "%#s", "\x1b[32mJc\x1b[0m"},
+		{"Jc", "%#+s", "\x1b[32mJc\x1b[0m"},
+		{"Jc", "%+d", "33589533"},
+	}
+	for i, test := range tests {
+		c := FromString(test.s)
+		if s := fmt.Sprintf(test.f, c); s != test.exp {
+			t.Errorf("test %d %s %s expected %q, got: %q", i, test.s, test.f, test.exp, s)
+		}
+	}
+	v := Must("Ah 2c")
+	if s, exp := fmt.Sprintf("%#b", Formatter(v)), "[\x1b[31mA♥\x1b[0m \x1b[32m2♣\x1b[0m]"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := fmt.Sprintf("%+b", InvalidCard), fmt.Sprintf("%b", InvalidCard); s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
+
+func TestCardStyle(t *testing.T) {
+	alternate := FourColorStyle()
+	alternate.Glyphs = AlternateEmoji
+	tests := []struct {
+		style CardStyle
+		s     string
+		exp   string
+	}{
+		{CardStyle{}, "As", "As"},
+		{CardStyle{}, "Kh", "Kh"},
+		{TwoColorStyle(), "Kh", "\x1b[31mK♥\x1b[0m"},
+		{TwoColorStyle(), "Jc", "\x1b[39mJ♣\x1b[0m"},
+		{FourColorStyle(), "Qd", "\x1b[34mQ♦\x1b[0m"},
+		{FourColorStyle(), "Jc", "\x1b[32mJ♣\x1b[0m"},
+		{alternate, "Qd", "\x1b[34mQ🔷\x1b[0m"},
+		{CardStyle{Glyphs: [4]string{"S", "H", "D", "C"}}, "Ah", "AH"},
+		{CardStyle{Colors: [4]string{"<", "<", "<", "<"}}, "2s", "<2s\x1b[0m"},
+	}
+	for i, test := range tests {
+		c := FromString(test.s)
+		if s := test.style.Format(c); s != test.exp {
+			t.Errorf("test %d %s expected %q, got: %q", i, test.s, test.exp, s)
+		}
+	}
+	style := FourColorStyle()
+	if s, exp := style.FormatCards(Must("Ah 2c")), "[\x1b[31mA♥\x1b[0m \x1b[32m2♣\x1b[0m]"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := style.FormatCards(nil), "[]"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := style.Format(InvalidCard), InvalidCard.String(); s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
@@ -7,6 +7,7 @@ package cardrank
 
 import (
 	"sort"
+	"sync"
 	"unicode"
 )
 
@@ -27,21 +28,54 @@ var (
 
 	// evals are eval funcs.
 	evals = make(map[Type]EvalFunc)
+
+	// initOnce is the package init once.
+	initOnce sync.Once
+	initErr  error
 )
 
-// Init inits the package level default variables. Must be manually called
-// prior to using the package when built with the [noinit] build tag.
+// Init inits the package level default variables, setting [RankCactus] (when
+// not already set) and registering any default types not already registered.
+// Must be manually called prior to using the package when built with the
+// [noinit] build tag. Panics when a default type cannot be registered (see
+// [InitErr]).
+//
+// Safe for concurrent use. Only the first call initializes the package.
+// Lookup tables and decks are built lazily on first use, and are not built by
+// Init.
 func Init() {
-	if RankCactus == nil {
-		switch {
-		case cactusFast != nil:
-			RankCactus = cactusFast
-		case cactus != nil:
-			RankCactus = cactus
-		}
+	if err := InitErr(); err != nil {
+		panic(err)
 	}
 }
 
+// InitErr inits the package level default variables, returning any error
+// encountered registering the default types. Subsequent calls return the first
+// call's error.
+//
+// See [Init].
+func InitErr() error {
+	initOnce.Do(func() {
+		if RankCactus == nil {
+			switch {
+			case cactusFast != nil:
+				RankCactus = cactusFast
+			case cactus != nil:
+				RankCactus = cactus
+			}
+		}
+		for _, desc := range DefaultTypes() {
+			if _, ok := descs[desc.Type]; ok {
+				continue
+			}
+			if initErr = RegisterType(desc); initErr != nil {
+				return
+			}
+		}
+	})
+	return initErr
+}
+
 // RegisterDefaultTypes registers default types.
 //
 // See [DefaultTypes].
@@ -73,8 +107,9 @@ func RegisterType(desc TypeDesc) error {
 	return nil
 }
 
-// Types returns registered types.
-func Types() []Type {
+// Types returns registered types in registration order, optionally
+// filtered to the types matching all the filters.
+func Types(filters ...TypeFilter) []Type {
 	var v []TypeDesc
 	for _, desc := range descs {
 		v = append(v, desc)
@@ -82,13 +117,72 @@ func Types() []Type {
 	sort.Slice(v, func(i, j int) bool {
 		return v[i].Num < v[j].Num
 	})
-	types := make([]Type, len(v))
-	for i := range len(types) {
-		types[i] = v[i].Type
+	var types []Type
+loop:
+	for _, desc := range v {
+		for _, f := range filters {
+			if !f(desc.Type) {
+				continue loop
+			}
+		}
+		types = append(types, desc.Type)
 	}
 	return types
 }
 
+// TypeFilter is a type filter for [Types].
+type TypeFilter func(Type) bool
+
+// FilterDeck is a type filter for types using the deck.
+func FilterDeck(deck DeckType) TypeFilter {
+	return func(typ Type) bool {
+		return typ.DeckType() == deck
+	}
+}
+
+// FilterLow is a type filter for types with (or without) a Lo eval.
+func FilterLow(low bool) TypeFilter {
+	return func(typ Type) bool {
+		return typ.Low() == low
+	}
+}
+
+// FilterDouble is a type filter for types with (or without) double boards.
+func FilterDouble(double bool) TypeFilter {
+	return func(typ Type) bool {
+		return typ.Double() == double
+	}
+}
+
+// FilterPocket is a type filter for types dealing the number of pocket
+// cards.
+func FilterPocket(pocket int) TypeFilter {
+	return func(typ Type) bool {
+		return typ.Pocket() == pocket
+	}
+}
+
+// FilterBoard is a type filter for types with (or without) a board.
+func FilterBoard(board bool) TypeFilter {
+	return func(typ Type) bool {
+		return (0 < typ.Board()) == board
+	}
+}
+
+// FilterDraw is a type filter for types with (or without) draws.
+func FilterDraw(draw bool) TypeFilter {
+	return func(typ Type) bool {
+		return typ.Draw() == draw
+	}
+}
+
+// FilterCactus is a type filter for types with (or without) a Cactus eval.
+func FilterCactus(cactus bool) TypeFilter {
+	return func(typ Type) bool {
+		return typ.Cactus() == cactus
+	}
+}
+
 // Error is a error.
 type Error string
 
@@ -107,6 +201,38 @@ const (
 	ErrInvalidCard Error = "invalid card"
 	// ErrInvalidType is the invalid type error.
 	ErrInvalidType Error = "invalid type"
+	// ErrInvalidPocket is the invalid pocket error.
+	ErrInvalidPocket Error = "invalid pocket"
+	// ErrDuplicateCard is the duplicate card error.
+	ErrDuplicateCard Error = "duplicate card"
+	// ErrEmptyRange is the empty range error.
+	ErrEmptyRange Error = "empty range"
+	// ErrRangeConflict is the range conflict error.
+	ErrRangeConflict Error = "range conflict"
+	// ErrInvalidData is the invalid data error.
+	ErrInvalidData Error = "invalid data"
+	// ErrInvalidBoard is the invalid board error.
+	ErrInvalidBoard Error = "invalid board"
+	// ErrInvalidAction is the invalid action error.
+	ErrInvalidAction Error = "invalid action"
+	// ErrInvalidStreet is the invalid street error.
+	ErrInvalidStreet Error = "invalid street"
+	// ErrNotEnoughCards is the not enough cards error.
+	ErrNotEnoughCards Error = "not enough cards"
+	// ErrInvalidHandName is the invalid hand name error.
+	ErrInvalidHandName Error = "invalid hand name"
+	// ErrWrongPocketSize is the wrong pocket size error.
+	ErrWrongPocketSize Error = "wrong pocket size"
+	// ErrWrongBoardSize is the wrong board size error.
+	ErrWrongBoardSize Error = "wrong board size"
+	// ErrCardNotInDeck is the card not in deck error.
+	ErrCardNotInDeck Error = "card not in deck"
+	// ErrInvalidCount is the invalid count error.
+	ErrInvalidCount Error = "invalid count"
+	// ErrInvalidAudit is the invalid audit error.
+	ErrInvalidAudit Error = "invalid audit"
+	// ErrEvalMismatch is the eval mismatch error.
+	ErrEvalMismatch Error = "eval mismatch"
 )
 
 // primes are the first 13 prime numbers (one per card rank).
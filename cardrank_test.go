@@ -0,0 +1,31 @@
+package cardrank
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestInit(t *testing.T) {
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range len(errs) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = InitErr()
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("%d expected no error, got: %v", i, err)
+		}
+	}
+	Init()
+	if RankCactus == nil {
+		t.Errorf("expected RankCactus to be set")
+	}
+	if n, exp := len(Types()), len(DefaultTypes()); n < exp {
+		t.Errorf("expected at least %d types, got: %d", exp, n)
+	}
+}
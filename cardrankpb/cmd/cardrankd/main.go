@@ -0,0 +1,26 @@
+// Command cardrankd runs the cardrank gRPC service.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/cardrank/cardrank/cardrankpb"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("l", ":50051", "listen address")
+	flag.Parse()
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := grpc.NewServer()
+	cardrankpb.RegisterCardrankServiceServer(s, cardrankpb.NewServer())
+	log.Printf("listening on %s", lis.Addr())
+	if err := s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,293 @@
+package cardrankpb
+
+import (
+	"sort"
+
+	"github.com/cardrank/cardrank"
+)
+
+// FromCard converts a card.
+func FromCard(c cardrank.Card) *Card {
+	if !c.Valid() {
+		return &Card{}
+	}
+	return &Card{
+		Rank: Rank(c.Rank().Index() + 1),
+		Suit: Suit(c.Suit().Index() + 1),
+	}
+}
+
+// ToCard converts the card, returning [cardrank.InvalidCard] when the rank or
+// suit is not specified.
+func (c *Card) ToCard() cardrank.Card {
+	r, s := c.GetRank(), c.GetSuit()
+	if r < Rank_RANK_TWO || Rank_RANK_ACE < r || s < Suit_SUIT_SPADE || Suit_SUIT_CLUB < s {
+		return cardrank.InvalidCard
+	}
+	return cardrank.New(cardrank.Rank(r-1), cardrank.Suit(1<<(s-1)))
+}
+
+// FromCards converts cards.
+func FromCards(v []cardrank.Card) []*Card {
+	if v == nil {
+		return nil
+	}
+	cards := make([]*Card, len(v))
+	for i, c := range v {
+		cards[i] = FromCard(c)
+	}
+	return cards
+}
+
+// ToCards converts cards.
+func ToCards(v []*Card) []cardrank.Card {
+	if v == nil {
+		return nil
+	}
+	cards := make([]cardrank.Card, len(v))
+	for i, c := range v {
+		cards[i] = c.ToCard()
+	}
+	return cards
+}
+
+// FromPockets converts pockets.
+func FromPockets(v [][]cardrank.Card) []*Cards {
+	if v == nil {
+		return nil
+	}
+	pockets := make([]*Cards, len(v))
+	for i, pocket := range v {
+		pockets[i] = &Cards{Cards: FromCards(pocket)}
+	}
+	return pockets
+}
+
+// ToPockets converts pockets.
+func ToPockets(v []*Cards) [][]cardrank.Card {
+	if v == nil {
+		return nil
+	}
+	pockets := make([][]cardrank.Card, len(v))
+	for i, pocket := range v {
+		pockets[i] = ToCards(pocket.GetCards())
+	}
+	return pockets
+}
+
+// FromEval converts an eval. Returns nil when ev is nil.
+func FromEval(ev *cardrank.Eval) *Eval {
+	if ev == nil {
+		return nil
+	}
+	return &Eval{
+		Type:     ev.Type.Id(),
+		HiRank:   uint32(ev.HiRank),
+		HiBest:   FromCards(ev.HiBest),
+		HiUnused: FromCards(ev.HiUnused),
+		LoRank:   uint32(ev.LoRank),
+		LoBest:   FromCards(ev.LoBest),
+		LoUnused: FromCards(ev.LoUnused),
+	}
+}
+
+// ToEval converts the eval. Returns nil when ev is nil.
+func (ev *Eval) ToEval() (*cardrank.Eval, error) {
+	if ev == nil {
+		return nil, nil
+	}
+	typ, err := cardrank.IdToType(ev.GetType())
+	if err != nil {
+		return nil, err
+	}
+	return &cardrank.Eval{
+		Type:     typ,
+		HiRank:   cardrank.EvalRank(ev.GetHiRank()),
+		HiBest:   ToCards(ev.GetHiBest()),
+		HiUnused: ToCards(ev.GetHiUnused()),
+		LoRank:   cardrank.EvalRank(ev.GetLoRank()),
+		LoBest:   ToCards(ev.GetLoBest()),
+		LoUnused: ToCards(ev.GetLoUnused()),
+	}, nil
+}
+
+// FromRun converts a run.
+func FromRun(run *cardrank.Run) *Run {
+	if run == nil {
+		return nil
+	}
+	return &Run{
+		Discard: FromCards(run.Discard),
+		Pockets: FromPockets(run.Pockets),
+		Hi:      FromCards(run.Hi),
+		Lo:      FromCards(run.Lo),
+	}
+}
+
+// ToRun converts the run.
+func (run *Run) ToRun() *cardrank.Run {
+	if run == nil {
+		return nil
+	}
+	return &cardrank.Run{
+		Discard: ToCards(run.GetDiscard()),
+		Pockets: ToPockets(run.GetPockets()),
+		Hi:      ToCards(run.GetHi()),
+		Lo:      ToCards(run.GetLo()),
+	}
+}
+
+// FromResult converts a result. Nil evals (ie, for inactive positions) are
+// converted as empty evals.
+func FromResult(res *cardrank.Result) *Result {
+	if res == nil {
+		return nil
+	}
+	evs := make([]*Eval, len(res.Evals))
+	for i, ev := range res.Evals {
+		if evs[i] = FromEval(ev); evs[i] == nil {
+			evs[i] = &Eval{}
+		}
+	}
+	return &Result{
+		Evals:   evs,
+		HiOrder: fromInts(res.HiOrder),
+		HiPivot: int32(res.HiPivot),
+		LoOrder: fromInts(res.LoOrder),
+		LoPivot: int32(res.LoPivot),
+	}
+}
+
+// ToResult converts the result. Empty evals are converted as nil.
+func (res *Result) ToResult() (*cardrank.Result, error) {
+	if res == nil {
+		return nil, nil
+	}
+	evs := make([]*cardrank.Eval, len(res.GetEvals()))
+	for i, ev := range res.GetEvals() {
+		if ev.GetType() == "" {
+			continue
+		}
+		var err error
+		if evs[i], err = ev.ToEval(); err != nil {
+			return nil, err
+		}
+	}
+	return &cardrank.Result{
+		Evals:   evs,
+		HiOrder: toInts(res.GetHiOrder()),
+		HiPivot: int(res.GetHiPivot()),
+		LoOrder: toInts(res.GetLoOrder()),
+		LoPivot: int(res.GetLoPivot()),
+	}, nil
+}
+
+// FromOdds converts odds.
+func FromOdds(odds *cardrank.Odds) *Odds {
+	if odds == nil {
+		return nil
+	}
+	counts := make([]int64, len(odds.Counts))
+	for i, n := range odds.Counts {
+		counts[i] = int64(n)
+	}
+	outs := make([]*Cards, len(odds.Outs))
+	for i, m := range odds.Outs {
+		v := make([]cardrank.Card, 0, len(m))
+		for c, ok := range m {
+			if ok {
+				v = append(v, c)
+			}
+		}
+		sort.Slice(v, func(j, k int) bool {
+			return v[j].Index() < v[k].Index()
+		})
+		outs[i] = &Cards{Cards: FromCards(v)}
+	}
+	return &Odds{
+		Total:  int64(odds.Total),
+		Counts: counts,
+		Outs:   outs,
+	}
+}
+
+// ToOdds converts the odds.
+func (odds *Odds) ToOdds() *cardrank.Odds {
+	if odds == nil {
+		return nil
+	}
+	counts := make([]int, len(odds.GetCounts()))
+	for i, n := range odds.GetCounts() {
+		counts[i] = int(n)
+	}
+	outs := make([]map[cardrank.Card]bool, len(odds.GetOuts()))
+	for i, v := range odds.GetOuts() {
+		outs[i] = make(map[cardrank.Card]bool)
+		for _, c := range v.GetCards() {
+			outs[i][c.ToCard()] = true
+		}
+	}
+	return &cardrank.Odds{
+		Total:  int(odds.GetTotal()),
+		Counts: counts,
+		Outs:   outs,
+	}
+}
+
+// FromDealer converts the dealer's state.
+func FromDealer(d *cardrank.Dealer) *DealerState {
+	if d == nil {
+		return nil
+	}
+	state := &DealerState{
+		Type:   d.Type.Id(),
+		Count:  int32(d.Count),
+		Street: int32(d.Street()),
+	}
+	if id := d.Id(); id != 0 {
+		state.StreetId = string([]byte{id})
+	}
+	if d.Deck != nil {
+		v := d.Deck.All()
+		state.Deck = &Deck{
+			Cards:    FromCards(v),
+			Position: int32(len(v) - d.Deck.Remaining()),
+		}
+	}
+	for i := range d.Count {
+		if d.Active.Has(i) {
+			state.Active = append(state.Active, int32(i))
+		}
+	}
+	for _, run := range d.Runs {
+		state.Runs = append(state.Runs, FromRun(run))
+	}
+	for _, res := range d.Results {
+		state.Results = append(state.Results, FromResult(res))
+	}
+	return state
+}
+
+// fromInts converts ints.
+func fromInts(v []int) []int32 {
+	if v == nil {
+		return nil
+	}
+	s := make([]int32, len(v))
+	for i, n := range v {
+		s[i] = int32(n)
+	}
+	return s
+}
+
+// toInts converts ints.
+func toInts(v []int32) []int {
+	if v == nil {
+		return nil
+	}
+	s := make([]int, len(v))
+	for i, n := range v {
+		s[i] = int(n)
+	}
+	return s
+}
@@ -0,0 +1,119 @@
+package cardrankpb
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCard(t *testing.T) {
+	for _, c := range cardrank.DeckFrench.Unshuffled() {
+		buf, err := proto.Marshal(FromCard(c))
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		var u Card
+		if err := proto.Unmarshal(buf, &u); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if d := u.ToCard(); d != c {
+			t.Errorf("expected %s, got: %s", c, d)
+		}
+	}
+	if c := (&Card{}).ToCard(); c != cardrank.InvalidCard {
+		t.Errorf("expected invalid card, got: %s", c)
+	}
+	if c := FromCard(cardrank.InvalidCard).ToCard(); c != cardrank.InvalidCard {
+		t.Errorf("expected invalid card, got: %s", c)
+	}
+}
+
+func TestEval(t *testing.T) {
+	ev := cardrank.OmahaHiLo.Eval(cardrank.Must("As 2s Kh Qh"), cardrank.Must("3c 4d 5h Jd 9c"))
+	buf, err := proto.Marshal(FromEval(ev))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var u Eval
+	if err := proto.Unmarshal(buf, &u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	v, err := u.ToEval()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if v.Type != ev.Type || v.HiRank != ev.HiRank || v.LoRank != ev.LoRank {
+		t.Errorf("expected %v, got: %v", ev, v)
+	}
+	if !slices.Equal(v.HiBest, ev.HiBest) || !slices.Equal(v.LoBest, ev.LoBest) || !slices.Equal(v.LoUnused, ev.LoUnused) {
+		t.Errorf("expected %v, got: %v", ev, v)
+	}
+}
+
+func TestOdds(t *testing.T) {
+	odds, _, ok := cardrank.Holdem.Odds(context.Background(), [][]cardrank.Card{
+		cardrank.Must("Ah Ad"),
+		cardrank.Must("Ks Kh"),
+	}, cardrank.Must("2c 7d 9h"))
+	if !ok {
+		t.Fatalf("expected ok")
+	}
+	u := FromOdds(odds).ToOdds()
+	if u.Total != odds.Total || !slices.Equal(u.Counts, odds.Counts) {
+		t.Errorf("expected %v, got: %v", odds, u)
+	}
+	for i := range odds.Outs {
+		if n, exp := len(u.Outs[i]), len(odds.Outs[i]); n != exp {
+			t.Errorf("expected %d outs, got: %d", exp, n)
+		}
+	}
+}
+
+func TestDealer(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	d := cardrank.Holdem.Dealer(r, 1, 3)
+	d.Deactivate(1)
+	for d.Next() {
+	}
+	for d.NextResult() {
+	}
+	state := FromDealer(d)
+	buf, err := proto.Marshal(state)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var u DealerState
+	if err := proto.Unmarshal(buf, &u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if u.GetType() != "Hh" || u.GetCount() != 3 || !slices.Equal(u.GetActive(), []int32{0, 2}) {
+		t.Errorf("unexpected state: %v", &u)
+	}
+	if n, exp := int(u.GetDeck().GetPosition()), 52-d.Deck.Remaining(); n != exp {
+		t.Errorf("expected position %d, got: %d", exp, n)
+	}
+	if n := len(u.GetRuns()); n != 1 {
+		t.Fatalf("expected 1 run, got: %d", n)
+	}
+	run := u.GetRuns()[0].ToRun()
+	if !slices.Equal(run.Hi, d.Runs[0].Hi) || !slices.Equal(run.Pockets[0], d.Runs[0].Pockets[0]) {
+		t.Errorf("expected %v, got: %v", d.Runs[0], run)
+	}
+	if n := len(u.GetResults()); n != 1 {
+		t.Fatalf("expected 1 result, got: %d", n)
+	}
+	res, err := u.GetResults()[0].ToResult()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if res.Evals[1] != nil {
+		t.Errorf("expected nil eval for inactive position, got: %v", res.Evals[1])
+	}
+	if exp := d.Results[0]; res.HiPivot != exp.HiPivot || !slices.Equal(res.HiOrder, exp.HiOrder) {
+		t.Errorf("expected %v, got: %v", exp, res)
+	}
+}
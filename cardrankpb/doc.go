@@ -0,0 +1,10 @@
+// Package cardrankpb contains protobuf messages for the cardrank package types,
+// and conversion functions to and from the cardrank types, for serializing
+// cards, evals, runs, results, odds, and dealer state, along with a gRPC
+// service implementation (see [Server]) for evaluation, odds, and dealing.
+//
+// The cardrankpb package is a separate module, keeping the cardrank package
+// free of dependencies.
+package cardrankpb
+
+//go:generate buf generate
@@ -0,0 +1,134 @@
+package cardrankpb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/cardrank/cardrank"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a [CardrankServiceServer] implementation.
+type Server struct {
+	UnimplementedCardrankServiceServer
+}
+
+// NewServer creates a new server.
+func NewServer() *Server {
+	return new(Server)
+}
+
+// EvalHand satisfies the [CardrankServiceServer] interface.
+func (s *Server) EvalHand(_ context.Context, req *EvalHandRequest) (*EvalHandResponse, error) {
+	typ, err := parseType(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	pocket, board := ToCards(req.GetPocket()), ToCards(req.GetBoard())
+	if err := validCards(pocket, board); err != nil {
+		return nil, err
+	}
+	ev := typ.Eval(pocket, board)
+	res := &EvalHandResponse{
+		Eval:   FromEval(ev),
+		HiDesc: fmt.Sprintf("%s", ev.Desc(false)),
+	}
+	if typ.Low() || typ.Double() {
+		res.LoDesc = fmt.Sprintf("%s", ev.Desc(true))
+	}
+	return res, nil
+}
+
+// CalcOdds satisfies the [CardrankServiceServer] interface.
+func (s *Server) CalcOdds(ctx context.Context, req *CalcOddsRequest) (*CalcOddsResponse, error) {
+	typ, err := parseType(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	pockets, board := ToPockets(req.GetPockets()), ToCards(req.GetBoard())
+	if len(pockets) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "at least 2 pockets required")
+	}
+	if err := validCards(pockets...); err != nil {
+		return nil, err
+	}
+	if err := validCards(board); err != nil {
+		return nil, err
+	}
+	hi, lo, ok := typ.Odds(ctx, pockets, board)
+	switch {
+	case ctx.Err() != nil:
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case !ok:
+		return nil, status.Error(codes.InvalidArgument, "unable to calculate odds")
+	}
+	return &CalcOddsResponse{
+		Id: req.GetId(),
+		Hi: FromOdds(hi),
+		Lo: FromOdds(lo),
+	}, nil
+}
+
+// CalcOddsStream satisfies the [CardrankServiceServer] interface.
+func (s *Server) CalcOddsStream(stream CardrankService_CalcOddsStreamServer) error {
+	ctx := stream.Context()
+	for {
+		req, err := stream.Recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return err
+		}
+		res, err := s.CalcOdds(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+// DealHand satisfies the [CardrankServiceServer] interface.
+func (s *Server) DealHand(_ context.Context, req *DealHandRequest) (*DealHandResponse, error) {
+	typ, err := parseType(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	count := int(req.GetCount())
+	if count < 1 || typ.Max() < count {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid count %d", count)
+	}
+	seed := req.GetSeed()
+	pockets, board := typ.Deal(rand.New(rand.NewPCG(seed, seed)), 1, count)
+	return &DealHandResponse{
+		Pockets: FromPockets(pockets),
+		Board:   FromCards(board),
+	}, nil
+}
+
+// parseType parses the type.
+func parseType(s string) (cardrank.Type, error) {
+	var typ cardrank.Type
+	if err := typ.UnmarshalText([]byte(s)); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid type %q", s)
+	}
+	return typ, nil
+}
+
+// validCards returns an error when any of the cards are invalid.
+func validCards(v ...[]cardrank.Card) error {
+	for _, cards := range v {
+		for _, c := range cards {
+			if !c.Valid() {
+				return status.Error(codes.InvalidArgument, "invalid card")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package cardrankpb
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newClient(t *testing.T) CardrankServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	RegisterCardrankServiceServer(s, NewServer())
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return NewCardrankServiceClient(conn)
+}
+
+func TestServerEvalHand(t *testing.T) {
+	client := newClient(t)
+	res, err := client.EvalHand(context.Background(), &EvalHandRequest{
+		Type:   "Holdem",
+		Pocket: FromCards(cardrank.Must("As Ks")),
+		Board:  FromCards(cardrank.Must("Qs Js Ts 2c 3d")),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if s, exp := res.GetHiDesc(), "Straight Flush, Ace-high, Royal"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if res.GetLoDesc() != "" {
+		t.Errorf("expected no lo desc, got: %q", res.GetLoDesc())
+	}
+	if r := res.GetEval().GetHiRank(); r != 1 {
+		t.Errorf("expected 1, got: %d", r)
+	}
+	_, err = client.EvalHand(context.Background(), &EvalHandRequest{Type: "Nope"})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("expected %v, got: %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestServerCalcOdds(t *testing.T) {
+	client := newClient(t)
+	req := &CalcOddsRequest{
+		Id:   "a",
+		Type: "Hh",
+		Pockets: FromPockets([][]cardrank.Card{
+			cardrank.Must("Ah Ad"),
+			cardrank.Must("Ks Kh"),
+		}),
+		Board: FromCards(cardrank.Must("2c 7d 9h")),
+	}
+	res, err := client.CalcOdds(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if res.GetId() != "a" || res.GetHi().GetTotal() != 990 || res.GetHi().GetCounts()[0] != 907 {
+		t.Errorf("unexpected response: %v", res)
+	}
+	stream, err := client.CalcOddsStream(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, id := range []string{"b", "c"} {
+		req.Id = id
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if res.GetId() != id || res.GetHi().GetTotal() != 990 {
+			t.Errorf("unexpected response: %v", res)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestServerDealHand(t *testing.T) {
+	client := newClient(t)
+	res, err := client.DealHand(context.Background(), &DealHandRequest{
+		Type:  "Omaha",
+		Count: 3,
+		Seed:  42,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if n := len(res.GetPockets()); n != 3 {
+		t.Fatalf("expected 3 pockets, got: %d", n)
+	}
+	if n := len(res.GetPockets()[0].GetCards()); n != 4 {
+		t.Errorf("expected 4 cards, got: %d", n)
+	}
+	if n := len(res.GetBoard()); n != 5 {
+		t.Errorf("expected 5 cards, got: %d", n)
+	}
+	_, err = client.DealHand(context.Background(), &DealHandRequest{Type: "Holdem", Count: 100})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("expected %v, got: %v", codes.InvalidArgument, err)
+	}
+}
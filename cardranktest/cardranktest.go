@@ -0,0 +1,162 @@
+// Package cardranktest provides helpers for building decks, pockets, boards,
+// dealers, and expected results in tests.
+package cardranktest
+
+import (
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+// MustCards parses the cards (see [cardrank.Parse]), failing the test on any
+// error.
+func MustCards(tb testing.TB, v ...string) []cardrank.Card {
+	tb.Helper()
+	cards, err := cardrank.Parse(v...)
+	if err != nil {
+		tb.Fatalf("invalid cards %q: %v", v, err)
+	}
+	return cards
+}
+
+// MustPockets parses each of the strings as a pocket, failing the test on any
+// error.
+func MustPockets(tb testing.TB, v ...string) [][]cardrank.Card {
+	tb.Helper()
+	pockets := make([][]cardrank.Card, len(v))
+	for i, s := range v {
+		pockets[i] = MustCards(tb, s)
+	}
+	return pockets
+}
+
+// StackedDeck creates a deck for the deck type having the cards on top (in
+// order), followed by the remaining cards in unshuffled order. Fails the test
+// when a card is invalid, duplicated, or not in the deck type.
+func StackedDeck(tb testing.TB, typ cardrank.DeckType, v ...string) *cardrank.Deck {
+	tb.Helper()
+	cards := MustCards(tb, v...)
+	order := make([]int, 0, typ.Len())
+	used := make([]bool, typ.Len())
+	for _, c := range cards {
+		i := typ.Index(c)
+		switch {
+		case i == -1:
+			tb.Fatalf("card %s not in deck %s", c, typ)
+		case used[i]:
+			tb.Fatalf("duplicate card %s", c)
+		}
+		order, used[i] = append(order, i), true
+	}
+	for i := range typ.Len() {
+		if !used[i] {
+			order = append(order, i)
+		}
+	}
+	return stack(typ, order)
+}
+
+// FixedDealer creates a dealer for the type that deals the pockets and
+// boards (the Hi board, followed by the Lo board for double board types).
+// Pockets and boards may be partial, with any remaining cards (including
+// discards) dealt from the remaining cards in unshuffled order. Fails the test
+// when a card is invalid, duplicated, or there are more pockets than the
+// type's max players.
+func FixedDealer(tb testing.TB, typ cardrank.Type, pockets []string, boards ...string) *cardrank.Dealer {
+	tb.Helper()
+	desc, count := typ.Desc(), len(pockets)
+	if count < 1 || desc.Max < count {
+		tb.Fatalf("invalid pocket count %d", count)
+	}
+	deck := desc.Deck
+	// deal an unshuffled deck to determine the deck position of each card
+	run := cardrank.NewRun(count)
+	probe := cardrank.NewDealer(desc, deck.New(), count)
+	for i := range len(desc.Streets) {
+		probe.Deal(i, run)
+	}
+	order := make([]int, deck.Len())
+	for i := range order {
+		order[i] = -1
+	}
+	used := make([]bool, deck.Len())
+	// place places the cards at the positions of the dealt cards
+	place := func(v []cardrank.Card, dealt []cardrank.Card) {
+		if len(dealt) < len(v) {
+			tb.Fatalf("too many cards %s", v)
+		}
+		for i, c := range v {
+			j := deck.Index(c)
+			switch {
+			case j == -1:
+				tb.Fatalf("card %s not in deck %s", c, deck)
+			case used[j]:
+				tb.Fatalf("duplicate card %s", c)
+			}
+			order[deck.Index(dealt[i])], used[j] = j, true
+		}
+	}
+	for i, s := range pockets {
+		place(MustCards(tb, s), run.Pockets[i])
+	}
+	switch {
+	case 2 < len(boards), len(boards) == 2 && !desc.Double:
+		tb.Fatalf("invalid board count %d", len(boards))
+	case len(boards) == 2:
+		place(MustCards(tb, boards[1]), run.Lo)
+		fallthrough
+	case len(boards) == 1:
+		place(MustCards(tb, boards[0]), run.Hi)
+	}
+	// fill remaining positions
+	var rem []int
+	for i := range deck.Len() {
+		if !used[i] {
+			rem = append(rem, i)
+		}
+	}
+	for i := range order {
+		if order[i] == -1 {
+			order[i], rem = rem[0], rem[1:]
+		}
+	}
+	return cardrank.NewDealer(desc, stack(deck, order), count)
+}
+
+// MustResult creates the expected result for the type, board, and pockets,
+// for types having a single board, failing the test on any error.
+func MustResult(tb testing.TB, typ cardrank.Type, board string, pockets ...string) *cardrank.Result {
+	tb.Helper()
+	run := cardrank.NewRun(len(pockets))
+	run.Pockets = MustPockets(tb, pockets...)
+	run.Hi = MustCards(tb, board)
+	return cardrank.NewResult(typ, run, cardrank.AllPositions, false)
+}
+
+// stack creates a deck for the deck type with the cards ordered by the
+// unshuffled indexes.
+func stack(typ cardrank.DeckType, order []int) *cardrank.Deck {
+	d := typ.New()
+	d.Shuffle(&stacker{order: order}, 1)
+	return d
+}
+
+// stacker is a [cardrank.Shuffler] that orders an unshuffled deck.
+type stacker struct {
+	order []int
+}
+
+// Shuffle satisfies the [cardrank.Shuffler] interface.
+func (s *stacker) Shuffle(n int, swap func(int, int)) {
+	// pos tracks the current position of each unshuffled index
+	pos, at := make([]int, n), make([]int, n)
+	for i := range n {
+		pos[i], at[i] = i, i
+	}
+	for i, j := range s.order[:min(n, len(s.order))] {
+		k := pos[j]
+		swap(i, k)
+		at[i], at[k] = at[k], at[i]
+		pos[at[i]], pos[at[k]] = i, k
+	}
+}
@@ -0,0 +1,68 @@
+package cardranktest
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestStackedDeck(t *testing.T) {
+	d := StackedDeck(t, cardrank.DeckFrench, "As Kh 2c")
+	if s, exp := fmt.Sprintf("%s", d.Draw(4)), "[As Kh 2c 2s]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+	if n, exp := d.Remaining(), 48; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+	d = StackedDeck(t, cardrank.DeckShort, "6s 9h")
+	v := d.All()
+	slices.Sort(v)
+	if exp := cardrank.DeckShort.Unshuffled(); !slices.Equal(v, slices.Sorted(slices.Values(exp))) {
+		t.Errorf("expected %v, got: %v", exp, v)
+	}
+}
+
+func TestFixedDealer(t *testing.T) {
+	tests := []struct {
+		typ     cardrank.Type
+		pockets []string
+		boards  []string
+	}{
+		{cardrank.Holdem, []string{"As Ad", "Ks Kd", "7c 2h"}, []string{"Ah Kh Qh Jh Th"}},
+		{cardrank.Holdem, []string{"As", "", "7c 2h"}, []string{"Ah Kh"}},
+		{cardrank.Omaha, []string{"As Ad Kc Qc", "Ks Kd 7c 2h"}, []string{"2s 3s 4s"}},
+		{cardrank.Stud, []string{"As Ad Kc Qc Jc Tc 9c", "Ks Kd 7c 2h 3h 4h 5h"}, nil},
+		{cardrank.Double, []string{"As Ad", "Ks Kd"}, []string{"Ah Kh Qh Jh Th", "2c 3c 4c 5c 6c"}},
+	}
+	for i, test := range tests {
+		d := FixedDealer(t, test.typ, test.pockets, test.boards...)
+		for d.Next() {
+		}
+		run := d.Runs[0]
+		for j, s := range test.pockets {
+			if exp := MustCards(t, s); !slices.Equal(run.Pockets[j][:len(exp)], exp) {
+				t.Errorf("test %d pocket %d expected %v, got: %v", i, j, exp, run.Pockets[j])
+			}
+		}
+		for j, board := range [][]cardrank.Card{run.Hi, run.Lo} {
+			if j < len(test.boards) {
+				if exp := MustCards(t, test.boards[j]); !slices.Equal(board[:len(exp)], exp) {
+					t.Errorf("test %d board %d expected %v, got: %v", i, j, exp, board)
+				}
+			}
+		}
+	}
+}
+
+func TestMustResult(t *testing.T) {
+	res := MustResult(t, cardrank.Holdem, "Ah Kh Qh 2c 3d", "As Ad", "Ks Kd", "Jh Th")
+	if res.HiPivot != 1 || res.HiOrder[0] != 2 {
+		t.Errorf("expected position 2 to win, got: %v/%d", res.HiOrder, res.HiPivot)
+	}
+	hi, _ := res.Win()
+	if s, exp := fmt.Sprintf("%S", hi), "2 wins with Straight Flush, Ace-high, Royal"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
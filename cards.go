@@ -0,0 +1,130 @@
+package cardrank
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sort"
+)
+
+// Cards is a set of cards that can be stored in and scanned from a single
+// binary database column.
+type Cards []Card
+
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+func (v *Cards) UnmarshalBinary(buf []byte) error {
+	u := make(Cards, len(buf))
+	for i := range buf {
+		if err := u[i].UnmarshalBinary(buf[i : i+1]); err != nil {
+			return err
+		}
+	}
+	*v = u
+	return nil
+}
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface. The cards
+// are encoded as a single byte per card (see [Card.MarshalBinary]).
+func (v Cards) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, len(v))
+	for i, c := range v {
+		if !c.Valid() {
+			return nil, ErrInvalidCard
+		}
+		buf[i] = byte(c.Index())
+	}
+	return buf, nil
+}
+
+// UnmarshalText satisfies the [encoding.TextUnmarshaler] interface.
+func (v *Cards) UnmarshalText(buf []byte) error {
+	u, err := Parse(string(buf))
+	if err != nil {
+		return err
+	}
+	*v = u
+	return nil
+}
+
+// MarshalText satisfies the [encoding.TextMarshaler] interface.
+func (v Cards) MarshalText() ([]byte, error) {
+	var buf []byte
+	for i, c := range v {
+		if !c.Valid() {
+			return nil, ErrInvalidCard
+		}
+		if i != 0 {
+			buf = append(buf, ' ')
+		}
+		buf = append(buf, c.RankByte(), c.SuitByte())
+	}
+	return buf, nil
+}
+
+// Scan satisfies the [sql.Scanner] interface. Scans the cards from their binary
+// (see [Cards.MarshalBinary]) or text representation. A NULL value scans as
+// nil.
+func (v *Cards) Scan(src any) error {
+	switch buf := src.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case []byte:
+		if binaryCards(buf) {
+			return v.UnmarshalBinary(buf)
+		}
+		return v.UnmarshalText(buf)
+	case string:
+		return v.UnmarshalText([]byte(buf))
+	}
+	return fmt.Errorf("cannot scan %T into cards: %w", src, ErrInvalidCard)
+}
+
+// Value satisfies the [driver.Valuer] interface. The cards are stored using
+// their binary representation (see [Cards.MarshalBinary]).
+func (v Cards) Value() (driver.Value, error) {
+	return v.MarshalBinary()
+}
+
+// SortRank sorts v in place by rank, high to low, with Aces high. Cards of the
+// same rank are ordered by suit ([Spade], [Heart], [Diamond], [Club]).
+func SortRank(v []Card) {
+	sort.SliceStable(v, func(i, j int) bool {
+		if m, n := v[i].Rank(), v[j].Rank(); m != n {
+			return n < m
+		}
+		return v[i].Suit() < v[j].Suit()
+	})
+}
+
+// SortSuit sorts v in place by suit ([Spade], [Heart], [Diamond], [Club]),
+// then by rank, high to low, with Aces high.
+func SortSuit(v []Card) {
+	sort.SliceStable(v, func(i, j int) bool {
+		if s, t := v[i].Suit(), v[j].Suit(); s != t {
+			return s < t
+		}
+		return v[j].Rank() < v[i].Rank()
+	})
+}
+
+// SortLow sorts v in place for display in low games, by rank, high to low,
+// with Aces low (ex: 5 4 3 2 A for a wheel). Cards of the same rank are
+// ordered by suit ([Spade], [Heart], [Diamond], [Club]).
+func SortLow(v []Card) {
+	sort.SliceStable(v, func(i, j int) bool {
+		if a, b := v[i].AceRank(), v[j].AceRank(); a != b {
+			return b < a
+		}
+		return v[i].Suit() < v[j].Suit()
+	})
+}
+
+// binaryCards returns true when buf contains only valid binary card indexes.
+func binaryCards(buf []byte) bool {
+	for _, b := range buf {
+		if 52 <= b {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,137 @@
+package cardrank
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*Card)(nil)
+	_ driver.Valuer = Card(0)
+	_ sql.Scanner   = (*Cards)(nil)
+	_ driver.Valuer = Cards(nil)
+)
+
+func TestCardBinary(t *testing.T) {
+	for _, c := range DeckFrench.Unshuffled() {
+		buf, err := c.MarshalBinary()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if n := len(buf); n != 1 {
+			t.Fatalf("expected 1 byte, got: %d", n)
+		}
+		var u Card
+		if err := u.UnmarshalBinary(buf); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if u != c {
+			t.Errorf("expected %s, got: %s", c, u)
+		}
+	}
+	if _, err := InvalidCard.MarshalBinary(); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("expected error %v, got: %v", ErrInvalidCard, err)
+	}
+	var c Card
+	if err := c.UnmarshalBinary([]byte{52}); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("expected error %v, got: %v", ErrInvalidCard, err)
+	}
+}
+
+func TestCardScan(t *testing.T) {
+	exp := New(Ace, Heart)
+	for i, src := range []any{
+		[]byte{byte(exp.Index())},
+		[]byte("Ah"),
+		"Ah",
+		"🂱",
+		int64(exp.Index()),
+	} {
+		var c Card
+		if err := c.Scan(src); err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if c != exp {
+			t.Errorf("test %d expected %s, got: %s", i, exp, c)
+		}
+	}
+	var c Card
+	if err := c.Scan(nil); err != nil || c != InvalidCard {
+		t.Errorf("expected invalid card, got: %d %v", c, err)
+	}
+	for i, src := range []any{int64(52), 1.5, "Zz"} {
+		if err := c.Scan(src); !errors.Is(err, ErrInvalidCard) {
+			t.Errorf("test %d expected error %v, got: %v", i, ErrInvalidCard, err)
+		}
+	}
+	v, err := exp.Value()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := c.Scan(v); err != nil || c != exp {
+		t.Errorf("expected %s, got: %s %v", exp, c, err)
+	}
+}
+
+func TestCardsScan(t *testing.T) {
+	exp := Cards(Must("Ah Ks 2c Td 9h"))
+	v, err := exp.Value()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if buf, ok := v.([]byte); !ok || len(buf) != len(exp) {
+		t.Fatalf("expected %d bytes, got: %v", len(exp), v)
+	}
+	text, err := exp.MarshalText()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if s, e := string(text), "Ah Ks 2c Td 9h"; s != e {
+		t.Errorf("expected %q, got: %q", e, s)
+	}
+	for i, src := range []any{v, text, string(text)} {
+		var u Cards
+		if err := u.Scan(src); err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if !slices.Equal(u, exp) {
+			t.Errorf("test %d expected %v, got: %v", i, exp, u)
+		}
+	}
+	var u Cards
+	if err := u.Scan(nil); err != nil || u != nil {
+		t.Errorf("expected nil, got: %v %v", u, err)
+	}
+	if err := u.Scan([]byte{}); err != nil || len(u) != 0 {
+		t.Errorf("expected empty, got: %v %v", u, err)
+	}
+	if _, err := (Cards{InvalidCard}).Value(); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("expected error %v, got: %v", ErrInvalidCard, err)
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		f   func([]Card)
+		s   string
+		exp string
+	}{
+		{SortRank, "2c As Kd Ah Ks", "As Ah Ks Kd 2c"},
+		{SortRank, "Tc 9c Jc", "Jc Tc 9c"},
+		{SortSuit, "2c As Kd Ah Ks 3h", "As Ks Ah 3h Kd 2c"},
+		{SortLow, "Kd As 2c 3h 5s 4d", "Kd 5s 4d 3h 2c As"},
+		{SortLow, "Ah 2s As 5c", "5c 2s As Ah"},
+		{SortLow, "", ""},
+	}
+	for i, test := range tests {
+		v := Must(test.s)
+		test.f(v)
+		if s := fmt.Sprintf("%s", Formatter(v)); s != "["+test.exp+"]" {
+			t.Errorf("test %d expected [%s], got: %s", i, test.exp, s)
+		}
+	}
+}
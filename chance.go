@@ -0,0 +1,145 @@
+package cardrank
+
+import (
+	"slices"
+)
+
+// ChanceSampler samples chance outcomes for a type with fixed hero holdings,
+// for use with Monte Carlo counterfactual regret minimization (MCCFR)
+// trainers using external or chance sampling.
+//
+// Cards are drawn uniformly without replacement from the type's deck after
+// removing the hero's pocket, the known board, and any dead cards (ie, card
+// removal), and each sample is returned with its probability, for use when
+// weighting sampled counterfactual values.
+type ChanceSampler struct {
+	typ   Type
+	r     Rand
+	hero  []Card
+	board []Card
+	u     []Card
+	buf   []Card
+}
+
+// NewChanceSampler creates a chance sampler for the type, using the random
+// source, the hero's fixed pocket, the known board, and any dead cards. The
+// hero's pocket can be partial (ie, for [Stud] types, before all streets have
+// been dealt), and is completed by [ChanceSampler.Deal].
+//
+// Returns [ErrInvalidType] for unregistered types, [ErrInvalidPocket] or
+// [ErrInvalidBoard] when the pocket or board has too many cards or contains
+// cards not in the type's deck, or [ErrDuplicateCard] when a card is used more
+// than once.
+func NewChanceSampler(typ Type, r Rand, hero, board []Card, dead ...[]Card) (*ChanceSampler, error) {
+	if _, ok := descs[typ]; !ok {
+		return nil, ErrInvalidType
+	}
+	deck := typ.DeckType()
+	switch {
+	case len(hero) == 0 || typ.Pocket() < len(hero) || !inDeck(deck, hero):
+		return nil, ErrInvalidPocket
+	case typ.Board() < len(board) || !inDeck(deck, board):
+		return nil, ErrInvalidBoard
+	}
+	m := deadMap(dead...)
+	for _, c := range slices.Concat(hero, board) {
+		if m[c] {
+			return nil, ErrDuplicateCard
+		}
+		m[c] = true
+	}
+	return &ChanceSampler{
+		typ:   typ,
+		r:     r,
+		hero:  slices.Clone(hero),
+		board: slices.Clone(board),
+		u:     deck.Exclude(append(dead, hero, board)...),
+	}, nil
+}
+
+// Remaining returns the number of live cards available to the sampler.
+func (s *ChanceSampler) Remaining() int {
+	return len(s.u)
+}
+
+// Deal samples a complete deal for count players, returning the pockets, the
+// board, and the probability of the sampled outcome. The first pocket is the
+// hero's, completed when partial, and the board includes the known board.
+// Returns nil pockets and a 0 probability when count is not between 1 and
+// the type's max players, or when there are not enough live cards.
+//
+// The probability is of the dealt sets of cards (ie, ignoring the order of
+// cards within a pocket or board), and is the same for every outcome.
+func (s *ChanceSampler) Deal(count int) ([][]Card, []Card, float64) {
+	n, board := s.typ.Pocket(), s.typ.Board()-len(s.board)
+	total := count*n - len(s.hero) + board
+	if count < 1 || s.typ.Max() < count || len(s.u) < total {
+		return nil, nil, 0
+	}
+	v := s.draw(s.u, total)
+	pockets := make([][]Card, count)
+	pockets[0] = append(slices.Clone(s.hero), v[:n-len(s.hero)]...)
+	p, live, i := 1/binom(len(s.u), n-len(s.hero)), len(s.u)-(n-len(s.hero)), n-len(s.hero)
+	for j := 1; j < count; j++ {
+		pockets[j] = slices.Clone(v[i : i+n])
+		p, live, i = p/binom(live, n), live-n, i+n
+	}
+	return pockets, append(slices.Clone(s.board), v[i:]...), p / binom(live, board)
+}
+
+// Street samples the cards dealt on the next street, given the board dealt
+// so far, excluding the hero's pocket and any dead cards (ie, opponent
+// pockets). Returns the board with the street's cards appended, and the
+// probability of the sampled cards. Returns nil and a 0 probability when the
+// board is complete, does not end on a street, or when there are not enough
+// live cards.
+func (s *ChanceSampler) Street(board []Card, dead ...[]Card) ([]Card, float64) {
+	var k, total int
+	for _, street := range s.typ.Streets() {
+		if total += street.Board; len(board) < total {
+			k = street.Board
+			break
+		}
+	}
+	if k == 0 || total-k != len(board) {
+		return nil, 0
+	}
+	u := Exclude(s.u, append(dead, board)...)
+	if len(u) < k {
+		return nil, 0
+	}
+	return append(slices.Clone(board), s.draw(u, k)...), 1 / binom(len(u), k)
+}
+
+// draw draws k random cards from v using a partial Fisher-Yates shuffle. The
+// returned cards are only valid until the next call.
+func (s *ChanceSampler) draw(v []Card, k int) []Card {
+	s.buf = append(s.buf[:0], v...)
+	for i := range k {
+		j := i + int(s.r.Float64()*float64(len(s.buf)-i))
+		s.buf[i], s.buf[j] = s.buf[j], s.buf[i]
+	}
+	return s.buf[:k]
+}
+
+// inDeck returns true when all cards are in the deck.
+func inDeck(typ DeckType, v []Card) bool {
+	for _, c := range v {
+		if typ.Index(c) == -1 {
+			return false
+		}
+	}
+	return true
+}
+
+// binom returns the binomial coefficient n choose k.
+func binom(n, k int) float64 {
+	if k < 0 || n < k {
+		return 0
+	}
+	f := 1.0
+	for i := range min(k, n-k) {
+		f = f * float64(n-i) / float64(i+1)
+	}
+	return f
+}
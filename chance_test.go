@@ -0,0 +1,146 @@
+package cardrank
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestNewChanceSampler(t *testing.T) {
+	tests := []struct {
+		typ   Type
+		hero  string
+		board string
+		dead  string
+		exp   int
+		err   error
+	}{
+		{Holdem, "As Ah", "", "", 50, nil},
+		{Holdem, "As Ah", "Kh Qh Jh", "2c", 46, nil},
+		{Stud, "As Ah Ks", "", "", 49, nil},
+		{Kuhn, "Ks", "", "", 2, nil},
+		{Leduc, "Ks", "Qh", "", 4, nil},
+		{Type(0), "As", "", "", 0, ErrInvalidType},
+		{Holdem, "", "", "", 0, ErrInvalidPocket},
+		{Holdem, "As Ah Ks", "", "", 0, ErrInvalidPocket},
+		{Short, "As 2h", "", "", 0, ErrInvalidPocket},
+		{Kuhn, "As", "", "", 0, ErrInvalidPocket},
+		{Holdem, "As Ah", "Kh Qh Jh Th 9h 8h", "", 0, ErrInvalidBoard},
+		{Kuhn, "Ks", "Qs", "", 0, ErrInvalidBoard},
+		{Holdem, "As Ah", "As", "", 0, ErrDuplicateCard},
+		{Holdem, "As Ah", "", "Ah", 0, ErrDuplicateCard},
+	}
+	for i, test := range tests {
+		s, err := NewChanceSampler(test.typ, rand.New(rand.NewPCG(1, 2)), Must(test.hero), Must(test.board), Must(test.dead))
+		switch {
+		case err != test.err:
+			t.Errorf("test %d expected error %v, got: %v", i, test.err, err)
+		case err == nil && s.Remaining() != test.exp:
+			t.Errorf("test %d expected %d, got: %d", i, test.exp, s.Remaining())
+		}
+	}
+}
+
+func TestChanceSamplerDeal(t *testing.T) {
+	tests := []struct {
+		typ   Type
+		hero  string
+		board string
+		count int
+		exp   float64
+	}{
+		{Holdem, "As Ah", "", 2, 1 / (binom(50, 2) * binom(48, 5))},
+		{Holdem, "As Ah", "Kh Qh Jh", 3, 1 / (binom(47, 2) * binom(45, 2) * binom(43, 2))},
+		{Omaha, "As Ah Ks Kh", "", 2, 1 / (binom(48, 4) * binom(44, 5))},
+		{Stud, "As Ah Ks", "", 2, 1 / (binom(49, 4) * binom(45, 7))},
+		{Kuhn, "Ks", "", 2, 1.0 / 2},
+		{Leduc, "Ks", "", 2, 1.0 / 20},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.Name(), func(t *testing.T) {
+			hero, board := Must(test.hero), Must(test.board)
+			s, err := NewChanceSampler(test.typ, rand.New(rand.NewPCG(1, 2)), hero, board)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			for range 100 {
+				pockets, v, p := s.Deal(test.count)
+				if len(pockets) != test.count || len(v) != test.typ.Board() {
+					t.Fatalf("expected %d pockets and %d board cards, got: %v %v", test.count, test.typ.Board(), pockets, v)
+				}
+				if math.Abs(p-test.exp) > 1e-12*test.exp {
+					t.Fatalf("expected %g, got: %g", test.exp, p)
+				}
+				if !slices.Equal(pockets[0][:len(hero)], hero) || !slices.Equal(v[:len(board)], board) {
+					t.Fatalf("expected fixed %v %v, got: %v %v", hero, board, pockets[0], v)
+				}
+				seen := make(map[Card]bool)
+				for _, c := range append(slices.Concat(pockets...), v...) {
+					if seen[c] {
+						t.Fatalf("duplicate card %s in %v %v", c, pockets, v)
+					}
+					seen[c] = true
+				}
+			}
+			if pockets, v, p := s.Deal(0); pockets != nil || v != nil || p != 0 {
+				t.Errorf("expected nil, got: %v %v %f", pockets, v, p)
+			}
+		})
+	}
+}
+
+func TestChanceSamplerFrequency(t *testing.T) {
+	s, err := NewChanceSampler(Leduc, rand.New(rand.NewPCG(3, 4)), Must("Ks"), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	counts := make(map[string]int)
+	const n = 40000
+	for range n {
+		pockets, board, p := s.Deal(2)
+		if p != 1.0/20 {
+			t.Fatalf("expected %f, got: %f", 1.0/20, p)
+		}
+		counts[pockets[1][0].String()+board[0].String()]++
+	}
+	if len(counts) != 20 {
+		t.Fatalf("expected 20 outcomes, got: %d", len(counts))
+	}
+	for k, c := range counts {
+		if exp := n / 20; c < exp*9/10 || exp*11/10 < c {
+			t.Errorf("expected %s to be dealt ~%d times, got: %d", k, exp, c)
+		}
+	}
+}
+
+func TestChanceSamplerStreet(t *testing.T) {
+	hero, opp := Must("As Ah"), Must("Ks Kh")
+	s, err := NewChanceSampler(Holdem, rand.New(rand.NewPCG(5, 6)), hero, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tests := []struct {
+		board int
+		exp   int
+		p     float64
+	}{
+		{0, 3, 1 / binom(48, 3)},
+		{3, 4, 1.0 / 45},
+		{4, 5, 1.0 / 44},
+		{5, 0, 0},
+		{2, 0, 0},
+	}
+	board := Must("Qs Qh Qd Qc 2s")
+	for _, test := range tests {
+		v, p := s.Street(board[:test.board], opp)
+		if len(v) != test.exp || p != test.p {
+			t.Errorf("board %d expected %d cards with %g, got: %v %g", test.board, test.exp, test.p, v, p)
+		}
+		for _, c := range v[min(len(v), test.board):] {
+			if slices.Contains(hero, c) || slices.Contains(opp, c) || slices.Contains(board[:test.board], c) {
+				t.Errorf("board %d expected live card, got: %s", test.board, c)
+			}
+		}
+	}
+}
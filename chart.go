@@ -0,0 +1,430 @@
+package cardrank
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// PreflopChart is a set of preflop charts for a position and stack depth.
+type PreflopChart struct {
+	// Position is the position, with 0 being first to act.
+	Position int
+	// Opener is the position of the opener faced by the call and 3-bet
+	// charts, or -1 when not facing an open.
+	Opener int
+	// Stack is the effective stack, in big blinds.
+	Stack float64
+	// Open is the open chart, when first to act in the pot.
+	Open Grid
+	// Call is the call chart, when facing an open from the opener.
+	Call Grid
+	// ThreeBet is the 3-bet chart, when facing an open from the opener.
+	ThreeBet Grid
+}
+
+// Format satisfies the [fmt.Formatter] interface.
+//
+// Supported verbs:
+//
+//	s - open, call, and 3-bet charts as starting pocket keys (see [Grid.Format])
+//	v - same as s
+func (chart *PreflopChart) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'v':
+		fmt.Fprintf(f, "open (%d, %gbb):\n%s", chart.Position, chart.Stack, chart.Open)
+		if chart.Opener != -1 {
+			fmt.Fprintf(f, "\ncall (vs %d):\n%s\n3-bet (vs %d):\n%s", chart.Opener, chart.Call, chart.Opener, chart.ThreeBet)
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(ERROR=unknown verb, preflop chart)", verb)
+	}
+}
+
+// StartingChart returns the expected value of each starting pocket class
+// against players-1 random opponents, for rendering color-coded preflop
+// charts. Only [Holdem] and [Short] are supported. Classes not available in
+// the type's deck have 0 expected value.
+//
+// Heads-up (2 player) values are exact, summing the precalculated class
+// matchups against every villain class (see [PreflopEquity]). Values for
+// more players are simulated with the default [ChartGen] options (see
+// [ChartGen.Equity]).
+//
+// Returns [ErrInvalidType] when the type is not supported, or
+// [ErrInvalidCount] when players is less than 2 or more than the type's max
+// players.
+func StartingChart(typ Type, players int) (Grid, error) {
+	if _, err := preflopTable(typ); err != nil {
+		return Grid{}, err
+	}
+	if players < 2 || typ.Max() < players {
+		return Grid{}, ErrInvalidCount
+	}
+	if players != 2 {
+		g, err := NewChartGen(typ, WithChartPlayers(players))
+		if err != nil {
+			return Grid{}, err
+		}
+		return g.Equity(players - 1), nil
+	}
+	var chart Grid
+	for i := range 13 {
+		for j := range 13 {
+			expv := NewExpValue(1)
+			for k := range 13 {
+				for l := range 13 {
+					if v, err := PreflopEquity(typ, GridKey(i, j), GridKey(k, l)); err == nil {
+						expv.Add(v)
+					}
+				}
+			}
+			chart[i][j] = expv.Float64()
+		}
+	}
+	return chart, nil
+}
+
+// ChartGen generates preflop open, call, and 3-bet charts from simulated
+// equities of the 169 starting pocket classes.
+//
+// Classes are ranked by their equity against a random hand. A class is opened
+// when the probability that no player left to act holds a higher ranked
+// class is at least the open probability (see [WithChartOpen]), opening
+// wider as fewer players are left to act. Facing an open, a class is 3-bet when its equity
+// against the opener's open chart is at least the 3-bet threshold (see
+// [WithChartThreeBet]), or when the 3-bet would commit a third of the stack,
+// when its equity is at least the pot odds of a called all-in. Otherwise, a
+// class is called when its equity, discounted by the equity realization
+// (see [WithChartRealization]), is at least the pot odds of calling the open
+// (see [WithChartRaise]).
+//
+// Charts are heuristic, and are intended as a starting point for study, or
+// for generating ranges for other tools.
+type ChartGen struct {
+	typ      Type
+	players  int
+	raise    float64
+	open     float64
+	threeBet float64
+	realize  float64
+	samples  int
+	seed     uint64
+	random   map[int]Grid
+	versus   map[int]Grid
+}
+
+// NewChartGen creates a new preflop chart generator for the type, which must
+// have 2 card pockets and a 5 card board (ie, [Holdem] or [Short]).
+func NewChartGen(typ Type, opts ...ChartOption) (*ChartGen, error) {
+	if typ.Pocket() != 2 || typ.Board() != 5 {
+		return nil, ErrInvalidType
+	}
+	g := &ChartGen{
+		typ:      typ,
+		players:  6,
+		raise:    2.5,
+		open:     0.5,
+		threeBet: 0.6,
+		realize:  0.85,
+		samples:  1000,
+		seed:     1,
+		random:   make(map[int]Grid),
+		versus:   make(map[int]Grid),
+	}
+	for _, o := range opts {
+		o(g)
+	}
+	return g, nil
+}
+
+// Chart generates the charts for the position and effective stack (in big
+// blinds), with call and 3-bet charts facing an open from the opener. Pass
+// -1 for the opener when only the open chart is needed.
+//
+// Positions are numbered in order of preflop action, with 0 being first to
+// act, and the last position being the big blind, which has no open chart.
+func (g *ChartGen) Chart(position, opener int, stack float64) (*PreflopChart, error) {
+	switch {
+	case position < 0 || g.players <= position:
+		return nil, fmt.Errorf("invalid position %d", position)
+	case opener < -1 || position <= opener:
+		return nil, fmt.Errorf("invalid opener %d", opener)
+	case stack <= 0:
+		return nil, fmt.Errorf("invalid stack %g", stack)
+	}
+	chart := &PreflopChart{
+		Position: position,
+		Opener:   opener,
+		Stack:    stack,
+		Open:     g.Open(position),
+	}
+	if opener == -1 {
+		return chart, nil
+	}
+	eq, ok := g.versus[opener]
+	if !ok {
+		eq = g.RangeEquity(g.Open(opener).Range())
+		g.versus[opener] = eq
+	}
+	raise := min(g.raise, stack)
+	call := raise / (2*raise + 1.5)
+	threeBet := g.threeBet
+	if stack <= 9*raise {
+		threeBet = stack / (2*stack + 1.5)
+	}
+	for i := range 13 {
+		for j := range 13 {
+			switch {
+			case eq[i][j] == 0:
+			case threeBet <= eq[i][j]:
+				chart.ThreeBet[i][j] = 1
+			case call <= eq[i][j]*g.realize:
+				chart.Call[i][j] = 1
+			}
+		}
+	}
+	return chart, nil
+}
+
+// Open returns the open chart for the position.
+func (g *ChartGen) Open(position int) Grid {
+	var open Grid
+	behind := g.players - 1 - position
+	if behind < 1 {
+		return open
+	}
+	// the number of combos ranked above each class
+	eq := g.Equity(1)
+	var above Grid
+	var total float64
+	for i := range 13 {
+		for j := range 13 {
+			if eq[i][j] == 0 {
+				continue
+			}
+			n := comboCount(i, j)
+			total += n
+			for k := range 13 {
+				for l := range 13 {
+					if eq[k][l] != 0 && eq[k][l] < eq[i][j] {
+						above[k][l] += n
+					}
+				}
+			}
+		}
+	}
+	threshold := math.Pow(g.open, 1/float64(behind))
+	for i := range 13 {
+		for j := range 13 {
+			if eq[i][j] != 0 && threshold*total <= total-above[i][j] {
+				open[i][j] = 1
+			}
+		}
+	}
+	return open
+}
+
+// comboCount returns the number of combos for the grid row and column.
+func comboCount(i, j int) float64 {
+	switch {
+	case i == j:
+		return 6
+	case i < j:
+		return 4
+	}
+	return 12
+}
+
+// Equity returns the simulated equity of each starting pocket class against
+// the number of random opponents. Classes not available in the type's deck
+// have 0 equity. Results are cached.
+func (g *ChartGen) Equity(opponents int) Grid {
+	if eq, ok := g.random[opponents]; ok {
+		return eq
+	}
+	eq := g.equity(nil, opponents)
+	g.random[opponents] = eq
+	return eq
+}
+
+// RangeEquity returns the simulated equity of each starting pocket class
+// against a single opponent holding a hand from the range. Classes not
+// available in the type's deck have 0 equity.
+func (g *ChartGen) RangeEquity(r Range) Grid {
+	s := newRangeSampler(r, nil)
+	if s == nil {
+		return Grid{}
+	}
+	return g.equity(s, 1)
+}
+
+// equity simulates the equity of each starting pocket class against the
+// opponents, drawing opponent hands from the range sampler when not nil.
+func (g *ChartGen) equity(s *rangeSampler, opponents int) Grid {
+	var eq Grid
+	switch {
+	case s == nil && opponents < 1:
+		return eq
+	case s == nil && opponents == 1 && g.typ == Holdem:
+		// use the precalculated starting equities
+		loadStarting()
+		for i := range 13 {
+			for j := range 13 {
+				expv := startingExpValue[GridKey(i, j)]
+				eq[i][j] = expv.Float64()
+			}
+		}
+		return eq
+	}
+	deck := g.typ.DeckType().Unshuffled()
+	hero, opp := EvalOf(g.typ), EvalOf(g.typ)
+	pocket, board := make([]Card, 2), make([]Card, 5)
+	pockets := make([][]Card, opponents)
+	for k := range pockets {
+		pockets[k] = make([]Card, 2)
+	}
+	u := make([]Card, 0, len(deck))
+	draw := func(r *rand.Rand, v []Card) {
+		for k := range v {
+			l := r.IntN(len(u))
+			v[k], u[l] = u[l], u[len(u)-1]
+			u = u[:len(u)-1]
+		}
+	}
+	for i := range 13 {
+		for j := range 13 {
+			var combos []Combo
+			for _, c := range mustKeyCombos(GridKey(i, j)) {
+				if slices.Contains(deck, c[0]) && slices.Contains(deck, c[1]) {
+					combos = append(combos, c)
+				}
+			}
+			if len(combos) == 0 {
+				continue
+			}
+			r := rand.New(rand.NewPCG(g.seed, uint64(i*13+j)))
+			var total, n float64
+			for range g.samples {
+				c := combos[r.IntN(len(combos))]
+				pocket[0], pocket[1] = c[0], c[1]
+				// remove dealt cards
+				u = u[:0]
+				for _, d := range deck {
+					if d != c[0] && d != c[1] {
+						u = append(u, d)
+					}
+				}
+				if s != nil {
+					// reject opponent combos conflicting with the pocket
+					o, ok := s.sample(r.Float64()), false
+					for k := 0; k < 100 && !ok; k++ {
+						if ok = !slices.Contains(pocket, o[0]) && !slices.Contains(pocket, o[1]); !ok {
+							o = s.sample(r.Float64())
+						}
+					}
+					if !ok {
+						continue
+					}
+					pockets[0][0], pockets[0][1] = o[0], o[1]
+					u = slices.DeleteFunc(u, func(d Card) bool {
+						return d == o[0] || d == o[1]
+					})
+				} else {
+					for _, p := range pockets {
+						draw(r, p)
+					}
+				}
+				draw(r, board)
+				hero.Reset(g.typ)
+				hero.Eval(pocket, board)
+				win, ties := 1.0, 1
+				for _, p := range pockets {
+					opp.Reset(g.typ)
+					opp.Eval(p, board)
+					switch c := hero.Comp(opp, false); {
+					case 0 < c:
+						win = 0
+					case c == 0:
+						ties++
+					}
+				}
+				total, n = total+win/float64(ties), n+1
+			}
+			if n != 0 {
+				eq[i][j] = total / n
+			}
+		}
+	}
+	return eq
+}
+
+// mustKeyCombos returns the combos for the starting pocket key.
+func mustKeyCombos(key string) []Combo {
+	v, err := KeyCombos(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ChartOption is a preflop chart generator option.
+type ChartOption func(*ChartGen)
+
+// WithChartPlayers is a preflop chart generator option to set the number of
+// players. Defaults to 6.
+func WithChartPlayers(players int) ChartOption {
+	return func(g *ChartGen) {
+		g.players = players
+	}
+}
+
+// WithChartRaise is a preflop chart generator option to set the open raise
+// size, in big blinds. Defaults to 2.5.
+func WithChartRaise(raise float64) ChartOption {
+	return func(g *ChartGen) {
+		g.raise = raise
+	}
+}
+
+// WithChartOpen is a preflop chart generator option to set the open
+// probability, the probability that no player left to act holds a higher
+// ranked class needed to open. Defaults to 0.5.
+func WithChartOpen(open float64) ChartOption {
+	return func(g *ChartGen) {
+		g.open = open
+	}
+}
+
+// WithChartThreeBet is a preflop chart generator option to set the equity
+// needed against the opener's range to 3-bet. Defaults to 0.6.
+func WithChartThreeBet(threeBet float64) ChartOption {
+	return func(g *ChartGen) {
+		g.threeBet = threeBet
+	}
+}
+
+// WithChartRealization is a preflop chart generator option to set the
+// share of equity realized when calling an open. Defaults to 0.85.
+func WithChartRealization(realize float64) ChartOption {
+	return func(g *ChartGen) {
+		g.realize = realize
+	}
+}
+
+// WithChartSamples is a preflop chart generator option to set the number of
+// simulated deals for each starting pocket class. Defaults to 1000.
+func WithChartSamples(samples int) ChartOption {
+	return func(g *ChartGen) {
+		g.samples = samples
+	}
+}
+
+// WithChartSeed is a preflop chart generator option to set the random seed.
+// Defaults to 1.
+func WithChartSeed(seed uint64) ChartOption {
+	return func(g *ChartGen) {
+		g.seed = seed
+	}
+}
@@ -0,0 +1,162 @@
+package cardrank
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestChartGen(t *testing.T) {
+	g, err := NewChartGen(Holdem, WithChartSamples(200))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	prev := 0.0
+	for position := range 5 {
+		chart, err := g.Chart(position, position-1, 100)
+		if err != nil {
+			t.Fatalf("position %d expected no error, got: %v", position, err)
+		}
+		n := chart.Open.Count()
+		if n < prev {
+			t.Errorf("position %d expected at least %f combos, got: %f", position, prev, n)
+		}
+		prev = n
+		if chart.Open.Get("AA") != 1 || chart.Open.Get("72o") != 0 {
+			t.Errorf("position %d expected AA and not 72o:\n%s", position, chart.Open)
+		}
+		if position == 0 {
+			continue
+		}
+		if chart.ThreeBet.Get("AA") != 1 || chart.Call.Get("AA") != 0 {
+			t.Errorf("position %d expected AA 3-bet", position)
+		}
+		for i := range 13 {
+			for j := range 13 {
+				if chart.Call[i][j] != 0 && chart.ThreeBet[i][j] != 0 {
+					t.Errorf("position %d expected %s to either call or 3-bet", position, GridKey(i, j))
+				}
+			}
+		}
+	}
+	if n := g.Open(5).Count(); n != 0 {
+		t.Errorf("expected no big blind open, got: %f", n)
+	}
+	// short stacks 3-bet all-in wider
+	deep, err := g.Chart(4, 3, 100)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	short, err := g.Chart(4, 3, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if short.ThreeBet.Count() <= deep.ThreeBet.Count() {
+		t.Errorf("expected more than %f combos, got: %f", deep.ThreeBet.Count(), short.ThreeBet.Count())
+	}
+	s := fmt.Sprintf("%s", short)
+	for _, exp := range []string{"open (4, 10bb):\n", "call (vs 3):\n", "3-bet (vs 3):\nAA  "} {
+		if !strings.Contains(s, exp) {
+			t.Errorf("expected %q in:\n%s", exp, s)
+		}
+	}
+}
+
+func TestChartGenShort(t *testing.T) {
+	g, err := NewChartGen(Short, WithChartSamples(100), WithChartPlayers(3))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	eq := g.Equity(2)
+	for _, key := range []string{"AA", "AKs", "76o", "66"} {
+		if eq.Get(key) == 0 {
+			t.Errorf("expected %s equity", key)
+		}
+	}
+	for _, key := range []string{"55", "A5s", "72o"} {
+		if eq.Get(key) != 0 {
+			t.Errorf("expected no %s equity, got: %f", key, eq.Get(key))
+		}
+	}
+	if open := g.Open(0); open.Get("A5s") != 0 || open.Get("AA") != 1 {
+		t.Errorf("expected AA and not A5s:\n%s", open)
+	}
+}
+
+func TestChartGenErrors(t *testing.T) {
+	if _, err := NewChartGen(Omaha); err != ErrInvalidType {
+		t.Errorf("expected %v, got: %v", ErrInvalidType, err)
+	}
+	g, err := NewChartGen(Holdem, WithChartSamples(10))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tests := []struct {
+		position, opener int
+		stack            float64
+	}{
+		{-1, -1, 100},
+		{6, -1, 100},
+		{2, 2, 100},
+		{2, -2, 100},
+		{2, 1, 0},
+	}
+	for i, test := range tests {
+		if _, err := g.Chart(test.position, test.opener, test.stack); err == nil {
+			t.Errorf("test %d expected error", i)
+		}
+	}
+}
+
+func TestStartingChart(t *testing.T) {
+	holdem, err := StartingChart(Holdem, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	g, err := NewChartGen(Holdem)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := g.Equity(1)
+	for i := range 13 {
+		for j := range 13 {
+			if math.Abs(holdem[i][j]-exp[i][j]) > 1e-6 {
+				t.Errorf("expected %s %f, got: %f", GridKey(i, j), exp[i][j], holdem[i][j])
+			}
+		}
+	}
+	short, err := StartingChart(Short, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, key := range []string{"55", "A5s", "72o"} {
+		if short.Get(key) != 0 {
+			t.Errorf("expected no %s expected value, got: %f", key, short.Get(key))
+		}
+	}
+	if aa, kk := short.Get("AA"), short.Get("KK"); aa <= kk || aa < 0.5 || 1 < aa {
+		t.Errorf("expected AA %f to beat KK %f", aa, kk)
+	}
+	multi, err := StartingChart(Holdem, 3)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if aa := multi.Get("AA"); holdem.Get("AA") <= aa || aa < 0.6 {
+		t.Errorf("expected 3 player AA %f to be less than %f", aa, holdem.Get("AA"))
+	}
+	tests := []struct {
+		typ     Type
+		players int
+		err     error
+	}{
+		{Omaha, 2, ErrInvalidType},
+		{Holdem, 1, ErrInvalidCount},
+		{Short, 7, ErrInvalidCount},
+	}
+	for i, test := range tests {
+		if _, err := StartingChart(test.typ, test.players); err != test.err {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
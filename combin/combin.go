@@ -0,0 +1,199 @@
+// Package combin provides combination and permutation generators, and
+// combination ranking and unranking, for enumerating k-subsets of cards (or
+// any other slice).
+//
+// Combinations are generated in lexicographic order of their indices, and
+// the rank of a combination is its position in that order (see [Rank] and
+// [Unrank]), allowing enumerations to be split across workers or resumed.
+package combin
+
+import (
+	"iter"
+	"slices"
+)
+
+// Binomial returns the binomial coefficient n choose k, the number of k
+// element combinations of n elements. Returns 0 when k < 0 or n < k.
+func Binomial(n, k int) int {
+	if k < 0 || n < k {
+		return 0
+	}
+	k = min(k, n-k)
+	c := 1
+	for i := range k {
+		c = c * (n - i) / (i + 1)
+	}
+	return c
+}
+
+// Permutation returns the number of k element permutations of n elements.
+// Returns 0 when k < 0 or n < k.
+func Permutation(n, k int) int {
+	if k < 0 || n < k {
+		return 0
+	}
+	p := 1
+	for i := range k {
+		p *= n - i
+	}
+	return p
+}
+
+// Combinations returns a sequence of the k element combinations of s, in
+// lexicographic order of the elements' indices in s.
+//
+// The yielded slice is reused, and must be copied when retained.
+func Combinations[T any](s []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(s)
+		if k < 0 || n < k {
+			return
+		}
+		v, d := First(k), make([]T, k)
+		for {
+			for i, j := range v {
+				d[i] = s[j]
+			}
+			if !yield(d) || !Next(v, n) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed returns a sequence of the rank and k element combinations of s,
+// starting at the combination with rank start, and ending before the
+// combination with rank end (see [Rank]). Use to split an enumeration into
+// ranges.
+//
+// The yielded slice is reused, and must be copied when retained.
+func Indexed[T any](s []T, k, start, end int) iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		n := len(s)
+		end = min(end, Binomial(n, k))
+		if start < 0 || end <= start {
+			return
+		}
+		v, d := Unrank(start, n, k), make([]T, k)
+		for r := start; r < end; r++ {
+			for i, j := range v {
+				d[i] = s[j]
+			}
+			if !yield(r, d) {
+				return
+			}
+			Next(v, n)
+		}
+	}
+}
+
+// Permutations returns a sequence of the k element permutations of s, in
+// lexicographic order of the elements' indices in s.
+//
+// The yielded slice is reused, and must be copied when retained.
+func Permutations[T any](s []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(s)
+		if k < 0 || n < k {
+			return
+		}
+		v, d := First(n), make([]T, k)
+		for {
+			for i := range k {
+				d[i] = s[v[i]]
+			}
+			if !yield(d) {
+				return
+			}
+			// reverse the unused tail, so the next permutation of all n
+			// elements is the next k element permutation
+			slices.Reverse(v[k:])
+			if !nextPermutation(v) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first combination of k indices, 0 through k-1.
+func First(k int) []int {
+	v := make([]int, k)
+	for i := range v {
+		v[i] = i
+	}
+	return v
+}
+
+// Next advances the combination of sorted indices v, of n elements, to the
+// next combination in lexicographic order. Returns false when v is the last
+// combination.
+func Next(v []int, n int) bool {
+	k := len(v)
+	for i := k - 1; 0 <= i; i-- {
+		if v[i] == n+i-k {
+			continue
+		}
+		v[i]++
+		for j := i + 1; j < k; j++ {
+			v[j] = v[i] + j - i
+		}
+		return true
+	}
+	return false
+}
+
+// Rank returns the lexicographic rank of the combination of sorted indices v,
+// of n elements, between 0 and [Binomial](n, len(v))-1.
+func Rank(v []int, n int) int {
+	k := len(v)
+	r := Binomial(n, k) - 1
+	for i, j := range v {
+		r -= Binomial(n-1-j, k-i)
+	}
+	return r
+}
+
+// Unrank returns the combination of k sorted indices, of n elements, having
+// the lexicographic rank r. Inverse of [Rank].
+func Unrank(r, n, k int) []int {
+	return UnrankInto(make([]int, k), r, n)
+}
+
+// UnrankInto sets v to the combination of len(v) sorted indices, of n
+// elements, having the lexicographic rank r, returning v. See [Unrank].
+func UnrankInto(v []int, r, n int) []int {
+	k := len(v)
+	// convert to the combinatorial number system of the complement
+	x, m := Binomial(n, k)-1-r, n
+	for i := range k {
+		j := k - i
+		m--
+		b := Binomial(m, j)
+		for b > x {
+			// binomial(m-1, j) from binomial(m, j)
+			b, m = b*(m-j)/m, m-1
+		}
+		x -= b
+		v[i] = n - 1 - m
+	}
+	return v
+}
+
+// nextPermutation advances v to the next permutation in lexicographic order.
+// Returns false when v is the last permutation.
+func nextPermutation(v []int) bool {
+	i := len(v) - 2
+	for 0 <= i && v[i] >= v[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(v) - 1
+	for v[j] <= v[i] {
+		j--
+	}
+	v[i], v[j] = v[j], v[i]
+	slices.Reverse(v[i+1:])
+	return true
+}
@@ -0,0 +1,132 @@
+package combin
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestBinomial(t *testing.T) {
+	tests := []struct {
+		n, k, exp int
+	}{
+		{0, 0, 1},
+		{5, 0, 1},
+		{5, 5, 1},
+		{5, 2, 10},
+		{52, 2, 1326},
+		{52, 5, 2598960},
+		{52, 26, 495918532948104},
+		{5, 6, 0},
+		{5, -1, 0},
+	}
+	for _, test := range tests {
+		if n := Binomial(test.n, test.k); n != test.exp {
+			t.Errorf("%d choose %d expected %d, got: %d", test.n, test.k, test.exp, n)
+		}
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		s   string
+		k   int
+		exp string
+	}{
+		{"abcd", 2, "[ab ac ad bc bd cd]"},
+		{"abcd", 4, "[abcd]"},
+		{"abcd", 0, "[]"},
+		{"abc", 4, "[]"},
+	}
+	for _, test := range tests {
+		var v []string
+		for c := range Combinations([]byte(test.s), test.k) {
+			v = append(v, string(c))
+		}
+		if s := fmt.Sprintf("%v", v); s != test.exp {
+			t.Errorf("%s %d expected %s, got: %s", test.s, test.k, test.exp, s)
+		}
+	}
+	var n int
+	for range Combinations(make([]int, 52), 3) {
+		n++
+	}
+	if exp := Binomial(52, 3); n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		s   string
+		k   int
+		exp string
+	}{
+		{"abc", 2, "[ab ac ba bc ca cb]"},
+		{"abc", 3, "[abc acb bac bca cab cba]"},
+		{"abc", 1, "[a b c]"},
+		{"abc", 4, "[]"},
+	}
+	for _, test := range tests {
+		var v []string
+		for p := range Permutations([]byte(test.s), test.k) {
+			v = append(v, string(p))
+		}
+		if s := fmt.Sprintf("%v", v); s != test.exp {
+			t.Errorf("%s %d expected %s, got: %s", test.s, test.k, test.exp, s)
+		}
+	}
+	var n int
+	for range Permutations(make([]int, 7), 4) {
+		n++
+	}
+	if exp := Permutation(7, 4); n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+}
+
+func TestRank(t *testing.T) {
+	for _, k := range []int{0, 1, 2, 3, 5} {
+		const n = 9
+		v, r := First(k), 0
+		for {
+			if i := Rank(v, n); i != r {
+				t.Errorf("%v expected rank %d, got: %d", v, r, i)
+			}
+			if u := Unrank(r, n, k); !slices.Equal(u, v) {
+				t.Errorf("rank %d expected %v, got: %v", r, v, u)
+			}
+			if u := UnrankInto(make([]int, k), r, n); !slices.Equal(u, v) {
+				t.Errorf("rank %d expected %v, got: %v", r, v, u)
+			}
+			r++
+			if !Next(v, n) {
+				break
+			}
+		}
+		if exp := Binomial(n, k); r != exp {
+			t.Errorf("expected %d combinations, got: %d", exp, r)
+		}
+	}
+}
+
+func TestIndexed(t *testing.T) {
+	s := []byte("abcdef")
+	var all []string
+	for c := range Combinations(s, 3) {
+		all = append(all, string(c))
+	}
+	// split into ranges
+	var v []string
+	for start := 0; start < len(all); start += 7 {
+		for r, c := range Indexed(s, 3, start, start+7) {
+			if all[r] != string(c) {
+				t.Errorf("rank %d expected %s, got: %s", r, all[r], c)
+			}
+			v = append(v, string(c))
+		}
+	}
+	if !slices.Equal(v, all) {
+		t.Errorf("expected %v, got: %v", all, v)
+	}
+}
@@ -0,0 +1,29 @@
+package combin_test
+
+import (
+	"fmt"
+
+	"github.com/cardrank/cardrank"
+	"github.com/cardrank/cardrank/combin"
+)
+
+func Example() {
+	cards := cardrank.Must("Ah Kh Qh Jh")
+	for v := range combin.Combinations(cards, 3) {
+		fmt.Println(v)
+	}
+	// Output:
+	// [Ah Kh Qh]
+	// [Ah Kh Jh]
+	// [Ah Qh Jh]
+	// [Kh Qh Jh]
+}
+
+func ExampleUnrank() {
+	// the 1000th two card combination of a 52 card deck
+	deck := cardrank.DeckFrench.Unshuffled()
+	v := combin.Unrank(999, len(deck), 2)
+	fmt.Println(v, deck[v[0]], deck[v[1]], combin.Rank(v, len(deck)))
+	// Output:
+	// [25 50] Ah Kc 999
+}
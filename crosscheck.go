@@ -0,0 +1,143 @@
+package cardrank
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/cardrank/cardrank/combin"
+)
+
+// CrossCheck ranks the best 5 of the 5, 6, or 7 cards through each of the
+// package's independent Cactus rank paths, returning the rank when all paths
+// agree. The reference rank is the best [Cactus] rank of each 5 card
+// combination of the cards, and is compared with:
+//
+//   - the best [CactusFast] rank of each 5 card combination (when built)
+//   - the direct 5, 6, or 7 card rank of [RankCactus] (see [NewEval])
+//   - the Two-Plus-Two lookup table rank (when built)
+//
+// Returns [ErrInvalidCount] when there are not 5 to 7 cards,
+// [ErrInvalidCard] or [ErrDuplicateCard] when a card is invalid or used more
+// than once, or [ErrEvalMismatch] wrapped with the mismatched path.
+//
+// Designed for use with fuzzing harnesses, see [Type.ValidateEval].
+func CrossCheck(v []Card) (EvalRank, error) {
+	if n := len(v); n < 5 || 7 < n {
+		return Invalid, fmt.Errorf("%w %d", ErrInvalidCount, n)
+	}
+	if err := validateCards(DeckFrench, make(map[Card]bool), v); err != nil {
+		return Invalid, err
+	}
+	exp := best5(Cactus, v)
+	check := func(name string, r EvalRank) error {
+		if r != exp {
+			return fmt.Errorf("%w: %s rank %d, expected %d for %v", ErrEvalMismatch, name, r, exp, v)
+		}
+		return nil
+	}
+	if cactusFast != nil {
+		if err := check("fast", best5(cactusFast, v)); err != nil {
+			return Invalid, err
+		}
+	}
+	if RankCactus != nil {
+		ev := EvalOf(Holdem)
+		NewEval(RankCactus)(ev, v, nil)
+		r := ev.HiRank
+		PutEval(ev)
+		if err := check("direct", r); err != nil {
+			return Invalid, err
+		}
+	}
+	if twoPlusTwo != nil {
+		if err := check("table", twoPlusTwo(v)); err != nil {
+			return Invalid, err
+		}
+	}
+	return exp, nil
+}
+
+// ValidateEval evaluates the pocket and board with the type's registered eval
+// func (see [RegisterType]), checking the eval's invariants:
+//
+//   - the Hi and Lo best and unused cards are drawn from the pocket and board
+//   - the eval is independent of the order of the pocket and board, except
+//     for [EvalOFC] types, where the pocket is in placement order
+//   - for [EvalCactus] types with a complete board, the Hi rank matches the
+//     rank of [CrossCheck]
+//   - for [EvalOmaha] types with a complete board, the Hi rank matches the
+//     best [Cactus] rank of each 2 pocket and 3 board card combination
+//
+// Returns a validation error for the pocket and board (see [Type.Validate]),
+// or [ErrEvalMismatch] wrapped with the failed invariant.
+//
+// Designed for use with fuzzing harnesses, and for verifying custom eval
+// funcs.
+func (typ Type) ValidateEval(pocket, board []Card) error {
+	if err := typ.Validate(pocket, board); err != nil {
+		return err
+	}
+	desc := descs[typ]
+	ev := typ.Eval(pocket, board)
+	defer PutEval(ev)
+	all := slices.Concat(pocket, board)
+	for _, v := range [][]Card{
+		slices.Concat(ev.HiBest, ev.HiUnused),
+		slices.Concat(ev.LoBest, ev.LoUnused),
+	} {
+		for i, c := range v {
+			if !slices.Contains(all, c) || slices.Contains(v[i+1:], c) {
+				return fmt.Errorf("%w: %s: best and unused %v not drawn from %v", ErrEvalMismatch, typ, v, all)
+			}
+		}
+	}
+	if desc.Eval != EvalOFC {
+		rev := typ.Eval(reversed(pocket), reversed(board))
+		defer PutEval(rev)
+		if ev.HiRank != rev.HiRank || ev.LoRank != rev.LoRank {
+			return fmt.Errorf("%w: %s: reordered rank %d/%d, expected %d/%d", ErrEvalMismatch, typ, rev.HiRank, rev.LoRank, ev.HiRank, ev.LoRank)
+		}
+	}
+	if len(board) != desc.board {
+		return nil
+	}
+	exp := Invalid
+	switch desc.Eval {
+	case EvalCactus:
+		if n := len(all); n < 5 || 7 < n {
+			return nil
+		}
+		var err error
+		if exp, err = CrossCheck(all); err != nil {
+			return err
+		}
+	case EvalOmaha:
+		for p := range combin.Combinations(pocket, 2) {
+			for b := range combin.Combinations(board, 3) {
+				exp = min(exp, Cactus(p[0], p[1], b[0], b[1], b[2]))
+			}
+		}
+	default:
+		return nil
+	}
+	if ev.HiRank != exp {
+		return fmt.Errorf("%w: %s: rank %d, expected %d for %v %v", ErrEvalMismatch, typ, ev.HiRank, exp, pocket, board)
+	}
+	return nil
+}
+
+// best5 returns the best rank of each 5 card combination of v.
+func best5(f RankFunc, v []Card) EvalRank {
+	r := Invalid
+	for c := range combin.Combinations(v, 5) {
+		r = min(r, f(c[0], c[1], c[2], c[3], c[4]))
+	}
+	return r
+}
+
+// reversed returns a reversed copy of v.
+func reversed(v []Card) []Card {
+	v = slices.Clone(v)
+	slices.Reverse(v)
+	return v
+}
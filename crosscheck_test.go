@@ -0,0 +1,70 @@
+package cardrank
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestCrossCheck(t *testing.T) {
+	tests := []struct {
+		v   string
+		exp EvalRank
+		err error
+	}{
+		{"As Ks Qs Js Ts", 1, nil},
+		{"As Ks Qs Js Ts 2c 3d", 1, nil},
+		{"2c 3d 4h 5s 7c 8d 9h", Invalid, nil},
+		{"As Ks Qs Js", Invalid, ErrInvalidCount},
+		{"As Ks Qs Js Ts 2c 3d 4d", Invalid, ErrInvalidCount},
+		{"As Ks Qs Js As", Invalid, ErrDuplicateCard},
+	}
+	for i, test := range tests {
+		r, err := CrossCheck(Must(test.v))
+		switch {
+		case !errors.Is(err, test.err):
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		case test.err == nil && test.exp != Invalid && r != test.exp:
+			t.Errorf("test %d expected %d, got: %d", i, test.exp, r)
+		}
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := range 2000 {
+		v := DeckFrench.Shuffle(r, 1).Draw(5 + i%3)
+		if _, err := CrossCheck(v); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+	}
+}
+
+func TestValidateEval(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, typ := range Types() {
+		t.Run(typ.Name(), func(t *testing.T) {
+			for range 50 {
+				pockets, board := typ.Deal(r, 1, min(2, typ.Max()))
+				for _, pocket := range pockets {
+					if err := typ.ValidateEval(pocket, board); err != nil {
+						t.Fatalf("expected no error, got: %v", err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValidateEvalMismatch(t *testing.T) {
+	f := evals[Holdem]
+	defer func() {
+		evals[Holdem] = f
+	}()
+	// ranks all hands as a high card
+	evals[Holdem] = func(ev *Eval, p, b []Card) {
+		f(ev, p, b)
+		ev.HiRank = HighCard
+	}
+	err := Holdem.ValidateEval(Must("As Kd"), Must("Qs Jd 2h 3c 4d"))
+	if !errors.Is(err, ErrEvalMismatch) {
+		t.Errorf("expected %v, got: %v", ErrEvalMismatch, err)
+	}
+}
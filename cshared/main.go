@@ -0,0 +1,196 @@
+//go:build cgo
+
+// Command cshared exports a C compatible API for the cardrank package, for use
+// by Python, C#, C++, and other callers able to link against a shared
+// library.
+//
+// Build with:
+//
+//	go build -buildmode=c-shared -o libcardrank.so ./cshared
+//
+// which generates libcardrank.so and the libcardrank.h header.
+//
+// Strings passed to and from the API are NUL terminated UTF-8. Output strings
+// are written to caller allocated buffers, and are truncated (and NUL
+// terminated) when the buffer is too small. Functions return a negative error
+// code on failure, and never panic:
+//
+//	-1 - invalid type
+//	-2 - invalid cards
+//	-3 - invalid argument
+//	-4 - calculation failed
+//
+// Types may be specified by either id ("Hh") or name ("Holdem"), and cards are
+// parsed using [cardrank.Parse].
+package main
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"unsafe"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Error codes.
+const (
+	errInvalidType     = -1
+	errInvalidCards    = -2
+	errInvalidArgument = -3
+	errCalcFailed      = -4
+)
+
+func main() {}
+
+// cardrank_eval evaluates the pocket and board for the type, returning the Hi
+// eval rank (lower is better), and writing the Hi description and best cards
+// to desc (ex: "Straight Flush, Ace-high, Royal [As Ks Qs Js Ts]").
+//
+//export cardrank_eval
+func cardrank_eval(typ, pocket, board *C.char, desc *C.char, n C.int) (ret C.int) {
+	defer guard(&ret)
+	t, ok := parseType(typ)
+	if !ok {
+		return errInvalidType
+	}
+	p, err := cardrank.Parse(C.GoString(pocket))
+	if err != nil {
+		return errInvalidCards
+	}
+	b, err := cardrank.Parse(C.GoString(board))
+	if err != nil || t.Validate(p, b) != nil {
+		return errInvalidCards
+	}
+	ev := t.Eval(p, b)
+	write(desc, n, fmt.Sprintf("%s", ev))
+	return C.int(ev.HiRank)
+}
+
+// cardrank_eval_lo evaluates the pocket and board for the type, returning the
+// Lo eval rank (lower is better), and writing the Lo description to desc.
+//
+//export cardrank_eval_lo
+func cardrank_eval_lo(typ, pocket, board *C.char, desc *C.char, n C.int) (ret C.int) {
+	defer guard(&ret)
+	t, ok := parseType(typ)
+	if !ok {
+		return errInvalidType
+	}
+	p, err := cardrank.Parse(C.GoString(pocket))
+	if err != nil {
+		return errInvalidCards
+	}
+	b, err := cardrank.Parse(C.GoString(board))
+	if err != nil || t.Validate(p, b) != nil {
+		return errInvalidCards
+	}
+	ev := t.Eval(p, b)
+	write(desc, n, fmt.Sprintf("%s %s", ev.Desc(true), cardrank.Formatter(ev.LoBest)))
+	return C.int(ev.LoRank)
+}
+
+// cardrank_odds calculates the Hi odds for count pockets and the board for the
+// type, returning the total number of outcomes, and writing each pocket's
+// odds (as a percent) to percents, which must have room for count values.
+//
+//export cardrank_odds
+func cardrank_odds(typ *C.char, pockets **C.char, count C.int, board *C.char, percents *C.double) (ret C.int) {
+	defer guard(&ret)
+	t, ok := parseType(typ)
+	if !ok {
+		return errInvalidType
+	}
+	if count < 1 || pockets == nil || percents == nil {
+		return errInvalidArgument
+	}
+	v := make([][]cardrank.Card, count)
+	for i, s := range unsafe.Slice(pockets, int(count)) {
+		var err error
+		if v[i], err = cardrank.Parse(C.GoString(s)); err != nil {
+			return errInvalidCards
+		}
+	}
+	b, err := cardrank.Parse(C.GoString(board))
+	if err != nil {
+		return errInvalidCards
+	}
+	if t.ValidatePockets(v, b) != nil {
+		return errInvalidCards
+	}
+	odds, _, ok := t.Odds(context.Background(), v, b)
+	if !ok || odds == nil {
+		return errCalcFailed
+	}
+	out := unsafe.Slice(percents, int(count))
+	for i := range out {
+		out[i] = C.double(odds.Percent(i))
+	}
+	return C.int(odds.Total)
+}
+
+// cardrank_deal deals count pockets and a board for the type using the seed,
+// writing the pockets and board to out, separated by a '|' (ex: "Ah Kd|7c
+// 2s|Qh Jd 9c 4s 4h"), and returning the number of pockets dealt.
+//
+//export cardrank_deal
+func cardrank_deal(typ *C.char, count C.int, seed C.uint64_t, out *C.char, n C.int) (ret C.int) {
+	defer guard(&ret)
+	t, ok := parseType(typ)
+	if !ok {
+		return errInvalidType
+	}
+	if count < 1 || C.int(t.Max()) < count {
+		return errInvalidArgument
+	}
+	r := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
+	pockets, board := t.Deal(r, 1, int(count))
+	v := make([]string, 0, len(pockets)+1)
+	for _, pocket := range pockets {
+		v = append(v, join(pocket))
+	}
+	write(out, n, strings.Join(append(v, join(board)), "|"))
+	return C.int(len(pockets))
+}
+
+// guard recovers from a panic, setting ret to [errCalcFailed], as a panic in
+// an exported func aborts the calling process.
+func guard(ret *C.int) {
+	if recover() != nil {
+		*ret = errCalcFailed
+	}
+}
+
+// parseType parses the type.
+func parseType(s *C.char) (cardrank.Type, bool) {
+	var typ cardrank.Type
+	if s == nil || typ.UnmarshalText([]byte(C.GoString(s))) != nil {
+		return 0, false
+	}
+	return typ, true
+}
+
+// join joins the cards with a space.
+func join(v []cardrank.Card) string {
+	s := make([]string, len(v))
+	for i, c := range v {
+		s[i] = c.String()
+	}
+	return strings.Join(s, " ")
+}
+
+// write writes s to buf of size n, truncating and NUL terminating.
+func write(buf *C.char, n C.int, s string) {
+	if buf == nil || n < 1 {
+		return
+	}
+	b := unsafe.Slice((*byte)(unsafe.Pointer(buf)), int(n))
+	b[copy(b[:n-1], s)] = 0
+}
@@ -3,12 +3,17 @@ package cardrank
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // Shuffler is an interface for a deck shuffler. Compatible with
-// math/rand.Rand's Shuffle method.
+// math/rand.Rand's Shuffle method. See [CryptoShuffler] for a
+// cryptographically secure shuffler.
 type Shuffler interface {
 	Shuffle(n int, swap func(int, int))
 }
@@ -28,6 +33,9 @@ const (
 	DeckSpanish = DeckType(Eight)
 	// DeckRoyal is a deck of 20 playing cards of rank 10+ (see [Royal]).
 	DeckRoyal = DeckType(Ten)
+	// DeckEuchre is a deck of 24 playing cards of rank 9+, for use with
+	// trick-taking games (see [EuchreOrder]).
+	DeckEuchre = DeckType(Nine)
 	// DeckKuhn is a deck of 3 playing cards, a [King], [Queen], and a [Jack]
 	// (see [Kuhn]).
 	DeckKuhn = DeckType(^uint8(0) - 1)
@@ -49,6 +57,8 @@ func (typ DeckType) Name() string {
 		return "Spanish"
 	case DeckRoyal:
 		return "Royal"
+	case DeckEuchre:
+		return "Euchre"
 	case DeckKuhn:
 		return "Kuhn"
 	case DeckLeduc:
@@ -96,7 +106,7 @@ func (typ DeckType) Format(f fmt.State, verb rune) {
 // Unshuffled returns a set of the deck's unshuffled cards.
 func (typ DeckType) Unshuffled() []Card {
 	switch typ {
-	case DeckFrench, DeckShort, DeckManila, DeckSpanish, DeckRoyal:
+	case DeckFrench, DeckShort, DeckManila, DeckSpanish, DeckEuchre, DeckRoyal:
 		v := make([]Card, 4*(Ace-Rank(typ)+1))
 		var i int
 		for _, s := range []Suit{Spade, Heart, Diamond, Club} {
@@ -119,29 +129,75 @@ func (typ DeckType) Unshuffled() []Card {
 	return nil
 }
 
+// Len returns the number of cards in the deck.
+func (typ DeckType) Len() int {
+	return len(typ.v())
+}
+
+// Index returns the card's dense index (0 to [DeckType.Len]-1) for the deck,
+// or -1 when the card is not contained in the deck.
+//
+// Indexes are stable and ordered the same as [DeckType.Unshuffled]: for the
+// French, Short, Manila, Spanish, Euchre, and Royal decks, cards are ordered
+// by suit ([Spade], [Heart], [Diamond], [Club]) then by rank (lowest to
+// highest), such that the index is the suit index multiplied by the number of
+// ranks, plus the rank's offset from the deck's lowest rank. For the Kuhn and Leduc decks,
+// cards are ordered [King], [Queen], [Jack] for each suit.
+func (typ DeckType) Index(c Card) int {
+	switch typ {
+	case DeckFrench, DeckShort, DeckManila, DeckSpanish, DeckEuchre, DeckRoyal:
+		if r := c.Rank(); c.Valid() && Rank(typ) <= r {
+			return c.Suit().Index()*int(Ace-Rank(typ)+1) + int(r-Rank(typ))
+		}
+	case DeckKuhn, DeckLeduc:
+		for i, d := range typ.v() {
+			if c == d {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// Card returns the card for the dense index (see [DeckType.Index]), or
+// [InvalidCard] when the index is out of range.
+func (typ DeckType) Card(i int) Card {
+	if v := typ.v(); 0 <= i && i < len(v) {
+		return v[i]
+	}
+	return InvalidCard
+}
+
 // deck cards.
 var (
 	deckFrench  []Card
 	deckShort   []Card
 	deckManila  []Card
 	deckSpanish []Card
+	deckEuchre  []Card
 	deckRoyal   []Card
 	deckKuhn    []Card
 	deckLeduc   []Card
+	deckOnce    sync.Once
 )
 
-func init() {
-	deckFrench = DeckFrench.Unshuffled()
-	deckShort = DeckShort.Unshuffled()
-	deckManila = DeckManila.Unshuffled()
-	deckSpanish = DeckSpanish.Unshuffled()
-	deckRoyal = DeckRoyal.Unshuffled()
-	deckKuhn = DeckKuhn.Unshuffled()
-	deckLeduc = DeckLeduc.Unshuffled()
+// loadDecks loads the unshuffled decks on first use.
+func loadDecks() {
+	deckOnce.Do(func() {
+		deckFrench = DeckFrench.Unshuffled()
+		deckShort = DeckShort.Unshuffled()
+		deckManila = DeckManila.Unshuffled()
+		deckSpanish = DeckSpanish.Unshuffled()
+		deckEuchre = DeckEuchre.Unshuffled()
+		deckRoyal = DeckRoyal.Unshuffled()
+		deckKuhn = DeckKuhn.Unshuffled()
+		deckLeduc = DeckLeduc.Unshuffled()
+	})
 }
 
 // v returns the cards for the type.
 func (typ DeckType) v() []Card {
+	loadDecks()
 	switch typ {
 	case DeckFrench:
 		return deckFrench
@@ -151,6 +207,8 @@ func (typ DeckType) v() []Card {
 		return deckManila
 	case DeckSpanish:
 		return deckSpanish
+	case DeckEuchre:
+		return deckEuchre
 	case DeckRoyal:
 		return deckRoyal
 	case DeckKuhn:
@@ -188,6 +246,79 @@ func (typ DeckType) Shuffle(shuffler Shuffler, shuffles int) *Deck {
 	return d
 }
 
+// ShuffleSeed returns a new deck, deterministically shuffled using the seed,
+// allowing a deal to be reproduced from a committed seed (see [AuditLog]).
+//
+// The deck's unshuffled cards (see [DeckType.Unshuffled]) are shuffled with a
+// Fisher-Yates shuffle, using the 64-bit values of a ChaCha8 stream keyed by
+// the seed (see [rand.NewChaCha8] and the [ChaCha8Rand] specification). For i
+// from n-1 down to 1, the cards at i and j are swapped, where j is v mod (i+1)
+// for the first value v less than 2^64-1 - (2^64-1) mod (i+1). Use
+// [DeckType.PermutationIndex] to recover the permutation of the shuffled
+// deck.
+//
+// [ChaCha8Rand]: https://c2sp.org/chacha8rand
+func (typ DeckType) ShuffleSeed(seed [32]byte) *Deck {
+	d, src := typ.Shoe(1), rand.NewChaCha8(seed)
+	fisherYates(len(d.v), func(i, j int) {
+		d.v[i], d.v[j] = d.v[j], d.v[i]
+	}, src.Uint64)
+	return d
+}
+
+// PermutationIndex returns the lexicographic index (0 to n!-1) of the
+// permutation of the deck's n unshuffled cards (see [DeckType.Unshuffled]),
+// such that the unshuffled cards have index 0. Returns [ErrInvalidCard] when
+// cards is not a permutation of the deck's cards.
+func (typ DeckType) PermutationIndex(cards []Card) (*big.Int, error) {
+	n := typ.Len()
+	if len(cards) != n || n == 0 {
+		return nil, ErrInvalidCard
+	}
+	used := make([]bool, n)
+	index, f := new(big.Int), new(big.Int)
+	for i, c := range cards {
+		j := typ.Index(c)
+		if j == -1 || used[j] {
+			return nil, ErrInvalidCard
+		}
+		used[j] = true
+		// count the unused cards ordered before the card
+		var k int64
+		for _, b := range used[:j] {
+			if !b {
+				k++
+			}
+		}
+		index.Mul(index, f.SetInt64(int64(n-i)))
+		index.Add(index, f.SetInt64(k))
+	}
+	return index, nil
+}
+
+// Permutation returns a new deck having the permutation of the deck's
+// unshuffled cards for the lexicographic index (see
+// [DeckType.PermutationIndex]). Returns [ErrInvalidData] when the index is
+// not in the range 0 to n!-1.
+func (typ DeckType) Permutation(index *big.Int) (*Deck, error) {
+	n := typ.Len()
+	if n == 0 || index.Sign() < 0 || new(big.Int).MulRange(1, int64(n)).Cmp(index) <= 0 {
+		return nil, ErrInvalidData
+	}
+	// factorial number system digits, least significant first
+	digits, x, m := make([]int, n), new(big.Int).Set(index), new(big.Int)
+	for i := range n {
+		x.DivMod(x, m.SetInt64(int64(i+1)), m)
+		digits[n-1-i] = int(m.Int64())
+	}
+	v, d := slices.Clone(typ.v()), typ.Shoe(1)
+	for i, k := range digits {
+		d.v[i] = v[k]
+		v = slices.Delete(v, k, k+1)
+	}
+	return d, nil
+}
+
 // Exclude returns a set of unshuffled cards excluding any supplied cards.
 func (typ DeckType) Exclude(ex ...[]Card) []Card {
 	return Exclude(typ.v(), ex...)
@@ -195,9 +326,10 @@ func (typ DeckType) Exclude(ex ...[]Card) []Card {
 
 // Deck is a set of playing cards.
 type Deck struct {
-	i int
-	l int
-	v []Card
+	i     int
+	l     int
+	v     []Card
+	audit *AuditLog
 }
 
 // DeckOf creates a deck for the provided cards.
@@ -251,14 +383,24 @@ func (d *Deck) Reset() {
 
 // Draw draws count cards from the top (front) of the deck.
 func (d *Deck) Draw(count int) []Card {
+	return d.DrawInto(nil, count)
+}
+
+// DrawInto draws count cards from the top (front) of the deck, appending them
+// to dst. Does not allocate when dst has sufficient capacity.
+func (d *Deck) DrawInto(dst []Card, count int) []Card {
 	if count < 0 {
-		return nil
+		return dst
 	}
-	var cards []Card
-	for l := min(d.i+count, d.l); d.i < l; d.i++ {
-		cards = append(cards, d.v[d.i])
+	l := min(d.i+count, d.l)
+	if d.i < l {
+		if d.audit != nil {
+			d.audit.Draw(d.i, d.v[d.i:l])
+		}
+		dst = append(dst, d.v[d.i:l]...)
+		d.i = l
 	}
-	return cards
+	return dst
 }
 
 // Shuffle shuffles the deck's cards using the shuffler.
@@ -279,14 +421,21 @@ type Dealer struct {
 	TypeDesc
 	Deck    *Deck
 	Count   int
-	Active  map[int]bool
+	Active  Positions
 	Runs    []*Run
 	Results []*Result
 	runs    int
+	drawn   Positions
+	drew    Positions
 	st      int
 	s       int
 	r       int
 	e       int
+	// exposed is the number of exposed cards to use as the next burns (see
+	// [Dealer.Expose])
+	exposed int
+	// observers are the event observers (see [Dealer.Observe])
+	observers []func(Event)
 }
 
 // NewDealer creates a new dealer for a provided deck and pocket count.
@@ -308,17 +457,34 @@ func NewShuffledDealer(desc TypeDesc, shuffler Shuffler, shuffles, count int) *D
 
 // init inits the street position and active positions.
 func (d *Dealer) init() {
-	d.Active = make(map[int]bool)
-	d.Runs = []*Run{NewRun(d.Count)}
+	d.Active = PositionsOf()
+	d.Runs = []*Run{d.newRun()}
 	d.Results = nil
 	d.runs = 1
+	d.drawn, d.drew = 0, 0
+	d.exposed = 0
 	d.st = -1
 	d.s = -1
 	d.r = -1
 	d.e = -1
 	for i := range d.Count {
-		d.Active[i] = true
+		d.Active = d.Active.With(i)
+	}
+}
+
+// newRun creates a new run with pocket, board, and discard capacity
+// preallocated for the dealer's type, allowing streets to be dealt without
+// additional allocations.
+func (d *Dealer) newRun() *Run {
+	lo, discard := 0, d.pocketDiscard+d.boardDiscard
+	if d.Double {
+		lo, discard = d.board, discard+d.boardDiscard
+	}
+	var up int
+	for _, street := range d.Streets {
+		up += street.PocketUp
 	}
+	return newRunCap(d.Count, d.pocket, up, d.board, lo, discard)
 }
 
 // Format satisfies the [fmt.Formatter] interface.
@@ -339,7 +505,7 @@ func (d *Dealer) Format(f fmt.State, verb rune) {
 func (d *Dealer) Inactive() []int {
 	var v []int
 	for i := range d.Count {
-		if !d.Active[i] {
+		if !d.Active.Has(i) {
 			v = append(v, i)
 		}
 	}
@@ -352,9 +518,7 @@ func (d *Dealer) Deactivate(positions ...int) bool {
 	if d.r != -1 && d.r != 0 {
 		return false
 	}
-	for _, position := range positions {
-		delete(d.Active, position)
-	}
+	d.Active = d.Active.Without(positions...)
 	return true
 }
 
@@ -402,7 +566,17 @@ func (d *Dealer) HasBoard() bool {
 
 // HasActive returns true when there is more than 1 active positions.
 func (d *Dealer) HasActive() bool {
-	return 0 <= d.s && (d.Type.Max() == 1 || 1 < len(d.Active))
+	return 0 <= d.s && (d.Type.Max() == 1 || 1 < d.Active.Len())
+}
+
+// IsActive returns true when the position is active.
+func (d *Dealer) IsActive(position int) bool {
+	return d.Active.Has(position)
+}
+
+// ActiveMap returns the active positions as a map.
+func (d *Dealer) ActiveMap() map[int]bool {
+	return d.Active.Map()
 }
 
 // HasCalc returns true when odds are available for calculation.
@@ -536,6 +710,7 @@ func (d *Dealer) ChangeRuns(runs int) bool {
 		d.Runs[run] = d.Runs[0].Dupe()
 	}
 	d.st, d.runs = d.s, runs
+	d.emit(EventRuns, d.s, -1, false, nil)
 	return true
 }
 
@@ -550,26 +725,157 @@ func (d *Dealer) Next() bool {
 	default:
 		d.s++
 	}
+	d.drew = 0
 	switch n := len(d.Streets); {
 	case n <= d.s && d.r == d.runs-1, !d.HasActive():
 		return false
 	case len(d.Streets) <= d.s && d.r < d.runs:
 		d.s, d.r = d.st+1, d.r+1
 	}
+	d.emit(EventStreet, d.s, -1, false, nil)
 	d.Deal(d.s, d.Runs[d.r])
 	return d.s < len(d.Streets) || d.r < d.runs-1
 }
 
+// Draw draws (exchanges) the discarded pocket cards for the position on the
+// current street, returning the replacement cards. Replacement cards are
+// drawn from the deck, and the discards are added to the run's discarded
+// cards. Drawing no cards stands pat.
+//
+// A position can draw once per street allowing draws (see
+// [Dealer.PocketDraw]), or only once when the type limits draws to one time
+// (see [Type.Once]). Draws are not possible after the runs have been changed.
+func (d *Dealer) Draw(position int, discards []Card) ([]Card, error) {
+	switch n := d.PocketDraw(); {
+	case n == 0, d.r != 0, d.runs != 1:
+		return nil, ErrInvalidStreet
+	case position < 0, d.Count <= position, !d.Active.Has(position):
+		return nil, ErrInvalidPocket
+	case d.drew.Has(position), d.Once && d.drawn.Has(position):
+		return nil, ErrInvalidAction
+	case n < len(discards):
+		return nil, ErrInvalidCount
+	case d.Deck.Remaining() < len(discards):
+		return nil, ErrNotEnoughCards
+	}
+	run := d.Runs[d.r]
+	pocket := run.Pockets[position]
+	for i, c := range discards {
+		switch {
+		case !slices.Contains(pocket, c):
+			return nil, ErrInvalidCard
+		case slices.Contains(discards[i+1:], c):
+			return nil, ErrDuplicateCard
+		}
+	}
+	// discards may share the pocket's backing array
+	i := len(run.Discard)
+	run.Discard = append(run.Discard, discards...)
+	pocket = slices.DeleteFunc(pocket, func(c Card) bool {
+		return slices.Contains(run.Discard[i:], c)
+	})
+	n := len(pocket)
+	run.Pockets[position] = d.Deck.DrawInto(pocket, len(discards))
+	d.drawn, d.drew = d.drawn.With(position), d.drew.With(position)
+	run.discard(run.Discard[i:], d.s, DiscardDraw, position)
+	d.emit(EventDiscard, d.s, position, false, run.Discard[i:])
+	d.emit(EventPocket, d.s, position, false, run.Pockets[position][n:])
+	return run.Pockets[position][n:], nil
+}
+
+// Expose replaces the position's pocket card exposed (or fouled) during the
+// deal on the current street, returning the replacement card. The replacement
+// is drawn from the deck, and takes the exposed card's place in the pocket.
+// Per standard procedure, the exposed card becomes the next burn card: the
+// exposed card is added to the run's discarded cards, and the next burn of a
+// following street uses the exposed card instead of drawing from the deck
+// (except for [Type.Double] types dealing boards sequentially).
+//
+// Only pocket cards dealt face down on the current street can be exposed, and
+// only on a street dealing pocket cards. Cards cannot be exposed after the
+// runs have been changed.
+func (d *Dealer) Expose(position int, card Card) (Card, error) {
+	switch {
+	case d.Pocket() == 0, d.r != 0, d.runs != 1:
+		return InvalidCard, ErrInvalidStreet
+	case position < 0, d.Count <= position, !d.Active.Has(position):
+		return InvalidCard, ErrInvalidPocket
+	case d.Deck.Remaining() < 1:
+		return InvalidCard, ErrNotEnoughCards
+	}
+	// pocket cards dealt prior to the current street
+	var offset int
+	for _, street := range d.Streets[:d.s] {
+		offset += street.Pocket
+	}
+	run := d.Runs[d.r]
+	i := slices.Index(run.Pockets[position], card)
+	if i < offset || run.Up != nil && slices.Contains(run.Up[position], card) {
+		return InvalidCard, ErrInvalidCard
+	}
+	v := d.Deck.Draw(1)
+	run.Pockets[position][i] = v[0]
+	run.Discard = append(run.Discard, card)
+	run.discard(run.Discard[len(run.Discard)-1:], d.s, DiscardExposed, position)
+	if !d.Double || d.BoardOrder != BoardSequential {
+		d.exposed++
+	}
+	d.emit(EventDiscard, d.s, position, false, []Card{card})
+	d.emit(EventPocket, d.s, position, false, v)
+	return v[0], nil
+}
+
+// burn draws n burn cards from the deck into the run's discarded cards, using
+// any exposed cards as the first burns (see [Dealer.Expose]).
+func (d *Dealer) burn(run *Run, street, n int, reason DiscardReason) {
+	k := min(d.exposed, n)
+	d.exposed -= k
+	i := len(run.Discard)
+	run.Discard = d.Deck.DrawInto(run.Discard, n-k)
+	run.discard(run.Discard[i:], street, reason, -1)
+}
+
+// RabbitHunt returns up to n of the board cards that would have been dealt on
+// the remaining streets of the current run, in the order they would have been
+// dealt (for [Type.Double] types, each street's Hi cards followed by its Lo
+// cards), such as for showing what would have come after a hand ends early
+// due to folds. Remaining pocket cards and discards are drawn as they would
+// have been, but are not returned. Does not change the dealer's state or the
+// deck's position, and draws are not recorded to the deck's audit log.
+func (d *Dealer) RabbitHunt(n int) []Card {
+	if n <= 0 || d.Count == 0 {
+		return nil
+	}
+	r := d.Runs[max(d.r, 0)]
+	run := r.Dupe()
+	run.seq, run.drawn = r.seq, r.drawn
+	deck := *d.Deck
+	deck.audit = nil
+	dealer := *d
+	dealer.Deck, dealer.observers = &deck, nil
+	// deal the streets not yet dealt
+	var pocket, board int
+	var v []Card
+	for i, street := range d.Streets {
+		pocket, board = pocket+street.Pocket, board+street.Board
+		if pocket <= len(run.Pockets[0]) && board <= len(run.Hi) {
+			continue
+		}
+		h, l := len(run.Hi), len(run.Lo)
+		dealer.Deal(i, run)
+		v = append(append(v, run.Hi[h:]...), run.Lo[l:]...)
+	}
+	return v[:min(n, len(v))]
+}
+
 // NextResult iterates the next result.
 func (d *Dealer) NextResult() bool {
 	if d.Results == nil {
-		switch n := len(d.Active); {
+		switch n := d.Active.Len(); {
 		case d.Results != nil:
 		case n == 1 && d.runs == 1 && d.Max != 1:
 			// only one active position
-			var i int
-			for ; i < d.Count && !d.Active[i]; i++ {
-			}
+			i := d.Active.First()
 			res := &Result{
 				Evals:   []*Eval{EvalOf(d.Type)},
 				HiOrder: []int{i},
@@ -582,15 +888,22 @@ func (d *Dealer) NextResult() bool {
 		case n > 1 || d.Max == 1:
 			d.Results = make([]*Result, d.runs)
 			for i := range d.runs {
-				d.Results[i] = NewResult(d.Type, d.Runs[i], d.Active, false)
+				d.Results[i] = &Result{
+					Evals: d.Runs[i].evalInto(make([]*Eval, len(d.Runs[i].Pockets)), d.Type, d.Active, false),
+				}
 			}
+			OrderResults(nil, d.Results, d.Low || d.Double)
 		}
 	}
 	if d.runs <= d.e {
 		return false
 	}
 	d.e++
-	return d.e < d.runs
+	if d.e < d.runs {
+		d.emit(EventResult, d.s, -1, false, nil)
+		return true
+	}
+	return false
 }
 
 // Deal deals pocket and board cards for the street and run, discarding cards
@@ -600,38 +913,164 @@ func (d *Dealer) Deal(street int, run *Run) {
 	// pockets
 	if p := desc.Pocket; 0 < p {
 		if n := desc.PocketDiscard; 0 < n {
-			run.Discard = append(run.Discard, d.Deck.Draw(n)...)
+			i := len(run.Discard)
+			d.burn(run, street, n, DiscardBurnPocket)
+			d.emit(EventDiscard, street, -1, false, run.Discard[i:])
 		}
 		for range p {
 			for i := range d.Count {
-				run.Pockets[i] = append(run.Pockets[i], d.Deck.Draw(1)...)
+				run.Pockets[i] = d.Deck.DrawInto(run.Pockets[i], 1)
+			}
+		}
+		for i := range d.Count {
+			d.emit(EventPocket, street, i, false, run.Pockets[i][max(0, len(run.Pockets[i])-p):])
+		}
+		// the street's last cards are dealt face up
+		if u := min(desc.PocketUp, p); 0 < u {
+			if run.Up == nil {
+				run.Up = make([][]Card, d.Count)
+			}
+			for i := range d.Count {
+				run.Up[i] = append(run.Up[i], run.Pockets[i][len(run.Pockets[i])-u:]...)
 			}
 		}
 	}
 	// board
-	if b := desc.Board; 0 < b {
+	b := desc.Board
+	if b <= 0 {
+		return
+	}
+	disc, lo := desc.BoardDiscard, desc.BoardDiscard
+	if d.SharedBurn {
+		lo = 0
+	}
+	i, h, l := len(run.Discard), len(run.Hi), len(run.Lo)
+	switch {
+	case d.Double && d.BoardOrder == BoardSequential:
+		if !run.drawn {
+			// draw the remaining streets for each board in turn
+			for i, n := range d.seqCount(street) {
+				run.seq[i] = d.Deck.Draw(n)
+			}
+			run.drawn = true
+		}
+		run.Discard = append(run.Discard, run.seq[0][:disc]...)
+		run.discard(run.seq[0][:disc], street, DiscardBurnHi, -1)
+		run.Hi = append(run.Hi, run.seq[0][disc:disc+b]...)
+		run.Discard = append(run.Discard, run.seq[1][:lo]...)
+		run.discard(run.seq[1][:lo], street, DiscardBurnLo, -1)
+		run.Lo = append(run.Lo, run.seq[1][lo:lo+b]...)
+		run.seq[0], run.seq[1] = run.seq[0][disc+b:], run.seq[1][lo+b:]
+	default:
 		// hi
-		disc := desc.BoardDiscard
 		if 0 < disc {
-			run.Discard = append(run.Discard, d.Deck.Draw(disc)...)
+			d.burn(run, street, disc, DiscardBurnHi)
 		}
-		run.Hi = append(run.Hi, d.Deck.Draw(b)...)
+		run.Hi = d.Deck.DrawInto(run.Hi, b)
 		// lo
 		if d.Double {
-			if 0 < disc {
-				run.Discard = append(run.Discard, d.Deck.Draw(disc)...)
+			if 0 < lo {
+				d.burn(run, street, lo, DiscardBurnLo)
 			}
-			run.Lo = append(run.Lo, d.Deck.Draw(b)...)
+			run.Lo = d.Deck.DrawInto(run.Lo, b)
+		}
+	}
+	if len(run.Discard) != i {
+		d.emit(EventDiscard, street, -1, false, run.Discard[i:])
+	}
+	d.emit(EventBoard, street, -1, false, run.Hi[h:])
+	if d.Double {
+		d.emit(EventBoard, street, -1, true, run.Lo[l:])
+	}
+}
+
+// seqCount returns the count of Hi and Lo board cards, including each
+// street's board discards, of the streets from street on, as drawn for
+// [Type.Double] types dealing boards sequentially.
+func (d *Dealer) seqCount(street int) [2]int {
+	var v [2]int
+	for _, desc := range d.Streets[street:] {
+		if desc.Board <= 0 {
+			continue
+		}
+		v[0] += desc.BoardDiscard + desc.Board
+		v[1] += desc.Board
+		if !d.SharedBurn {
+			v[1] += desc.BoardDiscard
 		}
 	}
+	return v
+}
+
+// DiscardReason is the reason a card was discarded.
+type DiscardReason uint8
+
+// Discard reasons.
+const (
+	// DiscardBurnPocket is a card burned prior to dealing pocket cards.
+	DiscardBurnPocket DiscardReason = iota
+	// DiscardBurnHi is a card burned prior to dealing Hi board cards.
+	DiscardBurnHi
+	// DiscardBurnLo is a card burned prior to dealing Lo board cards, for
+	// [Type.Double] types without a shared burn.
+	DiscardBurnLo
+	// DiscardDraw is a pocket card discarded by a position when drawing (see
+	// [Dealer.Draw]).
+	DiscardDraw
+	// DiscardExposed is a pocket card exposed during the deal and replaced
+	// (see [Dealer.Expose]), and used as the next burn card.
+	DiscardExposed
+)
+
+// String satisfies the [fmt.Stringer] interface.
+func (reason DiscardReason) String() string {
+	switch reason {
+	case DiscardBurnPocket:
+		return "burn pocket"
+	case DiscardBurnHi:
+		return "burn hi"
+	case DiscardBurnLo:
+		return "burn lo"
+	case DiscardDraw:
+		return "draw"
+	case DiscardExposed:
+		return "exposed"
+	}
+	return fmt.Sprintf("DiscardReason(%d)", int(reason))
+}
+
+// Discard is a discarded card, with the street and reason for the discard,
+// allowing a run's burn procedure to be audited.
+type Discard struct {
+	// Card is the discarded card.
+	Card Card
+	// Street is the street the card was discarded on.
+	Street int
+	// Reason is the reason the card was discarded.
+	Reason DiscardReason
+	// Position is the position discarding the card when drawing, or -1.
+	Position int
 }
 
 // Run holds pockets, and a Hi/Lo board for a deal.
 type Run struct {
 	Discard []Card
-	Pockets [][]Card
-	Hi      []Card
-	Lo      []Card
+	// Discards are the discarded cards in the same order as Discard, with the
+	// street and reason for each discard, for runs dealt by a [Dealer].
+	Discards []Discard
+	Pockets  [][]Card
+	// Up are the face up pocket cards of each position, in the order dealt,
+	// for types dealing face up pocket cards (ie, [Stud]). Face up cards are
+	// also contained in the position's pocket. The first face up card is the
+	// door card (see [BringIn]).
+	Up [][]Card
+	Hi []Card
+	Lo []Card
+
+	// seq are the remaining drawn Hi and Lo board cards (and discards) when
+	// dealing double boards sequentially.
+	seq   [2][]Card
+	drawn bool
 }
 
 // NewRun creates a new run for the pocket count.
@@ -641,47 +1080,136 @@ func NewRun(count int) *Run {
 	}
 }
 
+// newRunCap creates a new run for the pocket count, with the pockets, face up
+// pocket cards, Hi and Lo boards, and discard sharing a single preallocated
+// backing array.
+func newRunCap(count, pocket, up, hi, lo, discard int) *Run {
+	v := make([]Card, count*(pocket+up)+hi+lo+discard)
+	n := count
+	if up != 0 {
+		n *= 2
+	}
+	pockets := make([][]Card, n)
+	run := &Run{
+		Pockets: pockets[:count:count],
+	}
+	for i := range count {
+		run.Pockets[i] = carve(&v, pocket)
+	}
+	if up != 0 {
+		run.Up = pockets[count:]
+		for i := range count {
+			run.Up[i] = carve(&v, up)
+		}
+	}
+	run.Hi, run.Lo, run.Discard = carve(&v, hi), carve(&v, lo), carve(&v, discard)
+	if discard != 0 {
+		run.Discards = make([]Discard, 0, discard)
+	}
+	return run
+}
+
+// discard adds the discarded cards to the run's discards.
+func (run *Run) discard(cards []Card, street int, reason DiscardReason, position int) {
+	for _, c := range cards {
+		run.Discards = append(run.Discards, Discard{
+			Card:     c,
+			Street:   street,
+			Reason:   reason,
+			Position: position,
+		})
+	}
+}
+
 // Dupe creates a duplicate of run, with a copy of the pockets and Hi and Lo
-// board.
+// board. The duplicate retains the capacity of the pockets and boards.
 func (run *Run) Dupe() *Run {
-	r := new(Run)
+	n := cap(run.Hi) + cap(run.Lo)
+	for _, pocket := range run.Pockets {
+		n += cap(pocket)
+	}
+	for _, up := range run.Up {
+		n += cap(up)
+	}
+	v, r := make([]Card, n), new(Run)
 	if run.Pockets != nil {
 		r.Pockets = make([][]Card, len(run.Pockets))
 		for i := range len(run.Pockets) {
-			r.Pockets[i] = make([]Card, len(run.Pockets[i]))
-			copy(r.Pockets[i], run.Pockets[i])
+			r.Pockets[i] = dupe(&v, run.Pockets[i])
 		}
 	}
-	if run.Hi != nil {
-		r.Hi = make([]Card, len(run.Hi))
-		copy(r.Hi, run.Hi)
-	}
-	if run.Lo != nil {
-		r.Lo = make([]Card, len(run.Lo))
-		copy(r.Lo, run.Lo)
+	if run.Up != nil {
+		r.Up = make([][]Card, len(run.Up))
+		for i := range len(run.Up) {
+			r.Up[i] = dupe(&v, run.Up[i])
+		}
 	}
+	r.Hi, r.Lo = dupe(&v, run.Hi), dupe(&v, run.Lo)
 	return r
 }
 
-// Eval returns the evals for the run.
-func (run *Run) Eval(typ Type, active map[int]bool, calc bool) []*Eval {
-	n := len(run.Pockets)
-	evs := make([]*Eval, n)
+// carve carves a zero length slice having capacity n from the front of v,
+// returning nil when n is 0.
+func carve(v *[]Card, n int) []Card {
+	if n <= 0 {
+		return nil
+	}
+	s := (*v)[:0:n]
+	*v = (*v)[n:]
+	return s
+}
+
+// dupe copies src into a slice carved from the front of v having the same
+// capacity as src, returning nil when src is nil.
+func dupe(v *[]Card, src []Card) []Card {
+	if src == nil {
+		return nil
+	}
+	s := carve(v, cap(src))
+	if s == nil {
+		return []Card{}
+	}
+	return append(s, src...)
+}
+
+// Eval returns the evals for the run. Only active positions are evaluated,
+// with inactive positions having a nil eval. When active is zero, all
+// positions are evaluated (see [AllPositions]).
+func (run *Run) Eval(typ Type, active Positions, calc bool) []*Eval {
+	if active == 0 {
+		active = AllPositions
+	}
+	return run.evalInto(make([]*Eval, len(run.Pockets)), typ, active, calc)
+}
+
+// evalInto evaluates the run into evs, reusing the evals in evs. Inactive
+// positions are returned to the eval pool and set to nil.
+func (run *Run) evalInto(evs []*Eval, typ Type, active Positions, calc bool) []*Eval {
 	var f EvalFunc
 	if calc {
 		f = calcs[typ]
 	} else {
 		f = evals[typ]
 	}
-	for i, double := 0, typ.Double(); i < n; i++ {
-		if active == nil || active[i] {
+	double := typ.Double()
+	for i := range len(run.Pockets) {
+		if !active.Has(i) {
+			PutEval(evs[i])
+			evs[i] = nil
+			continue
+		}
+		if evs[i] == nil {
 			evs[i] = EvalOf(typ)
-			f(evs[i], run.Pockets[i], run.Hi)
-			if double {
-				ev := EvalOf(typ)
-				f(ev, run.Pockets[i], run.Lo)
-				evs[i].LoRank, evs[i].LoBest, evs[i].LoUnused = ev.HiRank, ev.HiBest, ev.HiUnused
-			}
+		} else {
+			evs[i].Reset(typ)
+		}
+		f(evs[i], run.Pockets[i], run.Hi)
+		if double {
+			ev := GetEval(typ)
+			f(ev, run.Pockets[i], run.Lo)
+			evs[i].LoRank = ev.HiRank
+			evs[i].LoBest, evs[i].LoUnused = evs[i].buf.clone(ev.HiBest), evs[i].buf.clone(ev.HiUnused)
+			PutEval(ev)
 		}
 	}
 	return evs
@@ -689,19 +1217,30 @@ func (run *Run) Eval(typ Type, active map[int]bool, calc bool) []*Eval {
 
 // CalcStart returns the run's starting odds.
 func (run *Run) CalcStart(low bool) (*Odds, *Odds) {
+	return run.CalcStartType(Holdem, low)
+}
+
+// CalcStartType returns the run's starting odds for the type, using the
+// type's starting expected values (see [Type.StartingExpValue]). Returns nil
+// when a pocket does not have a starting expected value.
+func (run *Run) CalcStartType(typ Type, low bool) (*Odds, *Odds) {
 	count := len(run.Pockets)
 	hi := NewOdds(count, nil)
-	hi.Total = startingTotal
 	var lo *Odds
 	if low {
 		lo = NewOdds(count, nil)
-		lo.Total = startingTotal
 	}
 	for i, pocket := range run.Pockets {
-		expv := StartingExpValue(pocket)
+		expv := typ.StartingExpValue(pocket)
 		if expv == nil {
 			return nil, nil
 		}
+		if i == 0 {
+			hi.Total = int(expv.Total)
+			if low {
+				lo.Total = int(expv.Total)
+			}
+		}
 		hi.Counts[i] = int(expv.Wins + expv.Losses)
 		if low {
 			lo.Counts[i] = int(expv.Wins + expv.Losses)
@@ -720,22 +1259,47 @@ type Result struct {
 }
 
 // NewResult creates a result for the run, storing the calculated or evaluated
-// result.
-func NewResult(typ Type, run *Run, active map[int]bool, calc bool) *Result {
-	evs := run.Eval(typ, active, calc)
-	hiOrder, hiPivot := Order(evs, false)
-	var loOrder []int
-	var loPivot int
-	if typ.Low() || typ.Double() {
-		loOrder, loPivot = Order(evs, true)
+// result. When active is zero, all positions are evaluated (see [Run.Eval]).
+func NewResult(typ Type, run *Run, active Positions, calc bool) *Result {
+	res := &Result{
+		Evals: run.Eval(typ, active, calc),
 	}
-	return &Result{
-		Evals:   evs,
-		HiOrder: hiOrder,
-		HiPivot: hiPivot,
-		LoOrder: loOrder,
-		LoPivot: loPivot,
+	OrderResults(nil, []*Result{res}, typ.Low() || typ.Double())
+	return res
+}
+
+// OrderResults orders the evals of each result by Hi, and by Lo when low is
+// true, setting the result's orders and pivots. The orders for all results
+// are carved from buf, which is grown as needed and returned, allowing it to
+// be reused across calls.
+//
+// A result's Lo order will be nil when there are no qualified Lo evals.
+func OrderResults(buf []int, results []*Result, low bool) []int {
+	var n int
+	for _, res := range results {
+		n += len(res.Evals)
 	}
+	if low {
+		n *= 2
+	}
+	if cap(buf) < n {
+		buf = make([]int, n)
+	}
+	v := buf[:n]
+	for _, res := range results {
+		m := len(res.Evals)
+		res.HiOrder, res.HiPivot = OrderInto(v[:m:m], res.Evals, false)
+		v = v[m:]
+		if !low {
+			continue
+		}
+		res.LoOrder, res.LoPivot = OrderInto(v[:m:m], res.Evals, true)
+		if res.LoPivot == 0 {
+			res.LoOrder = nil
+		}
+		v = v[m:]
+	}
+	return buf
 }
 
 // Win returns the Hi and Lo win.
@@ -749,6 +1313,94 @@ func (res *Result) Win(names ...string) (*Win, *Win) {
 	return hi, lo
 }
 
+// Shares returns each position's share of the pot for the result, where the
+// pot is split equally between the Hi and Lo when there is a qualified Lo, and
+// each half is split equally between its winners.
+func (res *Result) Shares() []float64 {
+	v := make([]float64, len(res.Evals))
+	hi := 1.0
+	if res.LoOrder != nil && res.LoPivot != 0 {
+		hi = 0.5
+		for i := range res.LoPivot {
+			v[res.LoOrder[i]] += 0.5 / float64(res.LoPivot)
+		}
+	}
+	for i := range res.HiPivot {
+		v[res.HiOrder[i]] += hi / float64(res.HiPivot)
+	}
+	return v
+}
+
+// Share returns the position's share of the pot for the result.
+func (res *Result) Share(pos int) Share {
+	if pos < 0 || len(res.Evals) <= pos {
+		return ShareNone
+	}
+	return ShareOf(res.Shares()[pos])
+}
+
+// Positions returns the positions having the share of the pot for the result
+// (ie, the positions that were quartered).
+func (res *Result) Positions(share Share) []int {
+	var v []int
+	for pos, f := range res.Shares() {
+		if ShareOf(f) == share {
+			v = append(v, pos)
+		}
+	}
+	return v
+}
+
+// Share is a position's share of a pot.
+type Share uint8
+
+// Shares.
+const (
+	// ShareNone is no share of the pot.
+	ShareNone Share = iota
+	// ShareScoop is the whole pot.
+	ShareScoop
+	// ShareHalf is half the pot.
+	ShareHalf
+	// ShareQuarter is a quarter of the pot.
+	ShareQuarter
+	// SharePartial is any other share of the pot (ie, a third, or three
+	// quarters).
+	SharePartial
+)
+
+// ShareOf returns the share for the fraction of a pot.
+func ShareOf(f float64) Share {
+	switch {
+	case f <= 0:
+		return ShareNone
+	case f == 1:
+		return ShareScoop
+	case f == 0.5:
+		return ShareHalf
+	case f == 0.25:
+		return ShareQuarter
+	}
+	return SharePartial
+}
+
+// String satisfies the [fmt.Stringer] interface.
+func (share Share) String() string {
+	switch share {
+	case ShareNone:
+		return "none"
+	case ShareScoop:
+		return "scoop"
+	case ShareHalf:
+		return "half"
+	case ShareQuarter:
+		return "quarter"
+	case SharePartial:
+		return "partial"
+	}
+	return fmt.Sprintf("Share(%d)", uint8(share))
+}
+
 // Win formats win information.
 type Win struct {
 	Evals []*Eval
@@ -847,3 +1499,73 @@ func (win *Win) Verb() string {
 	}
 	return "wins"
 }
+
+// Summary returns a structured summary of the win, for rendering the win
+// without parsing formatted output. The summary's verb name is translated
+// when the package translator satisfies the [WinTranslator] interface.
+func (win *Win) Summary() *WinSummary {
+	sum := &WinSummary{
+		Low:  win.Low,
+		Verb: win.Verb(),
+	}
+	if win.Invalid() || win.Pivot == 0 {
+		sum.Verb = "none"
+	}
+	sum.VerbName = verbName(sum.Verb)
+	if sum.Verb == "none" {
+		return sum
+	}
+	for i := range win.Pivot {
+		pos := win.Order[i]
+		desc := win.Evals[pos].Desc(win.Low)
+		if desc == nil || desc.Rank == 0 || desc.Rank == Invalid {
+			continue
+		}
+		w := Winner{
+			Position: pos,
+			Share:    1 / float64(win.Pivot),
+			Rank:     desc.Rank,
+			Category: fmt.Sprintf("%e", desc),
+			Desc:     fmt.Sprintf("%s", desc),
+			Best:     slices.Clone(desc.Best),
+			Unused:   slices.Clone(desc.Unused),
+		}
+		if pos < len(win.Names) {
+			w.Name = win.Names[pos]
+		}
+		sum.Winners = append(sum.Winners, w)
+	}
+	return sum
+}
+
+// WinSummary is a structured summary of a Hi or Lo win.
+type WinSummary struct {
+	// Low is true when the win is a Lo win.
+	Low bool
+	// Verb is the win verb (wins, split, push, scoops, none).
+	Verb string
+	// VerbName is the translated win verb.
+	VerbName string
+	// Winners are the winners, in order.
+	Winners []Winner
+}
+
+// Winner is a winner in a win summary.
+type Winner struct {
+	// Position is the winner's position.
+	Position int
+	// Name is the winner's name, when provided.
+	Name string
+	// Share is the winner's share of the win (ie, 0.5 for a split).
+	Share float64
+	// Rank is the winner's eval rank.
+	Rank EvalRank
+	// Category is the winner's category description (ie, Two Pair, 8-Low).
+	Category string
+	// Desc is the winner's full description.
+	Desc string
+	// Best are the winner's best cards.
+	Best []Card
+	// Unused are the winner's unused cards.
+	Unused []Card
+}
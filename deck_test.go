@@ -3,7 +3,9 @@ package cardrank
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"reflect"
 	"slices"
@@ -22,6 +24,7 @@ func TestDeck(t *testing.T) {
 		{36, DeckShort, "6789TJQKA"},
 		{32, DeckManila, "789TJQKA"},
 		{28, DeckSpanish, "89TJQKA"},
+		{24, DeckEuchre, "9TJQKA"},
 		{20, DeckRoyal, "TJQKA"},
 	}
 	for _, test := range tests {
@@ -157,6 +160,125 @@ func testDeckShoe(t *testing.T, exp int, typ DeckType) {
 	}
 }
 
+func TestDeckTypeIndex(t *testing.T) {
+	tests := []struct {
+		typ DeckType
+		exp int
+	}{
+		{DeckFrench, 52},
+		{DeckShort, 36},
+		{DeckManila, 32},
+		{DeckSpanish, 28},
+		{DeckEuchre, 24},
+		{DeckRoyal, 20},
+		{DeckKuhn, 3},
+		{DeckLeduc, 6},
+	}
+	for _, test := range tests {
+		if n := test.typ.Len(); n != test.exp {
+			t.Fatalf("%s expected %d, got: %d", test.typ, test.exp, n)
+		}
+		m := make(map[Card]bool)
+		for i, c := range test.typ.Unshuffled() {
+			if n := test.typ.Index(c); n != i {
+				t.Errorf("%s expected %s to have index %d, got: %d", test.typ, c, i, n)
+			}
+			if d := test.typ.Card(i); d != c {
+				t.Errorf("%s expected index %d to be %s, got: %s", test.typ, i, c, d)
+			}
+			m[c] = true
+		}
+		for _, c := range DeckFrench.Unshuffled() {
+			if i := test.typ.Index(c); !m[c] && i != -1 {
+				t.Errorf("%s expected %s to have index -1, got: %d", test.typ, c, i)
+			}
+		}
+		for _, i := range []int{-1, test.exp} {
+			if c := test.typ.Card(i); c != InvalidCard {
+				t.Errorf("%s expected index %d to be invalid, got: %s", test.typ, i, c)
+			}
+		}
+		if i := test.typ.Index(InvalidCard); i != -1 {
+			t.Errorf("%s expected -1, got: %d", test.typ, i)
+		}
+	}
+	for _, c := range DeckFrench.Unshuffled() {
+		if i, exp := DeckFrench.Index(c), c.Index(); i != exp {
+			t.Errorf("expected %s index %d, got: %d", c, exp, i)
+		}
+	}
+}
+
+func TestDeckTypeShuffleSeed(t *testing.T) {
+	var seed [32]byte
+	v := DeckFrench.ShuffleSeed(seed).All()
+	if s, exp := fmt.Sprintf("%s", v[:10]), "[2s 2d 5s 7h 4d 6c 7s 6d 3h Qc]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+	if u := DeckFrench.ShuffleSeed(seed).All(); !slices.Equal(v, u) {
+		t.Errorf("expected equal shuffles for the same seed")
+	}
+	seed[0] = 1
+	if u := DeckFrench.ShuffleSeed(seed).All(); slices.Equal(v, u) {
+		t.Errorf("expected different shuffles for different seeds")
+	}
+	index, err := DeckFrench.PermutationIndex(v)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	d, err := DeckFrench.Permutation(index)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if u := d.All(); !slices.Equal(v, u) {
+		t.Errorf("expected %v, got: %v", v, u)
+	}
+}
+
+func TestDeckTypePermutation(t *testing.T) {
+	for _, typ := range []DeckType{DeckFrench, DeckShort, DeckKuhn, DeckLeduc} {
+		n := typ.Len()
+		last := new(big.Int).Sub(new(big.Int).MulRange(1, int64(n)), big.NewInt(1))
+		rev := typ.Unshuffled()
+		slices.Reverse(rev)
+		tests := []struct {
+			v     []Card
+			index *big.Int
+		}{
+			{typ.Unshuffled(), big.NewInt(0)},
+			{slices.Concat(typ.Unshuffled()[:n-2], []Card{typ.Card(n - 1), typ.Card(n - 2)}), big.NewInt(1)},
+			{rev, last},
+		}
+		for i, test := range tests {
+			index, err := typ.PermutationIndex(test.v)
+			switch {
+			case err != nil:
+				t.Fatalf("%s test %d expected no error, got: %v", typ, i, err)
+			case index.Cmp(test.index) != 0:
+				t.Errorf("%s test %d expected %s, got: %s", typ, i, test.index, index)
+			}
+			d, err := typ.Permutation(test.index)
+			switch {
+			case err != nil:
+				t.Fatalf("%s test %d expected no error, got: %v", typ, i, err)
+			case !slices.Equal(d.All(), test.v):
+				t.Errorf("%s test %d expected %v, got: %v", typ, i, test.v, d.All())
+			}
+		}
+		for _, index := range []*big.Int{big.NewInt(-1), new(big.Int).Add(last, big.NewInt(1))} {
+			if _, err := typ.Permutation(index); err != ErrInvalidData {
+				t.Errorf("%s expected %v, got: %v", typ, ErrInvalidData, err)
+			}
+		}
+		v := typ.Unshuffled()
+		for _, u := range [][]Card{v[1:], append(v[1:], v[1]), append(v[1:], InvalidCard)} {
+			if _, err := typ.PermutationIndex(u); err != ErrInvalidCard {
+				t.Errorf("%s expected %v, got: %v", typ, ErrInvalidCard, err)
+			}
+		}
+	}
+}
+
 func TestDealer(t *testing.T) {
 	// seed := time.Now().UnixNano()
 	// seed := int64(1676122011905868217)
@@ -193,6 +315,7 @@ func TestDealerRuns(t *testing.T) {
 		{Omaha, 4, 100},
 		{OmahaDouble, 4, 182},
 		{OmahaHiLo, 4, 72},
+		{OmahaFiveHiLo, 4, 91},
 		{FusionHiLo, 5, 256},
 		{Manila, 3, 768},
 		{Jakarta, 2, 101},
@@ -285,7 +408,7 @@ func testDealer(t *testing.T, typ Type, count int, seed int64, f dealFunc) {
 		}
 		t.Log("    Evals:")
 		for i := range count {
-			if d.Active[i] {
+			if d.Active.Has(i) {
 				hi := res.Evals[i].Desc(false)
 				t.Logf("      %d: %v %v %s", i, hi.Best, hi.Unused, hi)
 				if d.Low || d.Double {
@@ -478,3 +601,532 @@ func sidebyside(t *testing.T, pad, gap string, v ...string) {
 		t.Log(s)
 	}
 }
+
+func TestDeckDrawInto(t *testing.T) {
+	d := DeckOf(Must("Ah Kh Qh Jh Th")...)
+	v := make([]Card, 0, 5)
+	v = d.DrawInto(v, 2)
+	v = d.DrawInto(v, 0)
+	v = d.DrawInto(v, 10)
+	if s, exp := fmt.Sprintf("%s", v), "[Ah Kh Qh Jh Th]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+	if u := d.Draw(1); u != nil {
+		t.Errorf("expected nil, got: %v", u)
+	}
+}
+
+func TestDealerBoardOrder(t *testing.T) {
+	// unshuffled deck with 2 pockets of 2 cards: 0-3 are pockets
+	tests := []struct {
+		order  BoardOrder
+		shared bool
+		hi     []int
+		lo     []int
+		disc   []int
+	}{
+		{BoardInterleaved, false, []int{5, 6, 7, 13, 17}, []int{9, 10, 11, 15, 19}, []int{4, 8, 12, 14, 16, 18}},
+		{BoardInterleaved, true, []int{5, 6, 7, 12, 15}, []int{8, 9, 10, 13, 16}, []int{4, 11, 14}},
+		{BoardSequential, false, []int{5, 6, 7, 9, 11}, []int{13, 14, 15, 17, 19}, []int{4, 12, 8, 16, 10, 18}},
+		{BoardSequential, true, []int{5, 6, 7, 9, 11}, []int{12, 13, 14, 15, 16}, []int{4, 8, 10}},
+	}
+	for i, test := range tests {
+		desc, err := NewType("Hd", Double, "Double", WithDouble(), WithBoardOrder(test.order, test.shared))
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		v := DeckFrench.Unshuffled()
+		cards := func(indexes []int) []Card {
+			var c []Card
+			for _, j := range indexes {
+				c = append(c, v[j])
+			}
+			return c
+		}
+		d := NewDealer(*desc, DeckFrench.New(), 2)
+		for d.Next() {
+		}
+		run := d.Runs[0]
+		if exp := cards(test.hi); !slices.Equal(run.Hi, exp) {
+			t.Errorf("test %d expected hi %v, got: %v", i, exp, run.Hi)
+		}
+		if exp := cards(test.lo); !slices.Equal(run.Lo, exp) {
+			t.Errorf("test %d expected lo %v, got: %v", i, exp, run.Lo)
+		}
+		if exp := cards(test.disc); !slices.Equal(run.Discard, exp) {
+			t.Errorf("test %d expected discard %v, got: %v", i, exp, run.Discard)
+		}
+		// run it twice from the flop
+		d = NewDealer(*desc, DeckFrench.New(), 2)
+		for d.Next() {
+			if d.Id() == 'f' && !d.ChangeRuns(2) {
+				t.Fatalf("test %d expected change runs", i)
+			}
+		}
+		m := make(map[Card]bool)
+		for j, run := range d.Runs {
+			if len(run.Hi) != 5 || len(run.Lo) != 5 {
+				t.Errorf("test %d run %d expected 5 board cards, got: %v %v", i, j, run.Hi, run.Lo)
+			}
+			for _, c := range slices.Concat(run.Hi[3:], run.Lo[3:]) {
+				if m[c] {
+					t.Errorf("test %d run %d expected unique card %s", i, j, c)
+				}
+				m[c] = true
+			}
+		}
+	}
+}
+
+func TestDealerBoardOrderDiscards(t *testing.T) {
+	// unshuffled deck with 2 pockets of 2 cards: 0-3 are pockets, and the turn
+	// discards 2 cards
+	tests := []struct {
+		shared bool
+		hi     []int
+		lo     []int
+		disc   []int
+	}{
+		{false, []int{5, 6, 7, 10, 12}, []int{14, 15, 16, 19, 21}, []int{4, 13, 8, 9, 17, 18, 11, 20}},
+		{true, []int{5, 6, 7, 10, 12}, []int{13, 14, 15, 16, 17}, []int{4, 8, 9, 11}},
+	}
+	for i, test := range tests {
+		desc, err := NewType("Hd", Double, "Double", WithDouble(), WithBoardOrder(BoardSequential, test.shared))
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		desc.Streets = slices.Clone(desc.Streets)
+		desc.Streets[2].BoardDiscard = 2
+		v := DeckFrench.Unshuffled()
+		cards := func(indexes []int) []Card {
+			var c []Card
+			for _, j := range indexes {
+				c = append(c, v[j])
+			}
+			return c
+		}
+		d := NewDealer(*desc, DeckFrench.New(), 2)
+		for d.Next() {
+		}
+		run := d.Runs[0]
+		if exp := cards(test.hi); !slices.Equal(run.Hi, exp) {
+			t.Errorf("test %d expected hi %v, got: %v", i, exp, run.Hi)
+		}
+		if exp := cards(test.lo); !slices.Equal(run.Lo, exp) {
+			t.Errorf("test %d expected lo %v, got: %v", i, exp, run.Lo)
+		}
+		if exp := cards(test.disc); !slices.Equal(run.Discard, exp) {
+			t.Errorf("test %d expected discard %v, got: %v", i, exp, run.Discard)
+		}
+	}
+}
+
+func TestDealerAllocs(t *testing.T) {
+	for _, typ := range []Type{Holdem, Omaha, OmahaDouble, Stud, Badugi} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(rand.New(rand.NewSource(1)), 1, 6)
+			n := testing.AllocsPerRun(10, func() {
+				d.Deck.Reset()
+				run := d.newRun()
+				for i := range len(d.Streets) {
+					d.Deal(i, run)
+				}
+			})
+			// newRun allocates the run, pockets, backing array, and discards
+			if n > 4 {
+				t.Errorf("expected at most 4 allocs, got: %v", n)
+			}
+		})
+	}
+}
+
+func TestOrderResults(t *testing.T) {
+	for _, typ := range []Type{Holdem, OmahaHiLo, OmahaDouble} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(rand.New(rand.NewSource(1)), 1, 6)
+			d.ChangeRuns(3)
+			for d.Next() {
+			}
+			low := typ.Low() || typ.Double()
+			results := make([]*Result, len(d.Runs))
+			for i, run := range d.Runs {
+				results[i] = &Result{Evals: run.Eval(typ, AllPositions, false)}
+			}
+			buf := OrderResults(nil, results, low)
+			for i, res := range results {
+				exp := NewResult(typ, d.Runs[i], AllPositions, false)
+				switch {
+				case !slices.Equal(res.HiOrder, exp.HiOrder), res.HiPivot != exp.HiPivot:
+					t.Errorf("run %d expected hi %v/%d, got: %v/%d", i, exp.HiOrder, exp.HiPivot, res.HiOrder, res.HiPivot)
+				case !slices.Equal(res.LoOrder, exp.LoOrder), res.LoPivot != exp.LoPivot:
+					t.Errorf("run %d expected lo %v/%d, got: %v/%d", i, exp.LoOrder, exp.LoPivot, res.LoOrder, res.LoPivot)
+				}
+			}
+			if n := testing.AllocsPerRun(10, func() {
+				buf = OrderResults(buf, results, low)
+			}); n != 0 {
+				t.Errorf("expected 0 allocs, got: %v", n)
+			}
+		})
+	}
+}
+
+func TestResultShares(t *testing.T) {
+	tests := []struct {
+		hi      []int
+		hiPivot int
+		lo      []int
+		loPivot int
+		exp     []Share
+	}{
+		{[]int{0, 1, 2}, 1, nil, 0, []Share{ShareScoop, ShareNone, ShareNone}},
+		{[]int{0, 1, 2}, 2, nil, 0, []Share{ShareHalf, ShareHalf, ShareNone}},
+		{[]int{0, 1, 2}, 1, []int{0, 1, 2}, 1, []Share{ShareScoop, ShareNone, ShareNone}},
+		{[]int{0, 1, 2}, 1, []int{2, 1, 0}, 1, []Share{ShareHalf, ShareNone, ShareHalf}},
+		{[]int{0, 1, 2}, 2, []int{2, 1, 0}, 1, []Share{ShareQuarter, ShareQuarter, ShareHalf}},
+		{[]int{0, 1, 2}, 1, []int{1, 2, 0}, 2, []Share{ShareHalf, ShareQuarter, ShareQuarter}},
+		{[]int{0, 1, 2}, 1, []int{0, 1, 2}, 2, []Share{SharePartial, ShareQuarter, ShareNone}},
+		{[]int{0, 1, 2}, 3, nil, 0, []Share{SharePartial, SharePartial, SharePartial}},
+	}
+	for i, test := range tests {
+		res := &Result{
+			Evals:   make([]*Eval, 3),
+			HiOrder: test.hi,
+			HiPivot: test.hiPivot,
+			LoOrder: test.lo,
+			LoPivot: test.loPivot,
+		}
+		for pos, exp := range test.exp {
+			if share := res.Share(pos); share != exp {
+				t.Errorf("test %d position %d expected %s, got: %s", i, pos, exp, share)
+			}
+		}
+		var exp []int
+		for pos, share := range test.exp {
+			if share == ShareQuarter {
+				exp = append(exp, pos)
+			}
+		}
+		if v := res.Positions(ShareQuarter); !slices.Equal(v, exp) {
+			t.Errorf("test %d expected quartered %v, got: %v", i, exp, v)
+		}
+	}
+}
+
+func TestRunEvalZeroPositions(t *testing.T) {
+	run := &Run{
+		Pockets: [][]Card{Must("As Ks"), Must("2c 3c"), Must("Ad Kd")},
+		Hi:      Must("Qh Jh Tc 4s 7s"),
+	}
+	for i, ev := range run.Eval(Holdem, 0, false) {
+		if ev == nil {
+			t.Errorf("position %d expected eval", i)
+		}
+	}
+	exp, res := NewResult(Holdem, run, AllPositions, false), NewResult(Holdem, run, 0, false)
+	if !reflect.DeepEqual(exp.HiOrder, res.HiOrder) || exp.HiPivot != res.HiPivot {
+		t.Errorf("expected %v %d, got: %v %d", exp.HiOrder, exp.HiPivot, res.HiOrder, res.HiPivot)
+	}
+	if evs := run.Eval(Holdem, PositionsOf(1), false); evs[0] != nil || evs[1] == nil || evs[2] != nil {
+		t.Errorf("expected only position 1 evaluated")
+	}
+}
+
+func TestWinSummary(t *testing.T) {
+	defer SetTranslator(nil)
+	run := &Run{
+		Pockets: [][]Card{Must("As Ks"), Must("2c 3c"), Must("Ad Kd")},
+		Hi:      Must("Qh Jh Tc 4s 7s"),
+	}
+	res := NewResult(Holdem, run, AllPositions, false)
+	hi, lo := res.Win("alice", "bob", "carol")
+	if lo != nil {
+		t.Fatalf("expected nil lo, got: %v", lo)
+	}
+	sum := hi.Summary()
+	if sum.Low || sum.Verb != "split" || sum.VerbName != "split" || len(sum.Winners) != 2 {
+		t.Fatalf("expected split with 2 winners, got: %+v", sum)
+	}
+	for i, exp := range []struct {
+		pos  int
+		name string
+	}{
+		{0, "alice"},
+		{2, "carol"},
+	} {
+		w := sum.Winners[i]
+		switch {
+		case w.Position != exp.pos, w.Name != exp.name, w.Share != 0.5:
+			t.Errorf("winner %d expected %d %s 0.5, got: %d %s %v", i, exp.pos, exp.name, w.Position, w.Name, w.Share)
+		case w.Rank.Fixed() != Straight, w.Category != "Straight", w.Desc != "Straight, Ace-high":
+			t.Errorf("winner %d expected Straight, got: %d %q %q", i, w.Rank, w.Category, w.Desc)
+		case len(w.Best) != 5 || w.Best[0].Rank() != Ace, len(w.Unused) != 2:
+			t.Errorf("winner %d expected best and unused, got: %v %v", i, w.Best, w.Unused)
+		}
+	}
+	SetTranslator(GermanNames)
+	if sum := hi.Summary(); sum.Verb != "split" || sum.VerbName != "teilen" || sum.Winners[0].Desc != "Straight, Ass-high" {
+		t.Errorf("expected translated summary, got: %+v", sum)
+	}
+}
+
+func TestDealerDraw(t *testing.T) {
+	for _, typ := range []Type{Lowball, LowballTriple} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(rand.New(rand.NewSource(1)), 1, 3)
+			if !d.Next() {
+				t.Fatalf("expected next")
+			}
+			_, run := d.Run()
+			if _, err := d.Draw(0, run.Pockets[0][:1]); !errors.Is(err, ErrInvalidStreet) {
+				t.Errorf("expected %v, got: %v", ErrInvalidStreet, err)
+			}
+			if !d.Next() {
+				t.Fatalf("expected next")
+			}
+			discards := slices.Clone(run.Pockets[0][1:3])
+			v, err := d.Draw(0, discards)
+			switch {
+			case err != nil:
+				t.Fatalf("expected no error, got: %v", err)
+			case len(v) != 2, len(run.Pockets[0]) != 5:
+				t.Fatalf("expected 2 drawn cards and 5 pocket cards, got: %v %v", v, run.Pockets[0])
+			case !slices.Equal(run.Pockets[0][3:], v):
+				t.Errorf("expected drawn cards %v at end of pocket, got: %v", v, run.Pockets[0])
+			case !slices.Equal(run.Discard, discards):
+				t.Errorf("expected discards %v, got: %v", discards, run.Discard)
+			}
+			for _, c := range discards {
+				if slices.Contains(run.Pockets[0], c) {
+					t.Errorf("expected %s to not be in pocket", c)
+				}
+			}
+			tests := []struct {
+				pos      int
+				discards []Card
+				err      error
+			}{
+				{0, nil, ErrInvalidAction},
+				{3, nil, ErrInvalidPocket},
+				{-1, nil, ErrInvalidPocket},
+				{1, discards, ErrInvalidCard},
+				{1, []Card{run.Pockets[1][0], run.Pockets[1][0]}, ErrDuplicateCard},
+				{1, append(slices.Clone(run.Pockets[1]), run.Pockets[0][0]), ErrInvalidCount},
+			}
+			for i, test := range tests {
+				if _, err := d.Draw(test.pos, test.discards); !errors.Is(err, test.err) {
+					t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+				}
+			}
+			// stand pat
+			if v, err := d.Draw(1, nil); err != nil || len(v) != 0 {
+				t.Errorf("expected no cards and no error, got: %v %v", v, err)
+			}
+			if !d.Next() {
+				t.Fatalf("expected next")
+			}
+			for _, pos := range []int{0, 1, 2} {
+				_, err := d.Draw(pos, run.Pockets[pos][:5])
+				switch {
+				case typ.Once() && pos != 2 && !errors.Is(err, ErrInvalidAction):
+					t.Errorf("position %d expected %v, got: %v", pos, ErrInvalidAction, err)
+				case (!typ.Once() || pos == 2) && err != nil:
+					t.Errorf("position %d expected no error, got: %v", pos, err)
+				}
+			}
+			for d.Next() {
+			}
+			m := make(map[Card]bool)
+			for _, c := range slices.Concat(append(run.Pockets, run.Discard)...) {
+				if m[c] {
+					t.Errorf("expected unique card %s", c)
+				}
+				m[c] = true
+			}
+		})
+	}
+}
+
+func TestDealerRabbitHunt(t *testing.T) {
+	for _, typ := range []Type{Holdem, Double, OmahaDouble, Stud, Lowball} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			for n := 1; n <= len(typ.Streets()); n++ {
+				// deal the full hand
+				exp := typ.Dealer(rand.New(rand.NewSource(7)), 1, 3)
+				for exp.Next() {
+				}
+				_, full := exp.Run()
+				// fold after n streets
+				d := typ.Dealer(rand.New(rand.NewSource(7)), 1, 3)
+				for range n {
+					d.Next()
+				}
+				d.Deactivate(1, 2)
+				more := d.Next()
+				_, run := d.Run()
+				// each remaining street's Hi cards, followed by its Lo cards
+				var v []Card
+				var board int
+				for _, street := range d.Streets {
+					if j := board + street.Board; len(run.Hi) < j {
+						v = append(v, full.Hi[max(board, len(run.Hi)):j]...)
+						if typ.Double() {
+							v = append(v, full.Lo[max(board, len(run.Lo)):j]...)
+						}
+					}
+					board += street.Board
+				}
+				buf, _ := d.MarshalBinary()
+				rabbit := d.RabbitHunt(100)
+				switch {
+				case more:
+					t.Fatalf("n %d expected no more streets", n)
+				case !slices.Equal(rabbit, v):
+					t.Errorf("n %d expected %v, got: %v", n, v, rabbit)
+				}
+				if exp, _ := d.MarshalBinary(); !bytes.Equal(buf, exp) {
+					t.Errorf("n %d expected dealer state to be unchanged", n)
+				}
+				if len(v) != 0 {
+					if rabbit := d.RabbitHunt(1); !slices.Equal(rabbit, v[:1]) {
+						t.Errorf("n %d expected %v, got: %v", n, v[:1], rabbit)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunDiscards(t *testing.T) {
+	for _, typ := range Types() {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(rand.New(rand.NewSource(3)), 1, min(typ.Max(), 3))
+			drew := make(map[int]int)
+			for d.Next() {
+				if d.PocketDraw() != 0 && (!typ.Once() || len(drew) == 0) {
+					_, run := d.Run()
+					if _, err := d.Draw(0, run.Pockets[0][:1]); err != nil {
+						t.Fatalf("expected no error, got: %v", err)
+					}
+					drew[d.Street()] = 1
+				}
+			}
+			_, run := d.Run()
+			if len(run.Discards) != len(run.Discard) {
+				t.Fatalf("expected %d discards, got: %d", len(run.Discard), len(run.Discards))
+			}
+			counts := make(map[Discard]int)
+			for i, discard := range run.Discards {
+				if discard.Card != run.Discard[i] {
+					t.Errorf("discard %d expected %s, got: %s", i, run.Discard[i], discard.Card)
+				}
+				counts[Discard{Street: discard.Street, Reason: discard.Reason, Position: discard.Position}]++
+			}
+			for i, street := range d.Streets {
+				exp := map[DiscardReason]int{
+					DiscardBurnPocket: street.PocketDiscard,
+					DiscardBurnHi:     street.BoardDiscard,
+				}
+				switch {
+				case street.Pocket == 0:
+					exp[DiscardBurnPocket] = 0
+				case street.Board == 0:
+					exp[DiscardBurnHi] = 0
+				}
+				if typ.Double() && !d.SharedBurn && street.Board != 0 {
+					exp[DiscardBurnLo] = street.BoardDiscard
+				}
+				for reason, n := range exp {
+					if c := counts[Discard{Street: i, Reason: reason, Position: -1}]; c != n {
+						t.Errorf("street %d expected %d %s discards, got: %d", i, n, reason, c)
+					}
+				}
+				if n, c := drew[i], counts[Discard{Street: i, Reason: DiscardDraw}]; c != n {
+					t.Errorf("street %d expected %d draw discards, got: %d", i, n, c)
+				}
+			}
+		})
+	}
+}
+
+func TestDealerExpose(t *testing.T) {
+	exp := Holdem.Dealer(rand.New(rand.NewSource(9)), 1, 3)
+	for exp.Next() {
+	}
+	d := Holdem.Dealer(rand.New(rand.NewSource(9)), 1, 3)
+	d.Next()
+	_, run := d.Run()
+	card, next := run.Pockets[1][0], d.Deck.v[d.Deck.i]
+	c, err := d.Expose(1, card)
+	switch {
+	case err != nil:
+		t.Fatalf("expected no error, got: %v", err)
+	case c != next || run.Pockets[1][0] != next:
+		t.Errorf("expected replacement %s, got: %s %v", next, c, run.Pockets[1])
+	case !slices.Equal(run.Discard, []Card{card}):
+		t.Errorf("expected discard %v, got: %v", []Card{card}, run.Discard)
+	case run.Discards[0] != Discard{Card: card, Street: 0, Reason: DiscardExposed, Position: 1}:
+		t.Errorf("expected exposed discard, got: %v", run.Discards[0])
+	}
+	for d.Next() {
+	}
+	// the exposed card is the flop's burn, so the board is unchanged
+	_, full := exp.Run()
+	switch {
+	case !slices.Equal(run.Hi, full.Hi):
+		t.Errorf("expected board %v, got: %v", full.Hi, run.Hi)
+	case len(run.Discard) != len(full.Discard):
+		t.Errorf("expected %d discards, got: %d", len(full.Discard), len(run.Discard))
+	case !slices.Equal(run.Discard[1:], full.Discard[1:]):
+		t.Errorf("expected discards %v, got: %v", full.Discard[1:], run.Discard[1:])
+	}
+	// invalid
+	d = Stud.Dealer(rand.New(rand.NewSource(9)), 1, 3)
+	d.Next()
+	_, run = d.Run()
+	tests := []struct {
+		pos  int
+		card Card
+		err  error
+	}{
+		{3, run.Pockets[0][0], ErrInvalidPocket},
+		{-1, run.Pockets[0][0], ErrInvalidPocket},
+		{0, run.Pockets[1][0], ErrInvalidCard},
+		{0, run.Up[0][0], ErrInvalidCard},
+	}
+	for i, test := range tests {
+		if _, err := d.Expose(test.pos, test.card); !errors.Is(err, test.err) {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+	d = Holdem.Dealer(rand.New(rand.NewSource(9)), 1, 3)
+	d.Next()
+	d.Next()
+	_, run = d.Run()
+	if _, err := d.Expose(0, run.Pockets[0][0]); !errors.Is(err, ErrInvalidStreet) {
+		t.Errorf("expected %v, got: %v", ErrInvalidStreet, err)
+	}
+	// cards dealt on earlier streets cannot be exposed
+	d = Stud.Dealer(rand.New(rand.NewSource(9)), 1, 3)
+	for range len(d.Streets) {
+		d.Next()
+	}
+	_, run = d.Run()
+	if _, err := d.Expose(0, run.Pockets[0][0]); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("expected %v, got: %v", ErrInvalidCard, err)
+	}
+	if _, err := d.Expose(0, run.Pockets[0][6]); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	// sequential boards do not use exposed cards as burns
+	desc, err := NewType("Hd", Double, "Double", WithDouble(), WithBoardOrder(BoardSequential, false))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	d = NewDealer(*desc, DeckFrench.Shuffle(rand.New(rand.NewSource(9)), 1), 2)
+	d.Next()
+	_, run = d.Run()
+	if _, err := d.Expose(0, run.Pockets[0][0]); err != nil || d.exposed != 0 {
+		t.Errorf("expected no error and no exposed burns, got: %v %d", err, d.exposed)
+	}
+}
@@ -0,0 +1,450 @@
+package cardrank
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// Equity calculation limits.
+const (
+	// equityExact is the max number of deals enumerated by [RandomEquity].
+	equityExact = 1 << 21
+	// equitySamples is the number of deals sampled by [RandomEquity] when
+	// there are too many deals to enumerate.
+	equitySamples = 100000
+)
+
+// RandomEquity returns the hero's Hi equity against the number of opponents
+// holding random pockets, for the hero's pocket and the known board. Pots are
+// split equally between tied players.
+//
+// The equity is exact when the number of possible deals (board runouts and
+// opponent pockets) is small (ie, any heads-up [Holdem] flop), and is
+// otherwise approximated by sampling a fixed number of deals with a fixed
+// seed, making results reproducible. See [SampleRandomEquity] to control
+// sampling.
+//
+// Returns [ErrInvalidType] for unregistered types, [ErrInvalidPocket] or
+// [ErrInvalidBoard] when the pocket or board is not valid for the type, or
+// [ErrDuplicateCard] when a card is used more than once.
+func RandomEquity(typ Type, pocket, board []Card, opponents int) (float64, error) {
+	e, err := newRandomEquity(typ, pocket, board, opponents)
+	if err != nil {
+		return 0, err
+	}
+	if e.deals() <= equityExact {
+		return e.exact(), nil
+	}
+	return e.sample(equitySamples, NewRNG(1)), nil
+}
+
+// SampleRandomEquity returns the hero's Hi equity against the number of
+// opponents holding random pockets, approximated by sampling deals using the
+// random source. See [RandomEquity].
+func SampleRandomEquity(typ Type, pocket, board []Card, opponents, samples int, r Rand) (float64, error) {
+	e, err := newRandomEquity(typ, pocket, board, opponents)
+	if err != nil {
+		return 0, err
+	}
+	return e.sample(samples, r), nil
+}
+
+// randomEquity calculates equity against random opponents.
+type randomEquity struct {
+	typ       Type
+	pocket    []Card
+	board     []Card
+	opponents int
+	u         []Card
+	evs       []*Eval
+}
+
+// newRandomEquity creates a random equity calculator.
+func newRandomEquity(typ Type, pocket, board []Card, opponents int) (*randomEquity, error) {
+	if _, ok := descs[typ]; !ok {
+		return nil, ErrInvalidType
+	}
+	deck := typ.DeckType()
+	switch {
+	case len(pocket) != typ.Pocket() || !inDeck(deck, pocket):
+		return nil, ErrInvalidPocket
+	case typ.Board() < len(board) || !inDeck(deck, board):
+		return nil, ErrInvalidBoard
+	case opponents < 1 || typ.Max() <= opponents:
+		return nil, fmt.Errorf("invalid opponents %d", opponents)
+	}
+	m := make(map[Card]bool)
+	for _, c := range slices.Concat(pocket, board) {
+		if m[c] {
+			return nil, ErrDuplicateCard
+		}
+		m[c] = true
+	}
+	e := &randomEquity{
+		typ:       typ,
+		pocket:    pocket,
+		board:     board,
+		opponents: opponents,
+		u:         deck.Exclude(pocket, board),
+		evs:       make([]*Eval, opponents+1),
+	}
+	for i := range e.evs {
+		e.evs[i] = EvalOf(typ)
+	}
+	return e, nil
+}
+
+// deals returns the number of possible deals.
+func (e *randomEquity) deals() float64 {
+	n, k, p := len(e.u), e.typ.Board()-len(e.board), e.typ.Pocket()
+	v := binom(n, k)
+	for i := range e.opponents {
+		v *= binom(n-k-i*p, p)
+	}
+	return v
+}
+
+// exact returns the equity, enumerating every deal.
+func (e *randomEquity) exact() float64 {
+	var total, n float64
+	board := slices.Clone(e.board)
+	pockets := make([][]Card, e.opponents)
+	var deal func([]Card, int)
+	deal = func(u []Card, i int) {
+		if i == e.opponents {
+			total, n = total+e.share(pockets, board), n+1
+			return
+		}
+		for g, v := NewCombinGen(u, e.typ.Pocket()); g.Next(); {
+			pockets[i] = v
+			deal(Exclude(u, v), i+1)
+		}
+	}
+	for g, v := NewCombinGen(e.u, e.typ.Board()-len(e.board)); g.Next(); {
+		board = append(board[:len(e.board)], v...)
+		deal(Exclude(e.u, v), 0)
+	}
+	if n == 0 {
+		return 0
+	}
+	return total / n
+}
+
+// sample returns the equity, sampling deals using the random source.
+func (e *randomEquity) sample(samples int, r Rand) float64 {
+	k, p := e.typ.Board()-len(e.board), e.typ.Pocket()
+	u := slices.Clone(e.u)
+	board := slices.Clone(e.board)
+	pockets := make([][]Card, e.opponents)
+	var total, n float64
+	for range samples {
+		// partial fisher-yates
+		for i := range k + e.opponents*p {
+			j := i + int(r.Float64()*float64(len(u)-i))
+			u[i], u[j] = u[j], u[i]
+		}
+		board = append(board[:len(e.board)], u[:k]...)
+		for i := range pockets {
+			pockets[i] = u[k+i*p : k+(i+1)*p]
+		}
+		total, n = total+e.share(pockets, board), n+1
+	}
+	if n == 0 {
+		return 0
+	}
+	return total / n
+}
+
+// share returns the hero's share of the pot for the deal.
+func (e *randomEquity) share(pockets [][]Card, board []Card) float64 {
+	e.evs[0].Reset(e.typ)
+	e.evs[0].Eval(e.pocket, board)
+	ties := 1
+	for i, p := range pockets {
+		ev := e.evs[i+1]
+		ev.Reset(e.typ)
+		ev.Eval(p, board)
+		switch c := e.evs[0].Comp(ev, false); {
+		case 0 < c:
+			return 0
+		case c == 0:
+			ties++
+		}
+	}
+	return 1 / float64(ties)
+}
+
+// EquityGrid returns the hero's Hi equity for each starting pocket class
+// against a villain holding a hand from the weighted range, on the known
+// board, for use as a heatmap (see the render package). Classes not available
+// in the type's deck, or fully blocked by the board, have 0 equity.
+//
+// Equities are exact when the board is complete, and are otherwise
+// approximated by sampling the number of deals for each class using the
+// random source.
+//
+// Returns [ErrInvalidType] when the type does not have 2 card pockets,
+// [ErrInvalidBoard] when the board is not valid for the type, or
+// [ErrEmptyRange] when the range has no combos not blocked by the board.
+func EquityGrid(typ Type, villain Range, board []Card, samples int, r Rand) (Grid, error) {
+	var g Grid
+	deck := typ.DeckType()
+	switch {
+	case typ.Pocket() != 2:
+		return g, ErrInvalidType
+	case typ.Board() < len(board) || !inDeck(deck, board):
+		return g, ErrInvalidBoard
+	}
+	dead := deadMap(board)
+	s := newRangeSampler(villain, dead)
+	if s == nil {
+		return g, ErrEmptyRange
+	}
+	k := typ.Board() - len(board)
+	hero, opp := EvalOf(typ), EvalOf(typ)
+	v, live := slices.Clone(board), deck.Exclude(board)
+	var u []Card
+	// share returns the hero's share of the pot.
+	share := func(h, o Combo, v []Card) float64 {
+		hero.Reset(typ)
+		hero.Eval(h[:], v)
+		opp.Reset(typ)
+		opp.Eval(o[:], v)
+		switch c := hero.Comp(opp, false); {
+		case c < 0:
+			return 1
+		case c == 0:
+			return 0.5
+		}
+		return 0
+	}
+	for i := range 13 {
+		for j := range 13 {
+			var combos []Combo
+			for _, c := range mustKeyCombos(GridKey(i, j)) {
+				if deck.Index(c[0]) != -1 && deck.Index(c[1]) != -1 && !c.Blocked(dead) {
+					combos = append(combos, c)
+				}
+			}
+			if len(combos) == 0 {
+				continue
+			}
+			var total, n float64
+			switch {
+			case k == 0:
+				// enumerate every matchup
+				for _, h := range combos {
+					for _, o := range s.v {
+						if w := villain[o]; !h.conflicts(o) {
+							total, n = total+w*share(h, o, board), n+w
+						}
+					}
+				}
+			default:
+				for range samples {
+					h := combos[int(r.Float64()*float64(len(combos)))]
+					o, ok := s.sample(r.Float64()), false
+					for l := 0; l < 100 && !ok; l++ {
+						if ok = !h.conflicts(o); !ok {
+							o = s.sample(r.Float64())
+						}
+					}
+					if !ok {
+						continue
+					}
+					u = append(u[:0], live...)
+					u = slices.DeleteFunc(u, func(c Card) bool {
+						return c == h[0] || c == h[1] || c == o[0] || c == o[1]
+					})
+					for l := range k {
+						m := l + int(r.Float64()*float64(len(u)-l))
+						u[l], u[m] = u[m], u[l]
+					}
+					v = append(v[:len(board)], u[:k]...)
+					total, n = total+share(h, o, v), n+1
+				}
+			}
+			if n != 0 {
+				g[i][j] = total / n
+			}
+		}
+	}
+	return g, nil
+}
+
+// SidePot is a main or side pot.
+type SidePot struct {
+	// Amount is the pot amount.
+	Amount float64
+	// Eligible are the positions eligible to win the pot.
+	Eligible []int
+}
+
+// SidePots returns the main pot and side pots for each position's
+// contribution, with live positions eligible to win each pot they
+// contributed to in full. Folded contributions are added to the pots without
+// making the position eligible, and any folded contributions greater than
+// the largest live contribution are added to the last pot. The main pot is
+// first.
+func SidePots(contributions []float64, live Positions) []SidePot {
+	amounts, eligible := sidePots(contributions, live)
+	pots := make([]SidePot, len(amounts))
+	for j, amount := range amounts {
+		pots[j] = SidePot{
+			Amount:   amount,
+			Eligible: eligible[j],
+		}
+	}
+	return pots
+}
+
+// sidePots returns the amount and the eligible positions of the main pot and
+// side pots for the contributions (see [SidePots]).
+func sidePots[T int64 | float64](contributions []T, live Positions) ([]T, [][]int) {
+	var levels []T
+	for i, c := range contributions {
+		if live.Has(i) && 0 < c && !slices.Contains(levels, c) {
+			levels = append(levels, c)
+		}
+	}
+	slices.Sort(levels)
+	amounts, eligible := make([]T, len(levels)), make([][]int, len(levels))
+	var prev T
+	for j, level := range levels {
+		for i, c := range contributions {
+			switch {
+			case j == len(levels)-1:
+				amounts[j] += max(0, c-prev)
+			default:
+				amounts[j] += max(0, min(c, level)-prev)
+			}
+			if live.Has(i) && level <= c {
+				eligible[j] = append(eligible[j], i)
+			}
+		}
+		prev = level
+	}
+	return amounts, eligible
+}
+
+// AllInResult is a multi-way all-in result.
+type AllInResult struct {
+	// Pots are the main and side pots (see [SidePots]).
+	Pots []SidePot
+	// Equity is each position's equity in each pot.
+	Equity [][]float64
+	// EV is each position's expected chips at showdown.
+	EV []float64
+	// Runouts is the number of board runouts.
+	Runouts int
+}
+
+// AllInEV returns each position's exact expected chips for a multi-way
+// all-in, with the pockets, the known board, and each position's total
+// contribution to the pot. Positions with an empty pocket have folded, and
+// their contributions are dead money.
+//
+// Main and side pots are built from the contributions (see [SidePots]), and
+// every runout of the board is enumerated. For each runout and pot, the pot
+// is split equally between the pot's eligible positions with the best Hi,
+// or, for types with a Lo, split in half between the best Hi and the best
+// qualified Lo of the pot's eligible positions, quartering as needed. Chips
+// are not rounded, and odd chips are split evenly.
+//
+// Returns [ErrInvalidType] for unregistered or double board types,
+// [ErrInvalidCount] when the number of contributions does not match the
+// number of pockets or a contribution is negative, or a validation error for
+// the pockets and board (see [Type.ValidatePockets]). Returns the context's
+// error when cancelled.
+func AllInEV(ctx context.Context, typ Type, pockets [][]Card, board []Card, contributions []float64) (*AllInResult, error) {
+	desc, ok := descs[typ]
+	switch {
+	case !ok, desc.Double:
+		return nil, ErrInvalidType
+	case len(contributions) != len(pockets) || slices.IndexFunc(contributions, func(f float64) bool { return f < 0 }) != -1:
+		return nil, fmt.Errorf("contributions: %w", ErrInvalidCount)
+	}
+	if err := desc.validateBoard(board); err != nil {
+		return nil, err
+	}
+	var live Positions
+	var ex [][]Card
+	m := make(map[Card]bool)
+	for i, pocket := range pockets {
+		if len(pocket) == 0 {
+			continue
+		}
+		if err := desc.validatePocket(pocket, len(board), m); err != nil {
+			return nil, fmt.Errorf("pocket %d: %w", i, err)
+		}
+		live, ex = live.With(i), append(ex, pocket)
+	}
+	if err := validateCards(desc.Deck, m, board); err != nil {
+		return nil, err
+	}
+	if live.Len() == 0 {
+		return nil, fmt.Errorf("no live pockets: %w", ErrInvalidCount)
+	}
+	res := &AllInResult{
+		Pots:   SidePots(contributions, live),
+		EV:     make([]float64, len(pockets)),
+		Equity: make([][]float64, 0),
+	}
+	for range res.Pots {
+		res.Equity = append(res.Equity, make([]float64, len(pockets)))
+	}
+	run := NewRun(len(pockets))
+	run.Pockets, run.Hi = pockets, slices.Clone(board)
+	evs, low := make([]*Eval, len(pockets)), desc.Low
+	defer PutEval(evs...)
+	var sub []*Eval
+	var order []int
+	// add adds the shares of each pot for the run's board
+	add := func() {
+		run.evalInto(evs, typ, live, false)
+		for j, pot := range res.Pots {
+			sub = sub[:0]
+			for _, i := range pot.Eligible {
+				sub = append(sub, evs[i])
+			}
+			hi := 1.0
+			if low {
+				var pivot int
+				if order, pivot = OrderInto(order, sub, true); pivot != 0 {
+					hi = 0.5
+					for _, k := range order[:pivot] {
+						res.Equity[j][pot.Eligible[k]] += 0.5 / float64(pivot)
+					}
+				}
+			}
+			var pivot int
+			order, pivot = OrderInto(order, sub, false)
+			for _, k := range order[:pivot] {
+				res.Equity[j][pot.Eligible[k]] += hi / float64(pivot)
+			}
+		}
+		res.Runouts++
+	}
+	switch k := desc.board - len(board); {
+	case k == 0:
+		add()
+	default:
+		for g, v := NewCombinGen(desc.Deck.Exclude(append(ex, board)...), k); g.Next(); {
+			if res.Runouts%4096 == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+			run.Hi = append(run.Hi[:len(board)], v...)
+			add()
+		}
+	}
+	for j, pot := range res.Pots {
+		for i := range res.Equity[j] {
+			res.Equity[j][i] /= float64(res.Runouts)
+			res.EV[i] += res.Equity[j][i] * pot.Amount
+		}
+	}
+	return res, nil
+}
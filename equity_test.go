@@ -0,0 +1,219 @@
+package cardrank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRandomEquity(t *testing.T) {
+	tests := []struct {
+		typ       Type
+		pocket    string
+		board     string
+		opponents int
+		exp       float64
+		delta     float64
+	}{
+		{Holdem, "As Ad", "Ks Kd 7c 2h 3c", 1, 893.5 / 990, 1e-9},
+		{Holdem, "As Ad", "", 1, 0.8520, 0.005},
+		{Holdem, "As Ad", "", 2, 0.7350, 0.005},
+		{Holdem, "As Ad", "", 3, 0.6370, 0.005},
+		{Holdem, "7c 2d", "", 1, 0.3460, 0.005},
+		{Omaha, "As Ad Ks Kd", "", 1, 0.7080, 0.01},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.Name()+"/"+test.pocket, func(t *testing.T) {
+			f, err := RandomEquity(test.typ, Must(test.pocket), Must(test.board), test.opponents)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if math.Abs(f-test.exp) > test.delta {
+				t.Errorf("expected %f, got: %f", test.exp, f)
+			}
+		})
+	}
+}
+
+func TestRandomEquityExact(t *testing.T) {
+	pocket, board := Must("Ah Kh"), Must("Qh 7h 2c")
+	f, err := RandomEquity(Holdem, pocket, board, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expv, ok := NewExpValueCalc(Holdem, pocket, WithBoard(board)).Calc(context.Background())
+	if !ok {
+		t.Fatalf("expected ok")
+	}
+	if exp := expv.Float64(); math.Abs(f-exp) > 1e-9 {
+		t.Errorf("expected %f, got: %f", exp, f)
+	}
+	g, err := SampleRandomEquity(Holdem, pocket, board, 1, 20000, rand.New(rand.NewPCG(2, 0)))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if math.Abs(f-g) > 0.01 {
+		t.Errorf("expected %f, got: %f", f, g)
+	}
+}
+
+func TestRandomEquityErrors(t *testing.T) {
+	tests := []struct {
+		typ       Type
+		pocket    string
+		board     string
+		opponents int
+	}{
+		{Holdem, "As", "", 1},
+		{Holdem, "As Ad", "Ks Kd 7c 2h 3c 4c", 1},
+		{Holdem, "As Ad", "As", 1},
+		{Holdem, "As Ad", "", 0},
+		{Holdem, "As Ad", "", 10},
+		{Short, "As 2d", "", 1},
+	}
+	for i, test := range tests {
+		if _, err := RandomEquity(test.typ, Must(test.pocket), Must(test.board), test.opponents); err == nil {
+			t.Errorf("test %d expected error", i)
+		}
+	}
+}
+
+func TestEquityGrid(t *testing.T) {
+	villain, err := NewRange("KK")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// exact on a complete board
+	g, err := EquityGrid(Holdem, villain, Must("Ks 7c 6h 3d 2s"), 0, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, test := range []struct {
+		key string
+		exp float64
+	}{
+		{"54s", 1},
+		{"54o", 1},
+		{"AA", 0},
+		{"77", 0},
+		{"KK", 0},
+	} {
+		if f := g.Get(test.key); f != test.exp {
+			t.Errorf("%s expected %f, got: %f", test.key, test.exp, f)
+		}
+	}
+	// sampled preflop
+	g, err = EquityGrid(Holdem, villain, nil, 2000, rand.New(rand.NewPCG(1, 0)))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, test := range []struct {
+		key string
+		exp float64
+	}{
+		{"AA", 0.8195},
+		{"72o", 0.1250},
+		{"AKs", 0.3400},
+	} {
+		if f := g.Get(test.key); math.Abs(f-test.exp) > 0.03 {
+			t.Errorf("%s expected %f, got: %f", test.key, test.exp, f)
+		}
+	}
+	if _, err := EquityGrid(Omaha, villain, nil, 1, nil); err != ErrInvalidType {
+		t.Errorf("expected error %v, got: %v", ErrInvalidType, err)
+	}
+	if _, err := EquityGrid(Holdem, villain, Must("Kc Kh Kd"), 1, nil); err != ErrEmptyRange {
+		t.Errorf("expected error %v, got: %v", ErrEmptyRange, err)
+	}
+}
+
+func TestSidePots(t *testing.T) {
+	tests := []struct {
+		contributions []float64
+		live          Positions
+		exp           string
+	}{
+		{[]float64{100, 100}, AllPositions, "[{200 [0 1]}]"},
+		{[]float64{50, 100, 200}, AllPositions, "[{150 [0 1 2]} {100 [1 2]} {100 [2]}]"},
+		{[]float64{50, 100, 100}, AllPositions, "[{150 [0 1 2]} {100 [1 2]}]"},
+		{[]float64{100, 100, 30}, AllPositions.Without(2), "[{230 [0 1]}]"},
+		{[]float64{50, 100, 150}, AllPositions.Without(2), "[{150 [0 1]} {150 [1]}]"},
+		{[]float64{0, 0}, AllPositions, "[]"},
+	}
+	for i, test := range tests {
+		if s := fmt.Sprintf("%v", SidePots(test.contributions, test.live)); s != test.exp {
+			t.Errorf("test %d expected %s, got: %s", i, test.exp, s)
+		}
+	}
+}
+
+func TestAllInEV(t *testing.T) {
+	tests := []struct {
+		typ           Type
+		pockets       []string
+		board         string
+		contributions []float64
+		exp           []float64
+	}{
+		{Holdem, []string{"As Ad", "Ks Kd"}, "Kh 7c 2h 3c 4d", []float64{100, 100}, []float64{0, 200}},
+		{Holdem, []string{"As Kd", "Ac Kh"}, "Qs Jd 2h 3c 4d", []float64{100, 100}, []float64{100, 100}},
+		{Holdem, []string{"As Ad", "Ks Kd", "Qs Qd"}, "Kh Qh 2c 3c 4d", []float64{50, 100, 200}, []float64{0, 250, 100}},
+		{Holdem, []string{"As Ad", "", "Qs Qd"}, "Kh Qh 2c 3c 4d", []float64{100, 40, 100}, []float64{0, 0, 240}},
+		{OmahaHiLo, []string{"As 2s Kd Kc", "Ah 2h Qd Qc", "Js Jh Tc 9c"}, "Kh 3d 4c 8s Jd", []float64{100, 100, 100}, []float64{225, 75, 0}},
+		{Holdem, []string{"As Ad", "Ks Kd"}, "Kh 7c 2h 3c", []float64{100, 100}, []float64{400.0 / 44, 200 - 400.0/44}},
+	}
+	for i, test := range tests {
+		pockets := make([][]Card, len(test.pockets))
+		for j, s := range test.pockets {
+			pockets[j] = Must(s)
+		}
+		res, err := AllInEV(context.Background(), test.typ, pockets, Must(test.board), test.contributions)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		var total, exp float64
+		for j := range test.exp {
+			if math.Abs(res.EV[j]-test.exp[j]) > 1e-9 {
+				t.Errorf("test %d expected %d to have %f, got: %f", i, j, test.exp[j], res.EV[j])
+			}
+			total, exp = total+res.EV[j], exp+test.contributions[j]
+		}
+		if math.Abs(total-exp) > 1e-9 {
+			t.Errorf("test %d expected total %f, got: %f", i, exp, total)
+		}
+	}
+}
+
+func TestAllInEVErrors(t *testing.T) {
+	tests := []struct {
+		typ           Type
+		pockets       []string
+		board         string
+		contributions []float64
+		err           error
+	}{
+		{Double, []string{"As Ad", "Ks Kd"}, "", []float64{100, 100}, ErrInvalidType},
+		{Holdem, []string{"As Ad", "Ks Kd"}, "", []float64{100}, ErrInvalidCount},
+		{Holdem, []string{"As Ad", "Ks Kd"}, "", []float64{100, -1}, ErrInvalidCount},
+		{Holdem, []string{"As Ad", "As Kd"}, "", []float64{100, 100}, ErrDuplicateCard},
+		{Holdem, []string{"As Ad", "Ks"}, "2c 3c 4c 5c 6c", []float64{100, 100}, ErrWrongPocketSize},
+		{Holdem, []string{"As Ad", "Ks Kd"}, "2c 3c 4c 5c 6c 7c", []float64{100, 100}, ErrWrongBoardSize},
+	}
+	for i, test := range tests {
+		pockets := make([][]Card, len(test.pockets))
+		for j, s := range test.pockets {
+			pockets[j] = Must(s)
+		}
+		if _, err := AllInEV(context.Background(), test.typ, pockets, Must(test.board), test.contributions); !errors.Is(err, test.err) {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := AllInEV(ctx, Holdem, [][]Card{Must("As Ad"), Must("Ks Kd")}, nil, []float64{1, 1}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v, got: %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,109 @@
+package cardrank
+
+import (
+	"slices"
+)
+
+// EuchrePartner returns the suit of the same color as the suit (ie, [Club] for
+// [Spade], [Diamond] for [Heart]), or [InvalidSuit] for an invalid suit.
+func EuchrePartner(suit Suit) Suit {
+	switch suit {
+	case Spade:
+		return Club
+	case Heart:
+		return Diamond
+	case Diamond:
+		return Heart
+	case Club:
+		return Spade
+	}
+	return InvalidSuit
+}
+
+// EuchreBowers returns the right bower (the [Jack] of the trump suit) and the
+// left bower (the [Jack] of the same color as the trump suit).
+func EuchreBowers(trump Suit) (Card, Card) {
+	return New(Jack, trump), New(Jack, EuchrePartner(trump))
+}
+
+// EuchreSuit returns the card's effective suit for the trump suit. The left
+// bower is a trump card, and is not a member of its printed suit.
+func EuchreSuit(c Card, trump Suit) Suit {
+	if _, left := EuchreBowers(trump); c == left {
+		return trump
+	}
+	return c.Suit()
+}
+
+// EuchreOrder returns the card's trick-taking order for the trump and led
+// suits, with higher orders beating lower orders. Trump cards are ordered
+// right bower, left bower, [Ace], [King], [Queen], [Ten], [Nine], followed by
+// cards of the led suit ordered [Ace] to [Nine]. Cards that are neither trump
+// nor of the led suit cannot win a trick, and have order 0.
+func EuchreOrder(c Card, trump, led Suit) int {
+	right, left := EuchreBowers(trump)
+	switch suit, r := EuchreSuit(c, trump), c.Rank(); {
+	case c == right:
+		return 13
+	case c == left:
+		return 12
+	case r < Nine:
+		return 0
+	case suit == trump && Jack < r:
+		return 8 + int(r-Jack)
+	case suit == trump:
+		return 7 + int(r-Nine)
+	case suit == led:
+		return 1 + int(r-Nine)
+	}
+	return 0
+}
+
+// EuchreCompare compares the cards for the trump and led suits, returning -1
+// when a beats b, 1 when b beats a, and 0 when neither card beats the other.
+// Can be used with [slices.SortFunc] to order cards from best to worst.
+func EuchreCompare(a, b Card, trump, led Suit) int {
+	switch i, j := EuchreOrder(a, trump, led), EuchreOrder(b, trump, led); {
+	case i > j:
+		return -1
+	case i < j:
+		return 1
+	}
+	return 0
+}
+
+// EuchreWinner returns the position of the card winning the trick for the
+// trump suit, where the led suit is the effective suit (see [EuchreSuit]) of
+// the first card. Returns -1 when the trick is empty.
+func EuchreWinner(trick []Card, trump Suit) int {
+	if len(trick) == 0 {
+		return -1
+	}
+	led, pos := EuchreSuit(trick[0], trump), 0
+	for i := 1; i < len(trick); i++ {
+		if EuchreCompare(trick[i], trick[pos], trump, led) < 0 {
+			pos = i
+		}
+	}
+	return pos
+}
+
+// EuchreSort sorts the cards for the trump suit, grouping cards by effective
+// suit with trump first, followed by the remaining suits in [Spade],
+// [Heart], [Diamond], [Club] order. Cards within each suit are ordered best
+// to worst.
+func EuchreSort(v []Card, trump Suit) {
+	group := func(c Card) int {
+		if suit := EuchreSuit(c, trump); suit != trump {
+			return 1 + suit.Index()
+		}
+		return 0
+	}
+	slices.SortStableFunc(v, func(a, b Card) int {
+		if i, j := group(a), group(b); i != j {
+			return i - j
+		}
+		led := EuchreSuit(a, trump)
+		return EuchreCompare(a, b, trump, led)
+	})
+}
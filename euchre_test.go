@@ -0,0 +1,94 @@
+package cardrank
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEuchreOrder(t *testing.T) {
+	tests := []struct {
+		trump Suit
+		led   Suit
+		exp   string
+	}{
+		{Heart, Heart, "Jh Jd Ah Kh Qh Th 9h"},
+		{Spade, Spade, "Js Jc As Ks Qs Ts 9s"},
+		{Spade, Diamond, "Js Jc As Ks Qs Ts 9s Ad Kd Qd Jd Td 9d"},
+		{Club, Spade, "Jc Js Ac Kc Qc Tc 9c As Ks Qs Ts 9s"},
+	}
+	for i, test := range tests {
+		v := Must(test.exp)
+		for j := 1; j < len(v); j++ {
+			if a, b := EuchreOrder(v[j-1], test.trump, test.led), EuchreOrder(v[j], test.trump, test.led); a <= b {
+				t.Errorf("test %d expected %s (%d) > %s (%d)", i, v[j-1], a, v[j], b)
+			}
+		}
+		if n := EuchreOrder(v[len(v)-1], test.trump, test.led); n == 0 {
+			t.Errorf("test %d expected %s to have order", i, v[len(v)-1])
+		}
+	}
+	for _, c := range Must("Ad Jd 9c 8h") {
+		if n := EuchreOrder(c, Spade, Heart); n != 0 {
+			t.Errorf("expected %s to have order 0, got: %d", c, n)
+		}
+	}
+}
+
+func TestEuchreWinner(t *testing.T) {
+	tests := []struct {
+		trick string
+		trump Suit
+		exp   int
+	}{
+		{"Ah Kh Qh 9h", Spade, 0},
+		{"9h Ah Kh Qh", Spade, 1},
+		{"Ah 9s Kh Qh", Spade, 1},
+		{"Ah 9s Jc Qh", Spade, 2},
+		{"Ah 9s Jc Js", Spade, 3},
+		{"Jc Ac Kc Ts", Spade, 0},
+		{"Jc Ac Kc Ts", Heart, 1},
+		{"9d Ac Kc Ts", Heart, 0},
+		{"9d Ac Jh Ts", Heart, 2},
+		{"", Heart, -1},
+	}
+	for i, test := range tests {
+		if n := EuchreWinner(Must(test.trick), test.trump); n != test.exp {
+			t.Errorf("test %d %s expected %d, got: %d", i, test.trick, test.exp, n)
+		}
+	}
+}
+
+func TestEuchreSort(t *testing.T) {
+	tests := []struct {
+		v     string
+		trump Suit
+		exp   string
+	}{
+		{"9h Jd Ah Js Kc", Heart, "[Jd Ah 9h Js Kc]"},
+		{"9h Jd Ah Js Kc", Diamond, "[Jd Js Ah 9h Kc]"},
+		{"9h Jd Ah Js Kc", Club, "[Js Kc Ah 9h Jd]"},
+		{"Ts Td Tc Th 9s", Spade, "[Ts 9s Th Td Tc]"},
+	}
+	for i, test := range tests {
+		v := Must(test.v)
+		EuchreSort(v, test.trump)
+		if s := fmt.Sprintf("%s", v); s != test.exp {
+			t.Errorf("test %d expected %s, got: %s", i, test.exp, s)
+		}
+	}
+}
+
+func TestDeckEuchre(t *testing.T) {
+	d := DeckEuchre.New()
+	if n, exp := d.Remaining(), 24; n != exp {
+		t.Fatalf("expected %d, got: %d", exp, n)
+	}
+	for _, c := range d.All() {
+		if c.Rank() < Nine {
+			t.Errorf("expected %s to be 9+", c)
+		}
+	}
+	if s, exp := fmt.Sprintf("%s", DeckEuchre), "Euchre (9+)"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
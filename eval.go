@@ -2,7 +2,11 @@ package cardrank
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"sync"
+
+	"github.com/cardrank/cardrank/combin"
 )
 
 // EvalRank is a eval rank.
@@ -12,6 +16,10 @@ type EvalRank uint16
 
 // Eval ranks.
 //
+// The Cactus category constants ([StraightFlush] through [Nothing]) are the
+// worst (highest) rank in each category, with the best rank in a category
+// being one more than the prior category's constant (see [CategoryBounds]).
+//
 // See: https://archive.is/G6GZg
 const (
 	StraightFlush     EvalRank = 10
@@ -30,6 +38,8 @@ const (
 	aceFiveMax        EvalRank = 16384
 	flushUnder        EvalRank = 156
 	flushOver         EvalRank = 1277
+	tripsUnder        EvalRank = 10
+	tripsOver         EvalRank = 858
 	lowballAceFlush   EvalRank = 811
 	lowballAceNothing EvalRank = 6678
 	sokoFlush         EvalRank = TwoPair + 13*715
@@ -75,6 +85,67 @@ func (r EvalRank) Fixed() EvalRank {
 	return Invalid
 }
 
+// Categories returns the Cactus rank categories, ordered best to worst.
+func Categories() []EvalRank {
+	return []EvalRank{
+		StraightFlush,
+		FourOfAKind,
+		FullHouse,
+		Flush,
+		Straight,
+		ThreeOfAKind,
+		TwoPair,
+		Pair,
+		Nothing,
+	}
+}
+
+// CategoryOf returns the Cactus rank category for a raw Cactus rank (ie, a
+// stored [Eval.HiRank]), without needing an eval. Same as [EvalRank.Fixed].
+func CategoryOf(r EvalRank) EvalRank {
+	return r.Fixed()
+}
+
+// CategoryBounds returns the best and worst Cactus ranks (inclusive) for the
+// category, or [Invalid] for both when not a Cactus rank category.
+func CategoryBounds(category EvalRank) (EvalRank, EvalRank) {
+	best := EvalRank(1)
+	for _, r := range Categories() {
+		if r == category {
+			return best, r
+		}
+		best = r + 1
+	}
+	return Invalid, Invalid
+}
+
+// IsStraightFlushRank returns true when the Cactus rank is a [StraightFlush].
+func IsStraightFlushRank(r EvalRank) bool {
+	return r.Fixed() == StraightFlush
+}
+
+// IsFlushRank returns true when the Cactus rank is a [Flush] (excluding
+// straight flushes).
+func IsFlushRank(r EvalRank) bool {
+	return r.Fixed() == Flush
+}
+
+// IsStraightRank returns true when the Cactus rank is a [Straight] (excluding
+// straight flushes).
+func IsStraightRank(r EvalRank) bool {
+	return r.Fixed() == Straight
+}
+
+// IsPairedRank returns true when the Cactus rank has paired cards (ie, a
+// [Pair], [TwoPair], [ThreeOfAKind], [FullHouse], or [FourOfAKind]).
+func IsPairedRank(r EvalRank) bool {
+	switch r.Fixed() {
+	case FourOfAKind, FullHouse, ThreeOfAKind, TwoPair, Pair:
+		return true
+	}
+	return false
+}
+
 // Name returns the eval rank name.
 //
 // Examples:
@@ -174,6 +245,35 @@ func (r EvalRank) FromFlushOver() EvalRank {
 	return r
 }
 
+// ToTripsOver changes a Cactus rank to a Three of a Kind Over a Straight rank.
+//
+//	Straight:     Straight(1609)     - Flush(1599)    == 10
+//	ThreeOfAKind: ThreeOfAKind(2467) - Straight(1609) == 858
+func (r EvalRank) ToTripsOver() EvalRank {
+	switch {
+	case Flush < r && r <= Straight:
+		return r + tripsOver
+	case Straight < r && r <= ThreeOfAKind:
+		return r - tripsUnder
+	}
+	return r
+}
+
+// FromTripsOver changes a rank from a Three of a Kind Over a Straight rank to
+// a Cactus rank.
+//
+//	Straight:     Straight(1609)     - Flush(1599)    == 10
+//	ThreeOfAKind: ThreeOfAKind(2467) - Straight(1609) == 858
+func (r EvalRank) FromTripsOver() EvalRank {
+	switch {
+	case Flush < r && r <= Flush+tripsOver:
+		return r + tripsUnder
+	case Flush+tripsOver < r && r <= ThreeOfAKind:
+		return r - tripsOver
+	}
+	return r
+}
+
 // ToLowball converts a Cactus rank to a [Lowball] rank, by inverting the rank
 // and converting the lowest Straight and Straight Flushes (5-4-3-2-A) to
 // different ranks.
@@ -274,6 +374,11 @@ func RankShort(c0, c1, c2, c3, c4 Card) EvalRank {
 	return r.ToFlushOver()
 }
 
+// RankShortTrips is a [ShortTrips] rank eval func.
+func RankShortTrips(c0, c1, c2, c3, c4 Card) EvalRank {
+	return RankShort(c0, c1, c2, c3, c4).ToTripsOver()
+}
+
 // RankManila is a [Manila] rank eval func.
 func RankManila(c0, c1, c2, c3, c4 Card) EvalRank {
 	r := RankCactus(c0, c1, c2, c3, c4)
@@ -344,7 +449,7 @@ func NewEval(f RankFunc) EvalFunc {
 		case 7:
 			eval = ev.Hi7
 		}
-		v := make([]Card, np+nb)
+		v := ev.buf.take(np + nb)
 		copy(v, p)
 		copy(v[np:], b)
 		eval(f, v)
@@ -369,7 +474,7 @@ func NewMaxEval(f RankFunc, maximum EvalRank, low bool) EvalFunc {
 		case 7:
 			eval = ev.Max7
 		}
-		v := make([]Card, np+nb)
+		v := ev.buf.take(np + nb)
 		copy(v, p)
 		copy(v[np:], b)
 		eval(f, v, maximum, low)
@@ -396,7 +501,7 @@ func NewSplitEval(hi, lo RankFunc, maximum EvalRank) EvalFunc {
 		case 7:
 			eval = ev.HiLo7
 		}
-		v := make([]Card, np+nb)
+		v := ev.buf.take(np + nb)
 		copy(v, p)
 		copy(v[np:], b)
 		eval(hi, lo, v, maximum)
@@ -427,7 +532,7 @@ func NewHybridEval(normalize, low bool) EvalFunc {
 				}
 			}
 		case 7:
-			v := make([]Card, np+nb)
+			v := ev.buf.take(np + nb)
 			copy(v, p)
 			copy(v[np:], b)
 			ev.HiRank = twoPlusTwo(v)
@@ -435,7 +540,7 @@ func NewHybridEval(normalize, low bool) EvalFunc {
 				ev.HiBest, ev.HiUnused = bestCactusSplit(ev.HiRank, v, 0)
 			}
 			if low {
-				u := make([]Card, np+nb)
+				u := ev.buf.take(np + nb)
 				copy(u, p)
 				copy(u[np:], b)
 				ev.Max7(RankEightOrBetter, u, eightOrBetterMax, true)
@@ -524,50 +629,28 @@ func NewOmahaEval(hi RankFunc, base Rank, inv func(EvalRank) EvalRank, normalize
 		case 5 < nb:
 			return
 		}
-		var fp, fb func([]Card) ([][]Card, int)
-		switch np {
-		case 2:
-			fp = take2c2
-		case 3:
-			fp = take3c2
-		case 4:
-			fp = take4c2
-		case 5:
-			fp = take5c2
-		case 6:
-			fp = take6c2
-		}
-		switch nb {
-		case 3:
-			fb = take3c3
-		case 4:
-			fb = take4c3
-		case 5:
-			fb = take5c3
-		}
-		vp, ip := fp(p)
-		vb, ib := fb(b)
-		ev.HiUnused = make([]Card, np+nb-5)
+		tp, tb := omahaPocketTakes[np], omahaBoardTakes[nb]
+		hiBest, hiUnused := ev.buf.take(5), ev.buf.take(np+nb-5)
+		ev.HiUnused = hiUnused
 		var loBest, loUnused []Card
 		if low {
-			loUnused = make([]Card, np+nb-5)
+			loBest, loUnused = ev.buf.take(5), ev.buf.take(np+nb-5)
 		}
 		var c0, c1, c2, c3, c4 Card
-		for i, r := 0, EvalRank(0); i < ip; i++ {
-			for j := range ib {
-				c0, c1, c2, c3, c4 = vp[i][0], vp[i][1], vb[j][0], vb[j][1], vb[j][2]
+		for i, r := 0, EvalRank(0); i < len(tp); i++ {
+			for j := range tb {
+				c0, c1, c2, c3, c4 = p[tp[i][0]], p[tp[i][1]], b[tb[j][0]], b[tb[j][1]], b[tb[j][2]]
 				if r = hi(c0, c1, c2, c3, c4); r < ev.HiRank {
 					ev.HiRank = r
-					ev.HiBest = []Card{c0, c1, c2, c3, c4}
-					ev.HiUnused = append(ev.HiUnused[:0], vp[i][2:]...)
-					ev.HiUnused = append(ev.HiUnused, vb[j][3:]...)
+					hiBest[0], hiBest[1], hiBest[2], hiBest[3], hiBest[4] = c0, c1, c2, c3, c4
+					ev.HiBest = hiBest
+					ev.HiUnused = takeUnused(ev.HiUnused[:0], p, b, tp[i][2:], tb[j][3:])
 				}
 				if low {
 					if r = RankEightOrBetter(c0, c1, c2, c3, c4); r < eightOrBetterMax && r < ev.LoRank {
 						ev.LoRank = r
-						loBest = []Card{c0, c1, c2, c3, c4}
-						loUnused = append(loUnused[:0], vp[i][2:]...)
-						loUnused = append(loUnused, vb[j][3:]...)
+						loBest[0], loBest[1], loBest[2], loBest[3], loBest[4] = c0, c1, c2, c3, c4
+						loUnused = takeUnused(loUnused[:0], p, b, tp[i][2:], tb[j][3:])
 					}
 				}
 			}
@@ -647,45 +730,41 @@ func NewRazzEval(normalize bool) EvalFunc {
 //	3 rounds of player discards (up to 4)
 func NewBadugiEval(normalize bool) EvalFunc {
 	return func(ev *Eval, p, _ []Card) {
-		s := make([][]Card, 4)
-		for i := 0; i < len(p) && i < 4; i++ {
-			idx := p[i].SuitIndex()
-			s[idx] = append(s[idx], p[i])
-		}
-		sort.SliceStable(s, func(i, j int) bool {
-			a, b := len(s[i]), len(s[j])
-			switch {
-			case a != b:
-				return a < b
-			case a == 0:
-				return true
-			case b == 0:
-				return false
+		n := min(len(p), 4)
+		// find the best set of cards having distinct suits and ranks,
+		// where fewer cards rank worse, followed by the highest ranks, and
+		// preferring the lowest suits for equal ranks
+		set, rank, low := 0, Invalid, 0
+		for i := range 1 << n {
+			count, suits, ranks := 4, 0, 0
+			for j := range n {
+				if i&(1<<j) == 0 {
+					continue
+				}
+				s, r := 1<<p[j].SuitIndex(), 1<<p[j].AceRank()
+				if suits&s != 0 || ranks&r != 0 {
+					count = -1
+					break
+				}
+				count, suits, ranks = count-1, suits|s, ranks|r
 			}
-			return s[i][0].AceRank() < s[j][0].AceRank()
-		})
+			if r := EvalRank(count<<13 | ranks); count != -1 && (r < rank || r == rank && suits < low) {
+				set, rank, low = i, r, suits
+			}
+		}
 		var best, unused []Card
-		count, rank := 4, 0
-		for i := range 4 {
-			sort.Slice(s[i], func(j, k int) bool {
-				return s[i][j].AceRank() < s[i][k].AceRank()
-			})
-			captured, r := false, 0
-			for j := range len(s[i]) {
-				if r = 1 << s[i][j].AceRank(); rank&r == 0 && !captured {
-					captured, best = true, append(best, s[i][j])
-					rank |= r
-					count--
-				} else {
-					unused = append(unused, s[i][j])
-				}
+		for j := range n {
+			if set&(1<<j) != 0 {
+				best = append(best, p[j])
+			} else {
+				unused = append(unused, p[j])
 			}
 		}
 		if normalize {
 			bestAceLow(best)
 			bestAceHigh(unused)
 		}
-		ev.HiRank, ev.HiBest, ev.HiUnused = EvalRank(count<<13|rank), best, unused
+		ev.HiRank, ev.HiBest, ev.HiUnused = rank, best, unused
 	}
 }
 
@@ -694,12 +773,12 @@ func NewHighEval() EvalFunc {
 	return func(ev *Eval, p, b []Card) {
 		np, nb := len(p), len(b)
 		if 0 < np+nb && p[0].Rank() <= Ace {
-			v := make([]Card, np+nb)
+			v := ev.buf.take(np + nb)
 			copy(v, p)
 			copy(v[np:], b)
 			bestAceHigh(v)
 			ev.HiRank = EvalRank(Ace-v[0].Rank()) + 1
-			ev.HiBest = []Card{v[0]}
+			ev.HiBest = v[:1:1]
 			if 1 < np+nb {
 				ev.HiUnused = v[1:]
 			}
@@ -722,13 +801,28 @@ func NewThreeEval() EvalFunc {
 }
 */
 
-/*
-// NewLeducEval creates a matching high card eval func.
+// leducPairs is the number of [Leduc] pair ranks.
+const leducPairs = 13
+
+// NewLeducEval creates a [Leduc] eval func, where a pocket card matching the
+// board card (a pair) is best, otherwise the highest pocket card is best.
 func NewLeducEval() EvalFunc {
 	return func(ev *Eval, p, b []Card) {
+		if len(p) == 0 || Ace < p[0].Rank() {
+			return
+		}
+		r := p[0].Rank()
+		ev.HiBest, ev.HiUnused = p[:1:1], b
+		switch {
+		case len(b) != 0 && b[0].Rank() == r:
+			ev.HiRank = EvalRank(Ace-r) + 1
+			ev.HiBest, ev.HiUnused = ev.buf.take(2), nil
+			ev.HiBest[0], ev.HiBest[1] = p[0], b[0]
+		default:
+			ev.HiRank = leducPairs + EvalRank(Ace-r) + 1
+		}
 	}
 }
-*/
 
 // Eval contains the eval results of a type's Hi/Lo.
 type Eval struct {
@@ -739,6 +833,37 @@ type Eval struct {
 	LoRank   EvalRank
 	LoBest   []Card
 	LoUnused []Card
+
+	// buf is storage for the best and unused cards.
+	buf evalCards
+}
+
+// evalCards is fixed-size card storage for a eval's best and unused cards,
+// avoiding small slice allocations when evaluating.
+type evalCards struct {
+	v [24]Card
+	n int
+}
+
+// take takes n cards from the storage, allocating a new slice when the
+// storage is exhausted.
+func (c *evalCards) take(n int) []Card {
+	if len(c.v) < c.n+n {
+		return make([]Card, n)
+	}
+	v := c.v[c.n : c.n+n : c.n+n]
+	c.n += n
+	return v
+}
+
+// clone copies v into the storage.
+func (c *evalCards) clone(v []Card) []Card {
+	if v == nil {
+		return nil
+	}
+	u := c.take(len(v))
+	copy(u, v)
+	return u
 }
 
 // EvalOf creates a eval for the type.
@@ -750,6 +875,44 @@ func EvalOf(typ Type) *Eval {
 	}
 }
 
+// evalPool is the eval pool.
+var evalPool = sync.Pool{
+	New: func() any {
+		return new(Eval)
+	},
+}
+
+// GetEval retrieves a eval for the type from the package's eval pool. Return
+// the eval to the pool with [PutEval] when no longer needed.
+func GetEval(typ Type) *Eval {
+	ev := evalPool.Get().(*Eval)
+	ev.Reset(typ)
+	return ev
+}
+
+// PutEval returns evals to the package's eval pool. The evals, and their best
+// and unused cards, must not be used after being returned. Nil evals are
+// ignored.
+func PutEval(evs ...*Eval) {
+	for _, ev := range evs {
+		if ev != nil {
+			*ev = Eval{}
+			evalPool.Put(ev)
+		}
+	}
+}
+
+// Reset resets the eval for the type, allowing the eval to be reused. Best and
+// unused cards previously retrieved from the eval must not be used after
+// being reset.
+func (ev *Eval) Reset(typ Type) {
+	*ev = Eval{
+		Type:   typ,
+		HiRank: Invalid,
+		LoRank: Invalid,
+	}
+}
+
 // Eval evaluates the pocket, board.
 func (ev *Eval) Eval(pocket, board []Card) {
 	evals[ev.Type](ev, pocket, board)
@@ -855,7 +1018,7 @@ func (ev *Eval) Max5(f RankFunc, v []Card, maximum EvalRank, low bool) {
 
 // Hi6 evaluates the 6 cards in v, using f.
 func (ev *Eval) Hi6(f RankFunc, v []Card) {
-	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, make([]Card, 5), make([]Card, 1)
+	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, ev.buf.take(5), ev.buf.take(1)
 	for i, r := 0, EvalRank(0); i < 6; i++ {
 		if r = f(
 			v[t6c5[i][0]],
@@ -875,7 +1038,7 @@ func (ev *Eval) Hi6(f RankFunc, v []Card) {
 
 // Max6 evaluates the 6 cards in v, using f, storing only when below max.
 func (ev *Eval) Max6(f RankFunc, v []Card, maximum EvalRank, low bool) {
-	rank, best, unused := Invalid, make([]Card, 5), make([]Card, 1)
+	rank, best, unused := Invalid, ev.buf.take(5), ev.buf.take(1)
 	for i, r := 0, EvalRank(0); i < 6; i++ {
 		if r = f(
 			v[t6c5[i][0]],
@@ -902,8 +1065,8 @@ func (ev *Eval) Max6(f RankFunc, v []Card, maximum EvalRank, low bool) {
 
 // HiLo6 evaluates the 6 cards in v, using hi, lo.
 func (ev *Eval) HiLo6(hi, lo RankFunc, v []Card, maximum EvalRank) {
-	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, make([]Card, 5), make([]Card, 1)
-	rank, best, unused := Invalid, make([]Card, 5), make([]Card, 1)
+	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, ev.buf.take(5), ev.buf.take(1)
+	rank, best, unused := Invalid, ev.buf.take(5), ev.buf.take(1)
 	for i, r := 0, EvalRank(0); i < 6; i++ {
 		if r = hi(
 			v[t6c5[i][0]],
@@ -939,7 +1102,7 @@ func (ev *Eval) HiLo6(hi, lo RankFunc, v []Card, maximum EvalRank) {
 
 // Hi7 evaluates the 7 cards in v, using f.
 func (ev *Eval) Hi7(f RankFunc, v []Card) {
-	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, make([]Card, 5), make([]Card, 2)
+	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, ev.buf.take(5), ev.buf.take(2)
 	for i, r := 0, EvalRank(0); i < 21; i++ {
 		if r = f(
 			v[t7c5[i][0]],
@@ -959,7 +1122,7 @@ func (ev *Eval) Hi7(f RankFunc, v []Card) {
 
 // Max7 evaluates the 7 cards in v, using f, storing only when below max.
 func (ev *Eval) Max7(f RankFunc, v []Card, maximum EvalRank, low bool) {
-	rank, best, unused := Invalid, make([]Card, 5), make([]Card, 2)
+	rank, best, unused := Invalid, ev.buf.take(5), ev.buf.take(2)
 	for i, r := 0, EvalRank(0); i < 21; i++ {
 		if r = f(
 			v[t7c5[i][0]],
@@ -986,8 +1149,8 @@ func (ev *Eval) Max7(f RankFunc, v []Card, maximum EvalRank, low bool) {
 
 // HiLo7 evaluates the 7 cards in v, using hi, lo.
 func (ev *Eval) HiLo7(hi, lo RankFunc, v []Card, maximum EvalRank) {
-	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, make([]Card, 5), make([]Card, 2)
-	rank, best, unused := Invalid, make([]Card, 5), make([]Card, 2)
+	ev.HiRank, ev.HiBest, ev.HiUnused = Invalid, ev.buf.take(5), ev.buf.take(2)
+	rank, best, unused := Invalid, ev.buf.take(5), ev.buf.take(2)
 	for i, r := 0, EvalRank(0); i < 21; i++ {
 		if r = hi(
 			v[t7c5[i][0]],
@@ -1023,7 +1186,8 @@ func (ev *Eval) HiLo7(hi, lo RankFunc, v []Card, maximum EvalRank) {
 
 // HiLo23 evaluates the 2 cards c0, c1 and the 3 in b, using hi, lo.
 func (ev *Eval) HiLo23(hi, lo RankFunc, c0, c1 Card, b []Card, maximum EvalRank) {
-	ev.HiRank, ev.HiBest = hi(c0, c1, b[0], b[1], b[2]), []Card{c0, c1, b[0], b[1], b[2]}
+	ev.HiRank, ev.HiBest = hi(c0, c1, b[0], b[1], b[2]), ev.buf.take(5)
+	ev.HiBest[0], ev.HiBest[1], ev.HiBest[2], ev.HiBest[3], ev.HiBest[4] = c0, c1, b[0], b[1], b[2]
 	if lo != nil {
 		if r := lo(c0, c1, b[0], b[1], b[2]); r < maximum {
 			ev.LoRank, ev.LoBest = r, ev.HiBest
@@ -1033,22 +1197,25 @@ func (ev *Eval) HiLo23(hi, lo RankFunc, c0, c1 Card, b []Card, maximum EvalRank)
 
 // HiLo24 evaluates the 2 cards c0, c1 and the 4 in b, using hi, lo.
 func (ev *Eval) HiLo24(hi, lo RankFunc, c0, c1 Card, b []Card, maximum EvalRank) {
-	ev.HiBest, ev.HiUnused = []Card{c0, c1, 0, 0, 0}, make([]Card, 1)
+	ev.HiBest, ev.HiUnused = ev.buf.take(5), ev.buf.take(1)
+	ev.HiBest[0], ev.HiBest[1] = c0, c1
 	if lo != nil {
-		ev.LoBest, ev.LoUnused = []Card{c0, c1, 0, 0, 0}, make([]Card, 1)
+		ev.LoBest, ev.LoUnused = ev.buf.take(5), ev.buf.take(1)
+		ev.LoBest[0], ev.LoBest[1] = c0, c1
 	}
-	v, r := make([]Card, 3), EvalRank(0)
+	var v [3]Card
+	var r EvalRank
 	for i := range 4 {
 		v[0], v[1], v[2] = b[i], b[(i+1)%4], b[(i+2)%4]
 		if r = hi(c0, c1, v[0], v[1], v[2]); r < ev.HiRank {
 			ev.HiRank = r
-			copy(ev.HiBest[2:], v)
+			copy(ev.HiBest[2:], v[:])
 			ev.HiUnused[0] = b[(i+3)%4]
 		}
 		if lo != nil {
 			if r = lo(c0, c1, v[0], v[1], v[2]); r < ev.LoRank && r < maximum {
 				ev.LoRank = r
-				copy(ev.LoBest[2:], v)
+				copy(ev.LoBest[2:], v[:])
 				ev.LoUnused[0] = b[(i+3)%4]
 			}
 		}
@@ -1057,22 +1224,25 @@ func (ev *Eval) HiLo24(hi, lo RankFunc, c0, c1 Card, b []Card, maximum EvalRank)
 
 // HiLo25 evaluates the 2 cards c0, c1 and the 5 in b, using hi, lo.
 func (ev *Eval) HiLo25(hi, lo RankFunc, c0, c1 Card, b []Card, maximum EvalRank) {
-	ev.HiBest, ev.HiUnused = []Card{c0, c1, 0, 0, 0}, make([]Card, 2)
+	ev.HiBest, ev.HiUnused = ev.buf.take(5), ev.buf.take(2)
+	ev.HiBest[0], ev.HiBest[1] = c0, c1
 	if lo != nil {
-		ev.LoBest, ev.LoUnused = []Card{c0, c1, 0, 0, 0}, make([]Card, 2)
+		ev.LoBest, ev.LoUnused = ev.buf.take(5), ev.buf.take(2)
+		ev.LoBest[0], ev.LoBest[1] = c0, c1
 	}
-	v, r := make([]Card, 3), EvalRank(0)
+	var v [3]Card
+	var r EvalRank
 	for i := range 10 {
 		v[0], v[1], v[2] = b[t5c3[i][0]], b[t5c3[i][1]], b[t5c3[i][2]]
 		if r = hi(c0, c1, v[0], v[1], v[2]); r < ev.HiRank {
 			ev.HiRank = r
-			copy(ev.HiBest[2:], v)
+			copy(ev.HiBest[2:], v[:])
 			ev.HiUnused[0], ev.HiUnused[1] = b[t5c3[i][3]], b[t5c3[i][4]]
 		}
 		if lo != nil {
 			if r = lo(c0, c1, v[0], v[1], v[2]); r < ev.LoRank && r < maximum {
 				ev.LoRank = r
-				copy(ev.LoBest[2:], v)
+				copy(ev.LoBest[2:], v[:])
 				ev.LoUnused[0], ev.LoUnused[1] = b[t5c3[i][3]], b[t5c3[i][4]]
 			}
 		}
@@ -1092,6 +1262,21 @@ func (desc *EvalDesc) Format(f fmt.State, verb rune) {
 	desc.Type.Desc(f, verb, desc.Rank, desc.Best, desc.Unused)
 }
 
+// Used returns the best cards used from the pocket and from the board, in
+// best order. Useful for explaining [Omaha] variant evals, which must use
+// exactly 2 pocket cards and 3 board cards, and which are commonly misread.
+func (desc *EvalDesc) Used(pocket []Card) ([]Card, []Card) {
+	var p, b []Card
+	for _, c := range desc.Best {
+		if slices.Contains(pocket, c) {
+			p = append(p, c)
+		} else {
+			b = append(b, c)
+		}
+	}
+	return p, b
+}
+
 // Order builds an ordered slice of indices for the provided evals, ordered by
 // either Hi or Lo (per [Eval.Comp]), returning the slice of indices and a
 // pivot into the indices indicating the winning vs losing position.
@@ -1099,36 +1284,132 @@ func (desc *EvalDesc) Format(f fmt.State, verb rune) {
 // Pivot will always be 1 or higher when ordering by Hi's. When ordering by
 // Lo's, if there are no valid (ie, qualified) evals, the returned pivot will
 // be 0.
+//
+// See [OrderInto] to reuse an existing slice of indices.
 func Order(evs []*Eval, low bool) ([]int, int) {
-	if len(evs) == 0 {
+	v, pivot := OrderInto(nil, evs, low)
+	if pivot == 0 {
 		return nil, 0
 	}
+	return v, pivot
+}
+
+// OrderInto is the same as [Order], but stores the ordered indices in dst,
+// reusing dst's underlying array when it has sufficient capacity. Useful for
+// avoiding allocations when repeatedly ordering evals.
+//
+// When there are no qualified Lo evals, returns dst truncated to zero length,
+// and a pivot of 0.
+func OrderInto(dst []int, evs []*Eval, low bool) ([]int, int) {
 	n := len(evs)
-	i, m, v := 0, make(map[int]*Eval, n), make([]int, n)
-	// set up
-	for ; i < n; i++ {
-		m[i], v[i] = evs[i], i
-	}
-	// sort v based on mapped evals
-	sort.SliceStable(v, func(j, k int) bool {
-		return m[v[j]].Comp(m[v[k]], low) < 0
-	})
+	if n == 0 {
+		return dst[:0], 0
+	}
+	if cap(dst) < n {
+		dst = make([]int, n)
+	}
+	v := dst[:n]
+	for i := range n {
+		v[i] = i
+	}
+	// stable insertion sort, as the number of evals is always small
+	for i := 1; i < n; i++ {
+		for j := i; 0 < j && evs[v[j]].Comp(evs[v[j-1]], low) < 0; j-- {
+			v[j], v[j-1] = v[j-1], v[j]
+		}
+	}
+	i := 1
 	if !low {
 		// determine hi pivot
-		for i = 1; i < n && m[v[i-1]] != nil && m[v[i]] != nil && m[v[i-1]].HiRank == m[v[i]].HiRank; i++ {
+		for ; i < n && evs[v[i-1]] != nil && evs[v[i]] != nil && evs[v[i-1]].HiRank == evs[v[i]].HiRank; i++ {
 		}
 	} else {
 		// determine if any qualified low evals
-		if m[v[0]] == nil || m[v[0]].LoRank == 0 || m[v[0]].LoRank == Invalid {
-			return nil, 0
+		if evs[v[0]] == nil || evs[v[0]].LoRank == 0 || evs[v[0]].LoRank == Invalid {
+			return v[:0], 0
 		}
 		// determine lo pivot
-		for i = 1; i < n && m[v[i-1]] != nil && m[v[i]] != nil && m[v[i-1]].LoRank == m[v[i]].LoRank; i++ {
+		for ; i < n && evs[v[i-1]] != nil && evs[v[i]] != nil && evs[v[i-1]].LoRank == evs[v[i]].LoRank; i++ {
 		}
 	}
 	return v, i
 }
 
+// EvalRanks evaluates each of the hands for the type, returning the Hi rank of
+// each hand. Each hand is evaluated as a pocket without a board (ie, the 7
+// cards of a [Holdem] pocket and board), so types requiring the use of pocket
+// cards, such as [Omaha], should be evaluated with [Type.EvalInto].
+//
+// A convenience for ranking many hands, evaluating each hand in turn. Hands of
+// 5, 6, or 7 cards for types having a Cactus eval ([EvalCactus]) are ranked
+// directly with [RankCactus], or with the 7 card eval backend (see
+// [SetEvalBackend]), without the overhead of determining the best and unused
+// cards of a [Eval]. Other hands are ranked using a single reused eval (see
+// [Type.EvalInto]). Ranks are the same as [Eval.HiRank] for the type.
+// Unregistered types rank all hands [Invalid].
+//
+// See [EvalRanksInto] for evaluating without allocating.
+func EvalRanks(typ Type, hands [][]Card) []EvalRank {
+	return EvalRanksInto(nil, typ, hands)
+}
+
+// EvalRanksInto evaluates each of the hands for the type, storing the Hi rank
+// of each hand in dst (see [EvalRanks]). Does not allocate when dst has a
+// capacity of at least the number of hands.
+func EvalRanksInto(dst []EvalRank, typ Type, hands [][]Card) []EvalRank {
+	n := len(hands)
+	if cap(dst) < n {
+		dst = make([]EvalRank, n)
+	}
+	v := dst[:n]
+	desc, ok := descs[typ]
+	if !ok {
+		for i := range v {
+			v[i] = Invalid
+		}
+		return v
+	}
+	fast := desc.Eval == EvalCactus && RankCactus != nil
+	var ev *Eval
+	for i, hand := range hands {
+		if n := len(hand); fast && 5 <= n && n <= 7 {
+			v[i] = rankCactusBest(hand)
+			continue
+		}
+		if ev == nil {
+			ev = GetEval(typ)
+		}
+		typ.EvalInto(ev, hand, nil)
+		v[i] = ev.HiRank
+	}
+	PutEval(ev)
+	return v
+}
+
+// rankCactusBest returns the best Cactus rank of any 5 of the 5, 6, or 7
+// cards.
+func rankCactusBest(v []Card) EvalRank {
+	r := Invalid
+	switch len(v) {
+	case 5:
+		r = RankCactus(v[0], v[1], v[2], v[3], v[4])
+	case 6:
+		for i := range t6c5 {
+			t := &t6c5[i]
+			r = min(r, RankCactus(v[t[0]], v[t[1]], v[t[2]], v[t[3]], v[t[4]]))
+		}
+	case 7:
+		if twoPlusTwo != nil {
+			return twoPlusTwo(v)
+		}
+		for i := range t7c5 {
+			t := &t7c5[i]
+			r = min(r, RankCactus(v[t[0]], v[t[1]], v[t[2]], v[t[3]], v[t[4]]))
+		}
+	}
+	return r
+}
+
 // bestCactus orders the best and unused cards in v and u, with the specified
 // straight base, and inv func to inverse the passed eval rank.
 func bestCactus(rank EvalRank, v, u []Card, base Rank, inv func(EvalRank) EvalRank) {
@@ -1435,33 +1716,54 @@ func take6c2(v []Card) ([][]Card, int) {
 	return u, 15
 }
 
-// take3c3 generates the combinations of v.
-func take3c3(v []Card) ([][]Card, int) {
-	return [][]Card{v}, 1
-}
-
-// take4c3 generates the combinations of v.
-func take4c3(v []Card) ([][]Card, int) {
-	u := make([][]Card, 4)
-	for i := range 4 {
-		u[i] = []Card{v[i], v[(i+1)%4], v[(i+2)%4], v[(i+3)%4]}
+// omahaPocketTakes are the indices for taking 2 pocket cards, by the pocket
+// count, followed by the unused indices.
+var omahaPocketTakes = [7][][]uint8{
+	2: takeIndices(2, 2),
+	3: takeIndices(3, 2),
+	4: takeIndices(4, 2),
+	5: takeIndices(5, 2),
+	6: takeIndices(6, 2),
+}
+
+// omahaBoardTakes are the indices for taking 3 board cards, by the board
+// count, followed by the unused indices.
+var omahaBoardTakes = [6][][]uint8{
+	3: takeIndices(3, 3),
+	4: takeIndices(4, 3),
+	5: takeIndices(5, 3),
+}
+
+// takeIndices returns the indices of the k element combinations of n
+// elements, in lexicographic order, each followed by the unused indices.
+func takeIndices(n, k int) [][]uint8 {
+	var v [][]uint8
+	for c, ok := combin.First(k), true; ok; ok = combin.Next(c, n) {
+		u := make([]uint8, 0, n)
+		for i := range n {
+			if slices.Contains(c, i) {
+				u = append(u, uint8(i))
+			}
+		}
+		for i := range n {
+			if !slices.Contains(c, i) {
+				u = append(u, uint8(i))
+			}
+		}
+		v = append(v, u)
 	}
-	return u, 4
+	return v
 }
 
-// take5c3 generates the combinations of v.
-func take5c3(v []Card) ([][]Card, int) {
-	u := make([][]Card, 10)
-	for i := range 10 {
-		u[i] = []Card{
-			v[t5c3[i][0]],
-			v[t5c3[i][1]],
-			v[t5c3[i][2]],
-			v[t5c3[i][3]],
-			v[t5c3[i][4]],
-		}
+// takeUnused appends the unused pocket and board cards to v.
+func takeUnused(v, p, b []Card, ip, ib []uint8) []Card {
+	for _, i := range ip {
+		v = append(v, p[i])
 	}
-	return u, 10
+	for _, i := range ib {
+		v = append(v, b[i])
+	}
+	return v
 }
 
 // t4c2 is used for taking 4, choosing 2.
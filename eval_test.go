@@ -72,6 +72,41 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderInto(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var dst []int
+	for _, typ := range []Type{Holdem, OmahaHiLo, Razz} {
+		for range 100 {
+			pockets, board := typ.Deal(r, 1, 6)
+			evs := make([]*Eval, len(pockets))
+			for i := range pockets {
+				evs[i] = typ.Eval(pockets[i], board)
+			}
+			for _, low := range []bool{false, true} {
+				exp, expPivot := Order(evs, low)
+				var pivot int
+				dst, pivot = OrderInto(dst, evs, low)
+				if pivot != expPivot {
+					t.Errorf("%s expected pivot %d, got: %d", typ, expPivot, pivot)
+				}
+				if exp == nil && len(dst) != 0 || exp != nil && !slices.Equal(dst, exp) {
+					t.Errorf("%s expected %v, got: %v", typ, exp, dst)
+				}
+			}
+		}
+	}
+	pockets, board := Holdem.Deal(r, 1, 6)
+	evs := make([]*Eval, len(pockets))
+	for i := range pockets {
+		evs[i] = Holdem.Eval(pockets[i], board)
+	}
+	if n := testing.AllocsPerRun(100, func() {
+		dst, _ = OrderInto(dst, evs, false)
+	}); n != 0 {
+		t.Errorf("expected 0 allocs, got: %f", n)
+	}
+}
+
 func TestEvalComp(t *testing.T) {
 	tests := []struct {
 		typ Type
@@ -99,6 +134,10 @@ func TestEvalComp(t *testing.T) {
 		{Soko, "Th Tc 8h 6c Kh Qc 2s", "Ah Kh Qc Jh 6c 9c 4s", +1, 12626, "Four Straight, Ace-high, kicker Nine [Ah Kh Qc Jh 9c]"},
 		{Short, "5c 3c Ah Th 9h 8h 7h", "J♣ J♥ 6♣ 6♦ 6♥ 5♥ 3♣", -1, 535, "Flush, Ace-high, kickers Ten, Nine, Eight, Seven [Ah Th 9h 8h 7h]"},
 		{Short, "5♥ 3♣ 6♣ 6♦ 6♥ J♣ J♥", "8h 7h Ah Th 9h 5c 3c", +1, 535, "Flush, Ace-high, kickers Ten, Nine, Eight, Seven [Ah Th 9h 8h 7h]"},
+		{Short, "Ah 6c 7c 8s 9d Kh Jc", "6h 6d 6s Tc Qd Kc 8h", -1, 1605, "Straight, Nine-high [9d 8s 7c 6c Ah]"},
+		{ShortTrips, "Ah 6c 7c 8s 9d Kh Jc", "6h 6d 6s Tc Qd Kc 8h", +1, 2139, "Three of a Kind, Sixes, kickers King, Queen [6d 6h 6s Kc Qd]"},
+		{ShortTrips, "6h 6d 6s Tc Qd Kc 8h", "Ah 6c 7c 8s 9d Kh Jc", -1, 2139, "Three of a Kind, Sixes, kickers King, Queen [6d 6h 6s Kc Qd]"},
+		{ShortTrips, "5c 3c Ah Th 9h 8h 7h", "J♣ J♥ 6♣ 6♦ 6♥ 5♥ 3♣", -1, 535, "Flush, Ace-high, kickers Ten, Nine, Eight, Seven [Ah Th 9h 8h 7h]"},
 	}
 	for i, test := range tests {
 		a, b := test.typ.Eval(Must(test.a), nil), test.typ.Eval(Must(test.b), nil)
@@ -157,6 +196,36 @@ func TestEval(t *testing.T) {
 	}
 }
 
+func TestEvalDescUsed(t *testing.T) {
+	tests := []struct {
+		typ    Type
+		pocket string
+		board  string
+		low    bool
+		p, b   string
+	}{
+		{Holdem, "Ah Kh", "Qh Jh Th 2c 3d", false, "[Ah Kh]", "[Qh Jh Th]"},
+		{Holdem, "2s 3s", "Ah Kh Qh Jh Th", false, "[]", "[Ah Kh Qh Jh Th]"},
+		{Omaha, "Ah 2c 3d 4s", "Kh Qh Jh Th 9h", false, "[Ah 4s]", "[Kh Qh Jh]"},
+		{Omaha, "As Ks Qd Jd", "2s 3s 4s 5s 6s", false, "[As Ks]", "[6s 5s 4s]"},
+		{OmahaHiLo, "As 2d Kc Kd", "3c 4h 8s Qh Jd", true, "[2d As]", "[8s 4h 3c]"},
+		{OmahaFive, "Ah Ad Ac Kh Qd", "As 2c 3d 7h 9s", false, "[Ad Ah]", "[As 9s 7h]"},
+		{OmahaFiveHiLo, "Ah Ad Ac Kh 4d", "As 2c 3d 7h 9s", true, "[4d Ah]", "[7h 3d 2c]"},
+		{OmahaSix, "7c 8c 9d Td Jh Qh", "6c 5h Kd 2s 3s", false, "[Qh Jh]", "[Kd 6c 5h]"},
+	}
+	for i, test := range tests {
+		pocket, board := Must(test.pocket), Must(test.board)
+		ev := test.typ.Eval(pocket, board)
+		p, b := ev.Desc(test.low).Used(pocket)
+		if s := fmt.Sprintf("%s", p); s != test.p {
+			t.Errorf("test %d expected pocket %s, got: %s", i, test.p, s)
+		}
+		if s := fmt.Sprintf("%s", b); s != test.b {
+			t.Errorf("test %d expected board %s, got: %s", i, test.b, s)
+		}
+	}
+}
+
 func TestNewSplitEval(t *testing.T) {
 	tests := []struct {
 		f   RankFunc
@@ -220,6 +289,12 @@ func TestEvalRankToFrom(t *testing.T) {
 			t.Errorf("expected %d, got: %d", i, b)
 		}
 	}
+	for i := EvalRank(1); i <= Nothing; i++ {
+		a := i.ToTripsOver()
+		if b := a.FromTripsOver(); b != i {
+			t.Errorf("expected %d, got: %d", i, b)
+		}
+	}
 	for i := EvalRank(1); i <= Nothing; i++ {
 		a := i.ToLowball()
 		if b := a.FromLowball(); b != i {
@@ -260,6 +335,60 @@ func TestEvalRankTitle(t *testing.T) {
 	}
 }
 
+func TestCategoryBounds(t *testing.T) {
+	tests := []struct {
+		category EvalRank
+		best     EvalRank
+		worst    EvalRank
+	}{
+		{StraightFlush, 1, 10},
+		{FourOfAKind, 11, 166},
+		{FullHouse, 167, 322},
+		{Flush, 323, 1599},
+		{Straight, 1600, 1609},
+		{ThreeOfAKind, 1610, 2467},
+		{TwoPair, 2468, 3325},
+		{Pair, 3326, 6185},
+		{Nothing, 6186, 7462},
+		{100, Invalid, Invalid},
+	}
+	for i, test := range tests {
+		best, worst := CategoryBounds(test.category)
+		if best != test.best || worst != test.worst {
+			t.Errorf("test %d expected %d-%d, got: %d-%d", i, test.best, test.worst, best, worst)
+		}
+		if best == Invalid {
+			continue
+		}
+		for _, r := range []EvalRank{best, worst} {
+			if c := CategoryOf(r); c != test.category {
+				t.Errorf("test %d expected %d category %s, got: %s", i, r, test.category.Title(), c.Title())
+			}
+		}
+	}
+	for _, test := range []struct {
+		r        string
+		flush    bool
+		straight bool
+		paired   bool
+	}{
+		{"As Ks Qs Js Ts", false, false, false},
+		{"As Ks Qs Js 9s", true, false, false},
+		{"As Kh Qs Js Ts", false, true, false},
+		{"As Ah Kc Kd Ks", false, false, true},
+		{"As Kh Qs Js 9s", false, false, false},
+	} {
+		v := Must(test.r)
+		r := Cactus(v[0], v[1], v[2], v[3], v[4])
+		if IsFlushRank(r) != test.flush || IsStraightRank(r) != test.straight || IsPairedRank(r) != test.paired {
+			t.Errorf("%s expected %t/%t/%t, got: %t/%t/%t", test.r, test.flush, test.straight, test.paired, IsFlushRank(r), IsStraightRank(r), IsPairedRank(r))
+		}
+		if exp := test.r == "As Ks Qs Js Ts"; IsStraightFlushRank(r) != exp {
+			t.Errorf("%s expected straight flush %t", test.r, exp)
+		}
+	}
+}
+
 func TestLowballCards(t *testing.T) {
 	if s := os.Getenv("TESTS"); !strings.Contains(s, "lowball") && !strings.Contains(s, "all") {
 		t.Skip("skipping: $ENV{TESTS} does not contain 'lowball' or 'all'")
@@ -426,3 +555,127 @@ func sevenCardTests() []cardTest {
 		{"2d 3d As Ks Qs Js Ts", 0x0001, StraightFlush, "Straight Flush, Ace-high, Royal [A♠ K♠ Q♠ J♠ T♠] [3♦ 2♦]"},
 	}
 }
+
+func TestEvalPool(t *testing.T) {
+	ev := GetEval(Holdem)
+	if ev.Type != Holdem || ev.HiRank != Invalid || ev.LoRank != Invalid || ev.HiBest != nil {
+		t.Fatalf("expected reset eval, got: %+v", ev)
+	}
+	ev.Eval(Must("Ah Kh"), Must("Qh Jh Th 2c 3c"))
+	if s, exp := fmt.Sprintf("%s", ev.HiBest), "[Ah Kh Qh Jh Th]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+	PutEval(ev, nil)
+	ev = GetEval(Omaha)
+	if ev.Type != Omaha || ev.HiRank != Invalid || ev.HiBest != nil || ev.HiUnused != nil {
+		t.Errorf("expected reset eval, got: %+v", ev)
+	}
+	PutEval(ev)
+}
+
+func TestEvalAllocs(t *testing.T) {
+	tests := []struct {
+		typ    Type
+		pocket string
+		board  string
+	}{
+		{Holdem, "Ah Kh", "Qh Jh Th 2c 3c"},
+		{Stud, "Ah Kh Qh Jh Th 2c 3c", ""},
+		{StudHiLo, "Ah 2h 3c Jh Th 4c 5c", ""},
+		{Short, "Ah Kh", "Qh Jh Th 9c 8c"},
+		{Razz, "Ah Kh Qh Jh Th 2c 3c", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.Name(), func(t *testing.T) {
+			f, ev := calcs[test.typ], EvalOf(test.typ)
+			pocket, board := Must(test.pocket), Must(test.board)
+			if n := testing.AllocsPerRun(100, func() {
+				ev.Reset(test.typ)
+				f(ev, pocket, board)
+			}); n != 0 {
+				t.Errorf("expected 0 allocs, got: %v", n)
+			}
+		})
+	}
+}
+
+func TestEvalRanks(t *testing.T) {
+	tests := []struct {
+		typ Type
+		n   []int
+	}{
+		{Holdem, []int{2, 3, 5, 6, 7}},
+		{Split, []int{7}},
+		{Short, []int{5, 7}},
+		{Stud, []int{7}},
+		{Razz, []int{7}},
+		{Badugi, []int{4}},
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, test := range tests {
+		t.Run(test.typ.Name(), func(t *testing.T) {
+			var hands [][]Card
+			for i := range 1000 {
+				v := test.typ.DeckType().Unshuffled()
+				r.Shuffle(len(v), func(i, j int) {
+					v[i], v[j] = v[j], v[i]
+				})
+				hands = append(hands, v[:test.n[i%len(test.n)]])
+			}
+			ranks := EvalRanks(test.typ, hands)
+			if len(ranks) != len(hands) {
+				t.Fatalf("expected %d ranks, got: %d", len(hands), len(ranks))
+			}
+			for i, hand := range hands {
+				if exp := test.typ.Eval(hand, nil).HiRank; ranks[i] != exp {
+					t.Errorf("hand %d %s expected %d, got: %d", i, hand, exp, ranks[i])
+				}
+			}
+			if n := testing.AllocsPerRun(10, func() {
+				ranks = EvalRanksInto(ranks, test.typ, hands)
+			}); n != 0 && 5 <= slices.Min(test.n) {
+				t.Errorf("expected 0 allocs, got: %v", n)
+			}
+		})
+	}
+	if ranks := EvalRanks(Type(0), [][]Card{Must("Ah Kh Qh Jh Th")}); len(ranks) != 1 || ranks[0] != Invalid {
+		t.Errorf("expected [%d], got: %v", Invalid, ranks)
+	}
+}
+
+func TestEvalInto(t *testing.T) {
+	tests := []struct {
+		typ    Type
+		pocket string
+		board  string
+	}{
+		{Holdem, "Ah Kh", "Qh Jh Th 2c 3c"},
+		{Short, "Ah Kh", "Qh Jh Th 9c 8c"},
+		{Omaha, "Ah Kh Qc 2d", "Qh Jh Th"},
+		{Omaha, "Ah Kh Qc 2d", "Qh Jh Th 2c"},
+		{OmahaHiLo, "Ah 2h Qc 3d", "4h 5h Th 9c 8c"},
+		{OmahaSix, "Ah Kh Qc 2d 3d 4d", "Qh Jh Th 2c 3c"},
+		{Stud, "Ah Kh Qh Jh Th 2c 3c", ""},
+		{Razz, "Ah Kh Qh Jh Th 2c 3c", ""},
+	}
+	ev := GetEval(Holdem)
+	defer PutEval(ev)
+	for _, test := range tests {
+		t.Run(test.typ.Name(), func(t *testing.T) {
+			pocket, board := Must(test.pocket), Must(test.board)
+			test.typ.EvalInto(ev, pocket, board)
+			exp := test.typ.Eval(pocket, board)
+			if ev.Type != test.typ || ev.HiRank != exp.HiRank || ev.LoRank != exp.LoRank {
+				t.Errorf("expected %s %d %d, got: %s %d %d", test.typ, exp.HiRank, exp.LoRank, ev.Type, ev.HiRank, ev.LoRank)
+			}
+			if a, b := slices.Sorted(slices.Values(ev.HiBest)), slices.Sorted(slices.Values(exp.HiBest)); len(a) != 0 && !slices.Equal(a, b) {
+				t.Errorf("expected best %s, got: %s", b, a)
+			}
+			if n := testing.AllocsPerRun(100, func() {
+				test.typ.EvalInto(ev, pocket, board)
+			}); n != 0 {
+				t.Errorf("expected 0 allocs, got: %v", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package cardrank
+
+import (
+	"fmt"
+	"slices"
+)
+
+// EventKind is a dealer event kind.
+type EventKind uint8
+
+// Dealer event kinds.
+const (
+	// EventStreet is the advance to a street of a run.
+	EventStreet EventKind = iota
+	// EventDiscard is the discard of cards, either burned prior to dealing
+	// pocket or board cards, or discarded by a position when drawing.
+	EventDiscard
+	// EventPocket is the deal of pocket cards to a position, either on a
+	// street or as replacements when drawing.
+	EventPocket
+	// EventBoard is the deal of Hi or Lo board cards.
+	EventBoard
+	// EventRuns is a change to the number of runs.
+	EventRuns
+	// EventResult is the result of a run.
+	EventResult
+)
+
+// String satisfies the [fmt.Stringer] interface.
+func (kind EventKind) String() string {
+	switch kind {
+	case EventStreet:
+		return "street"
+	case EventDiscard:
+		return "discard"
+	case EventPocket:
+		return "pocket"
+	case EventBoard:
+		return "board"
+	case EventRuns:
+		return "runs"
+	case EventResult:
+		return "result"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(kind))
+}
+
+// Event is a dealer event.
+type Event struct {
+	// Kind is the event kind.
+	Kind EventKind
+	// Street is the street.
+	Street int
+	// Run is the run, or the result's run.
+	Run int
+	// Position is the position of a pocket deal or draw discard, or -1.
+	Position int
+	// Lo is true for a deal of Lo board cards.
+	Lo bool
+	// Runs is the number of runs.
+	Runs int
+	// Cards are the discarded or dealt cards. The cards are a copy, and can be
+	// retained.
+	Cards []Card
+	// Result is the run's result.
+	Result *Result
+}
+
+// Observe registers the func to be called with each event as the dealer
+// deals streets and runs (see [Dealer.Next]), draws (see [Dealer.Draw]),
+// changes runs (see [Dealer.ChangeRuns]), and iterates results (see
+// [Dealer.NextResult]), allowing state changes to be broadcast without
+// inspecting the dealer after each call. Funcs are called synchronously, in
+// the order registered, and must not call the dealer's methods.
+func (d *Dealer) Observe(f func(Event)) {
+	d.observers = append(d.observers, f)
+}
+
+// Notify registers the channel to be sent each event (see [Dealer.Observe]).
+// Sends block, so the channel must be received from in another goroutine, or
+// be sufficiently buffered for the events of a hand.
+func (d *Dealer) Notify(ch chan<- Event) {
+	d.Observe(func(ev Event) {
+		ch <- ev
+	})
+}
+
+// emit sends the event to the dealer's observers.
+func (d *Dealer) emit(kind EventKind, street, position int, lo bool, cards []Card) {
+	if len(d.observers) == 0 {
+		return
+	}
+	ev := Event{
+		Kind:     kind,
+		Street:   street,
+		Run:      d.r,
+		Position: position,
+		Lo:       lo,
+		Runs:     d.runs,
+		Cards:    slices.Clone(cards),
+	}
+	if kind == EventResult {
+		ev.Run, ev.Result = d.e, d.Results[d.e]
+	}
+	for _, f := range d.observers {
+		f(ev)
+	}
+}
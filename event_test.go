@@ -0,0 +1,127 @@
+package cardrank
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDealerObserve(t *testing.T) {
+	for _, typ := range Types() {
+		t.Run(typ.Name(), func(t *testing.T) {
+			count := min(typ.Max(), 3)
+			d := typ.Dealer(rand.New(rand.NewSource(int64(typ))), 1, count)
+			pockets, hi, lo, discard := make([][]Card, count), []Card(nil), []Card(nil), []Card(nil)
+			var streets []int
+			d.Observe(func(ev Event) {
+				switch ev.Kind {
+				case EventStreet:
+					streets = append(streets, ev.Street)
+				case EventDiscard:
+					discard = append(discard, ev.Cards...)
+				case EventPocket:
+					pockets[ev.Position] = append(pockets[ev.Position], ev.Cards...)
+				case EventBoard:
+					if ev.Lo {
+						lo = append(lo, ev.Cards...)
+					} else {
+						hi = append(hi, ev.Cards...)
+					}
+				}
+			})
+			for d.Next() {
+			}
+			_, run := d.Run()
+			switch {
+			case len(streets) != len(d.Streets):
+				t.Errorf("expected %d streets, got: %v", len(d.Streets), streets)
+			case !slices.Equal(hi, run.Hi), !slices.Equal(lo, run.Lo):
+				t.Errorf("expected boards %v %v, got: %v %v", run.Hi, run.Lo, hi, lo)
+			case !slices.Equal(discard, run.Discard):
+				t.Errorf("expected discard %v, got: %v", run.Discard, discard)
+			}
+			for i := range count {
+				if !slices.Equal(pockets[i], run.Pockets[i]) {
+					t.Errorf("position %d expected %v, got: %v", i, run.Pockets[i], pockets[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDealerNotify(t *testing.T) {
+	d := Holdem.Dealer(rand.New(rand.NewSource(1)), 1, 3)
+	ch := make(chan Event, 64)
+	d.Notify(ch)
+	var kinds []EventKind
+	for d.Next() {
+		if d.Id() == 'f' {
+			if !d.ChangeRuns(2) {
+				t.Fatalf("expected runs to change")
+			}
+		}
+	}
+	for d.NextResult() {
+	}
+	close(ch)
+	var runs []int
+	var results []*Result
+	for ev := range ch {
+		if len(kinds) == 0 || kinds[len(kinds)-1] != ev.Kind {
+			kinds = append(kinds, ev.Kind)
+		}
+		switch ev.Kind {
+		case EventRuns:
+			if ev.Runs != 2 {
+				t.Errorf("expected 2 runs, got: %d", ev.Runs)
+			}
+		case EventStreet:
+			runs = append(runs, ev.Run)
+		case EventResult:
+			results = append(results, ev.Result)
+		}
+	}
+	exp := []EventKind{
+		EventStreet, EventPocket, // preflop
+		EventStreet, EventDiscard, EventBoard, EventRuns, // flop
+		EventStreet, EventDiscard, EventBoard, // turn
+		EventStreet, EventDiscard, EventBoard, // river
+		EventStreet, EventDiscard, EventBoard, // turn
+		EventStreet, EventDiscard, EventBoard, // river
+		EventResult,
+	}
+	switch {
+	case !slices.Equal(kinds, exp):
+		t.Errorf("expected %v, got: %v", exp, kinds)
+	case !slices.Equal(runs, []int{0, 0, 0, 0, 1, 1}):
+		t.Errorf("expected runs %v, got: %v", []int{0, 0, 0, 0, 1, 1}, runs)
+	case len(results) != 2 || results[0] != d.Results[0] || results[1] != d.Results[1]:
+		t.Errorf("expected results %v, got: %v", d.Results, results)
+	}
+}
+
+func TestDealerObserveDraw(t *testing.T) {
+	d := Lowball.Dealer(rand.New(rand.NewSource(1)), 1, 2)
+	var events []Event
+	d.Observe(func(ev Event) {
+		if ev.Kind == EventDiscard || ev.Kind == EventPocket {
+			events = append(events, ev)
+		}
+	})
+	d.Next()
+	d.Next()
+	events = events[:0]
+	_, run := d.Run()
+	discards := slices.Clone(run.Pockets[1][:2])
+	v, err := d.Draw(1, discards)
+	switch {
+	case err != nil:
+		t.Fatalf("expected no error, got: %v", err)
+	case len(events) != 2:
+		t.Fatalf("expected 2 events, got: %d", len(events))
+	case events[0].Kind != EventDiscard || events[0].Position != 1 || !slices.Equal(events[0].Cards, discards):
+		t.Errorf("expected discard of %v, got: %v", discards, events[0])
+	case events[1].Kind != EventPocket || events[1].Position != 1 || !slices.Equal(events[1].Cards, v):
+		t.Errorf("expected pocket of %v, got: %v", v, events[1])
+	}
+}
@@ -148,7 +148,7 @@ func Example() {
 			n, res := d.Result()
 			fmt.Printf("  Run %d:\n", n)
 			for i := range game.players {
-				if d.Active[i] {
+				if d.Active.Has(i) {
 					hi := res.Evals[i].Desc(false)
 					fmt.Printf("    %d: %v %v %s\n", i, hi.Best, hi.Unused, hi)
 					if d.Low || d.Double {
@@ -1296,40 +1296,40 @@ func ExampleType_badugi() {
 	}
 	// Output:
 	// ------ Badugi 1 ------
-	// Player 1: [K♥ J♣ A♥ Q♠] Queen, Jack, Ace-low [Q♠ J♣ A♥] [K♥]
-	// Player 2: [7♣ 4♣ 5♠ 2♠] Four, Two-low [4♣ 2♠] [7♣ 5♠]
-	// Result:   Player 1 wins with Queen, Jack, Ace-low
+	// Player 1: [K♥ J♣ A♥ Q♠] Three-card badugi: Q-J-A [Q♠ J♣ A♥] [K♥]
+	// Player 2: [7♣ 4♣ 5♠ 2♠] Two-card badugi: 4-2 [4♣ 2♠] [7♣ 5♠]
+	// Result:   Player 1 wins with Three-card badugi: Q-J-A
 	// ------ Badugi 2 ------
-	// Player 1: [3♠ 3♦ T♠ Q♠] Ten, Three-low [T♠ 3♦] [Q♠ 3♠]
-	// Player 2: [6♦ Q♣ 8♥ 6♣] Queen, Eight, Six-low [Q♣ 8♥ 6♦] [6♣]
-	// Player 3: [Q♦ K♠ 8♣ A♥] King, Queen, Eight, Ace-low [K♠ Q♦ 8♣ A♥] []
-	// Player 4: [K♦ T♦ 8♦ 4♥] Eight, Four-low [8♦ 4♥] [K♦ T♦]
-	// Player 5: [J♦ 2♥ Q♥ 6♠] Jack, Six, Two-low [J♦ 6♠ 2♥] [Q♥]
-	// Result:   Player 3 wins with King, Queen, Eight, Ace-low
+	// Player 1: [3♠ 3♦ T♠ Q♠] Two-card badugi: T-3 [T♠ 3♦] [Q♠ 3♠]
+	// Player 2: [6♦ Q♣ 8♥ 6♣] Three-card badugi: Q-8-6 [Q♣ 8♥ 6♦] [6♣]
+	// Player 3: [Q♦ K♠ 8♣ A♥] Badugi: K-Q-8-A [K♠ Q♦ 8♣ A♥] []
+	// Player 4: [K♦ T♦ 8♦ 4♥] Two-card badugi: 8-4 [8♦ 4♥] [K♦ T♦]
+	// Player 5: [J♦ 2♥ Q♥ 6♠] Three-card badugi: J-6-2 [J♦ 6♠ 2♥] [Q♥]
+	// Result:   Player 3 wins with Badugi: K-Q-8-A
 	// ------ Badugi 3 ------
-	// Player 1: [K♠ Q♠ 4♣ J♦] Queen, Jack, Four-low [Q♠ J♦ 4♣] [K♠]
-	// Player 2: [J♠ 3♣ 8♥ 2♠] Eight, Three, Two-low [8♥ 3♣ 2♠] [J♠]
-	// Player 3: [3♠ T♠ 2♣ Q♦] Queen, Three, Two-low [Q♦ 3♠ 2♣] [T♠]
-	// Player 4: [5♣ 5♥ T♦ 2♦] Five, Two-low [5♥ 2♦] [T♦ 5♣]
-	// Player 5: [7♠ 3♥ 6♠ A♣] Six, Three, Ace-low [6♠ 3♥ A♣] [7♠]
-	// Player 6: [4♠ 8♦ K♦ T♣] Ten, Eight, Four-low [T♣ 8♦ 4♠] [K♦]
-	// Result:   Player 5 wins with Six, Three, Ace-low
+	// Player 1: [K♠ Q♠ 4♣ J♦] Three-card badugi: Q-J-4 [Q♠ J♦ 4♣] [K♠]
+	// Player 2: [J♠ 3♣ 8♥ 2♠] Three-card badugi: 8-3-2 [8♥ 3♣ 2♠] [J♠]
+	// Player 3: [3♠ T♠ 2♣ Q♦] Three-card badugi: Q-3-2 [Q♦ 3♠ 2♣] [T♠]
+	// Player 4: [5♣ 5♥ T♦ 2♦] Two-card badugi: 5-2 [5♥ 2♦] [T♦ 5♣]
+	// Player 5: [7♠ 3♥ 6♠ A♣] Three-card badugi: 6-3-A [6♠ 3♥ A♣] [7♠]
+	// Player 6: [4♠ 8♦ K♦ T♣] Three-card badugi: T-8-4 [T♣ 8♦ 4♠] [K♦]
+	// Result:   Player 5 wins with Three-card badugi: 6-3-A
 	// ------ Badugi 4 ------
-	// Player 1: [6♠ K♥ A♣ 8♣] King, Six, Ace-low [K♥ 6♠ A♣] [8♣]
-	// Player 2: [Q♥ 4♥ J♣ 5♥] Jack, Four-low [J♣ 4♥] [Q♥ 5♥]
-	// Player 3: [2♣ 6♥ 5♣ Q♠] Queen, Six, Two-low [Q♠ 6♥ 2♣] [5♣]
-	// Player 4: [9♠ J♥ K♠ J♠] Jack, Nine-low [J♥ 9♠] [K♠ J♠]
-	// Player 5: [3♦ 4♦ K♣ 8♦] King, Three-low [K♣ 3♦] [8♦ 4♦]
-	// Player 6: [T♣ Q♦ A♠ 7♥] Queen, Ten, Seven, Ace-low [Q♦ T♣ 7♥ A♠] []
-	// Result:   Player 6 wins with Queen, Ten, Seven, Ace-low
+	// Player 1: [6♠ K♥ A♣ 8♣] Three-card badugi: K-6-A [K♥ 6♠ A♣] [8♣]
+	// Player 2: [Q♥ 4♥ J♣ 5♥] Two-card badugi: J-4 [J♣ 4♥] [Q♥ 5♥]
+	// Player 3: [2♣ 6♥ 5♣ Q♠] Three-card badugi: Q-6-2 [Q♠ 6♥ 2♣] [5♣]
+	// Player 4: [9♠ J♥ K♠ J♠] Two-card badugi: J-9 [J♥ 9♠] [K♠ J♠]
+	// Player 5: [3♦ 4♦ K♣ 8♦] Two-card badugi: K-3 [K♣ 3♦] [8♦ 4♦]
+	// Player 6: [T♣ Q♦ A♠ 7♥] Badugi: Q-T-7-A [Q♦ T♣ 7♥ A♠] []
+	// Result:   Player 6 wins with Badugi: Q-T-7-A
 	// ------ Badugi 5 ------
-	// Player 1: [3♦ 4♦ 5♦ J♣] Jack, Three-low [J♣ 3♦] [5♦ 4♦]
-	// Player 2: [T♥ J♠ K♠ 2♣] Jack, Ten, Two-low [J♠ T♥ 2♣] [K♠]
-	// Player 3: [A♣ 9♠ T♠ 3♠] Three, Ace-low [3♠ A♣] [T♠ 9♠]
-	// Player 4: [7♦ 3♣ 8♠ 7♣] Eight, Seven, Three-low [8♠ 7♦ 3♣] [7♣]
-	// Player 5: [5♣ Q♠ J♥ 2♠] Jack, Five, Two-low [J♥ 5♣ 2♠] [Q♠]
-	// Player 6: [6♠ 7♠ 7♥ 2♥] Six, Two-low [6♠ 2♥] [7♥ 7♠]
-	// Result:   Player 4 wins with Eight, Seven, Three-low
+	// Player 1: [3♦ 4♦ 5♦ J♣] Two-card badugi: J-3 [J♣ 3♦] [5♦ 4♦]
+	// Player 2: [T♥ J♠ K♠ 2♣] Three-card badugi: J-T-2 [J♠ T♥ 2♣] [K♠]
+	// Player 3: [A♣ 9♠ T♠ 3♠] Two-card badugi: 3-A [3♠ A♣] [T♠ 9♠]
+	// Player 4: [7♦ 3♣ 8♠ 7♣] Three-card badugi: 8-7-3 [8♠ 7♦ 3♣] [7♣]
+	// Player 5: [5♣ Q♠ J♥ 2♠] Three-card badugi: J-5-2 [J♥ 5♣ 2♠] [Q♠]
+	// Player 6: [6♠ 7♠ 7♥ 2♥] Two-card badugi: 6-2 [6♠ 2♥] [7♥ 7♠]
+	// Result:   Player 4 wins with Three-card badugi: 8-7-3
 }
 
 func ExampleOddsCalc() {
@@ -0,0 +1,282 @@
+package cardrank
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Made is the made hand strength of a 2 card pocket on a board, relative to
+// the board (ie, a pair using only board cards is [MadeNothing]).
+type Made uint8
+
+// Made hands.
+const (
+	// MadeNothing is no made hand using the pocket.
+	MadeNothing Made = iota
+	// MadeWeakPair is a pair below top pair, including underpairs.
+	MadeWeakPair
+	// MadeTopPair is a pair with the board's highest card.
+	MadeTopPair
+	// MadeOverPair is a pocket pair higher than the board's highest card.
+	MadeOverPair
+	// MadeTwoPair is two pair, with both pairs using the pocket.
+	MadeTwoPair
+	// MadeThreeOfAKind is three of a kind using the pocket.
+	MadeThreeOfAKind
+	// MadeStraight is a straight.
+	MadeStraight
+	// MadeFlush is a flush.
+	MadeFlush
+	// MadeFullHouse is a full house.
+	MadeFullHouse
+	// MadeFourOfAKind is four of a kind.
+	MadeFourOfAKind
+	// MadeStraightFlush is a straight flush.
+	MadeStraightFlush
+)
+
+// String satisfies the [fmt.Stringer] interface.
+func (made Made) String() string {
+	switch made {
+	case MadeNothing:
+		return "Nothing"
+	case MadeWeakPair:
+		return "Weak Pair"
+	case MadeTopPair:
+		return "Top Pair"
+	case MadeOverPair:
+		return "Over Pair"
+	case MadeTwoPair:
+		return "Two Pair"
+	case MadeThreeOfAKind:
+		return "Three of a Kind"
+	case MadeStraight:
+		return "Straight"
+	case MadeFlush:
+		return "Flush"
+	case MadeFullHouse:
+		return "Full House"
+	case MadeFourOfAKind:
+		return "Four of a Kind"
+	case MadeStraightFlush:
+		return "Straight Flush"
+	}
+	return fmt.Sprintf("Made(%d)", uint8(made))
+}
+
+// Made returns the combo's made hand on the board for the type, which must
+// have 2 card pockets and a Cactus eval (see [Type.Cactus]). Returns
+// [MadeNothing] when the board has less than 3 cards, the combo is blocked by
+// the board, or the type is not supported.
+//
+// Hands of a straight or better are [MadeNothing] when the pocket does not
+// improve the hand (ie, playing the board).
+func (c Combo) Made(typ Type, board []Card) Made {
+	if len(board) < 3 || typ.Pocket() != 2 || !typ.Cactus() || c.Blocked(deadMap(board)) {
+		return MadeNothing
+	}
+	// evaluate as a single hand, as incomplete boards are otherwise ranked
+	// by pocket only
+	rank := typ.cactusRank(typ.Eval(slices.Concat(c[:], board), nil).HiRank)
+	top := slices.MaxFunc(board, func(a, b Card) int {
+		return int(a.Rank()) - int(b.Rank())
+	}).Rank()
+	// pair returns the made hand for a pair of the rank
+	pair := func(r Rank) Made {
+		switch {
+		case c.Pair() && top < r:
+			return MadeOverPair
+		case !c.Pair() && r == top:
+			return MadeTopPair
+		}
+		return MadeWeakPair
+	}
+	counts := rankCounts(c[:], board)
+	pocket := func(r Rank) bool {
+		return c[0].Rank() == r || c[1].Rank() == r
+	}
+	switch cat := rank.Fixed(); cat {
+	case Nothing:
+		return MadeNothing
+	case Pair, TwoPair:
+		var pairs []Rank
+		for i := Ace.Index(); 0 <= i; i-- {
+			if r := Rank(i); counts[i] == 2 && pocket(r) {
+				pairs = append(pairs, r)
+			}
+		}
+		switch {
+		case len(pairs) == 0:
+			return MadeNothing
+		case len(pairs) == 1 || c.Pair():
+			return pair(pairs[0])
+		}
+		return MadeTwoPair
+	case ThreeOfAKind:
+		for i := range counts {
+			if counts[i] == 3 && pocket(Rank(i)) {
+				return MadeThreeOfAKind
+			}
+		}
+		return MadeNothing
+	default:
+		if 5 <= len(board) && typ.Eval(board, nil).HiRank == typ.Eval(slices.Concat(c[:], board), nil).HiRank {
+			return MadeNothing
+		}
+		switch cat {
+		case Straight:
+			return MadeStraight
+		case Flush:
+			return MadeFlush
+		case FullHouse:
+			return MadeFullHouse
+		case FourOfAKind:
+			return MadeFourOfAKind
+		}
+		return MadeStraightFlush
+	}
+}
+
+// Draws is a bit mask of drawing hands.
+type Draws uint8
+
+// Draws.
+const (
+	// FlushDraw is a flush draw (ie, 4 cards of a suit, using the pocket).
+	FlushDraw Draws = 1 << iota
+	// OpenEndedDraw is an open-ended (or double gutshot) straight draw.
+	OpenEndedDraw
+	// GutshotDraw is an inside (gutshot) straight draw.
+	GutshotDraw
+)
+
+// Draws returns the combo's draws on the board, using a French deck. Returns
+// no draws when the board does not have 3 or 4 cards, or the combo is blocked
+// by the board.
+func (c Combo) Draws(board []Card) Draws {
+	if len(board) < 3 || 4 < len(board) || c.Blocked(deadMap(board)) {
+		return 0
+	}
+	var draws Draws
+	// flush
+	var suits [4]int
+	for _, card := range slices.Concat(c[:], board) {
+		suits[card.Suit().Index()]++
+	}
+	for _, card := range c {
+		if suits[card.Suit().Index()] == 4 {
+			draws |= FlushDraw
+		}
+	}
+	// straight
+	hand, b := rankMask(c[:]...)|rankMask(board...), rankMask(board...)
+	if !straightMask(hand) {
+		var outs int
+		for r := range 13 {
+			if bit := uint16(1) << r; hand&bit == 0 && straightMask(hand|bit) && !straightMask(b|bit) {
+				outs++
+			}
+		}
+		switch {
+		case 2 <= outs:
+			draws |= OpenEndedDraw
+		case outs == 1:
+			draws |= GutshotDraw
+		}
+	}
+	return draws
+}
+
+// ComboFilter is a range filter for combos.
+type ComboFilter func(Combo) bool
+
+// Filter returns the combos in the range having a non-zero weight matching
+// all the filters. Useful for conditioning the range used in equity
+// calculations (ie, "if villain has top pair or better").
+func (r Range) Filter(filters ...ComboFilter) Range {
+	u := make(Range)
+loop:
+	for c, w := range r {
+		if w == 0 {
+			continue
+		}
+		for _, f := range filters {
+			if !f(c) {
+				continue loop
+			}
+		}
+		u[c] = w
+	}
+	return u
+}
+
+// AtLeast returns a combo filter matching combos having at least the made
+// hand on the board for the type. See [Combo.Made].
+func AtLeast(typ Type, board []Card, made Made) ComboFilter {
+	return func(c Combo) bool {
+		return made <= c.Made(typ, board)
+	}
+}
+
+// HasDraw returns a combo filter matching combos having any of the draws on
+// the board. See [Combo.Draws].
+func HasDraw(board []Card, draws Draws) ComboFilter {
+	return func(c Combo) bool {
+		return c.Draws(board)&draws != 0
+	}
+}
+
+// MissedDraw returns a combo filter matching combos that had a flush or
+// straight draw on the flop or turn, but have no made hand on the completed
+// board for the type.
+func MissedDraw(typ Type, board []Card) ComboFilter {
+	return func(c Combo) bool {
+		if len(board) < 5 || c.Made(typ, board) != MadeNothing {
+			return false
+		}
+		return c.Draws(board[:3]) != 0 || c.Draws(board[:4]) != 0
+	}
+}
+
+// Not returns a combo filter matching combos not matching the filter.
+func Not(f ComboFilter) ComboFilter {
+	return func(c Combo) bool {
+		return !f(c)
+	}
+}
+
+// rankCounts returns the count of each rank in the cards.
+func rankCounts(v ...[]Card) [13]int {
+	var counts [13]int
+	for _, cards := range v {
+		for _, c := range cards {
+			counts[c.Rank().Index()]++
+		}
+	}
+	return counts
+}
+
+// rankMask returns the bit mask of the card ranks.
+func rankMask(v ...Card) uint16 {
+	var mask uint16
+	for _, c := range v {
+		mask |= 1 << c.Rank().Index()
+	}
+	return mask
+}
+
+// straightMask returns true when the rank bit mask contains a straight,
+// including the wheel (A-2-3-4-5).
+func straightMask(mask uint16) bool {
+	// shift so the wheel's ace is below the two
+	v := uint32(mask) << 1
+	if mask&(1<<Ace.Index()) != 0 {
+		v |= 1
+	}
+	for i := range 10 {
+		if m := uint32(0x1f) << i; v&m == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package cardrank
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestComboMade(t *testing.T) {
+	tests := []struct {
+		typ   Type
+		combo string
+		board string
+		exp   Made
+	}{
+		{Holdem, "Ah Kd", "Ks 7h 2d", MadeTopPair},
+		{Holdem, "As Ad", "Ks 7h 2d", MadeOverPair},
+		{Holdem, "8c 8d", "Ks 7h 2d", MadeWeakPair},
+		{Holdem, "Ac 7d", "Ks 7h 2d", MadeWeakPair},
+		{Holdem, "Kc 7c", "Ks 7h 2d", MadeTwoPair},
+		{Holdem, "7c 7d", "Ks 7h 2d", MadeThreeOfAKind},
+		{Holdem, "Qh Jh", "Ks 7h 2d", MadeNothing},
+		{Holdem, "As Ad", "Ks Kd 7h", MadeOverPair},
+		{Holdem, "Ah Qh", "Ks Kd 7h", MadeNothing},
+		{Holdem, "7c 7d", "Ks Kd 7h", MadeFullHouse},
+		{Holdem, "Kc 2d", "Ks Kd 7h", MadeThreeOfAKind},
+		{Holdem, "2c 3d", "9s Td Jh Qc Kc", MadeNothing},
+		{Holdem, "Ah 2c", "9s Td Jh Qc Kc", MadeStraight},
+		{Holdem, "Ac 2c", "9c Tc Jh Qc Kd", MadeFlush},
+		{Holdem, "Ac 2c", "Ks 7h", MadeNothing},
+		{Holdem, "Ks Ad", "Ks 7h 2d", MadeNothing},
+		{Short, "Ah Kd", "Ks 7h 6d", MadeTopPair},
+		{Omaha, "Ah Kd", "Ks 7h 2d", MadeNothing},
+		{Badugi, "Ah Kd", "Ks 7h 2d", MadeNothing},
+	}
+	for i, test := range tests {
+		v := Must(test.combo)
+		if made := NewCombo(v[0], v[1]).Made(test.typ, Must(test.board)); made != test.exp {
+			t.Errorf("test %d %s %s expected %s, got: %s", i, test.combo, test.board, test.exp, made)
+		}
+	}
+}
+
+func TestComboDraws(t *testing.T) {
+	tests := []struct {
+		combo string
+		board string
+		exp   Draws
+	}{
+		{"Jh Th", "9h 8h 2c", FlushDraw | OpenEndedDraw},
+		{"Th 6c", "9h 8h 2c", GutshotDraw},
+		{"Ah 2h", "9h 8h 2c", FlushDraw},
+		{"Ac 2d", "3h 4s Kc", GutshotDraw},
+		{"Ac Kd", "Qh Js 2c", GutshotDraw},
+		{"Tc 7d", "9h 8s 2c", OpenEndedDraw},
+		{"Tc 6d", "9h 8s 7c", 0},
+		{"Ac 2d", "5h 6s 7c 8d", 0},
+		{"Jh Th", "9h 8h 2c 3s Kd", 0},
+		{"Jh Th", "9h 8h", 0},
+	}
+	for i, test := range tests {
+		v := Must(test.combo)
+		if draws := NewCombo(v[0], v[1]).Draws(Must(test.board)); draws != test.exp {
+			t.Errorf("test %d %s %s expected %d, got: %d", i, test.combo, test.board, test.exp, draws)
+		}
+	}
+}
+
+func TestRangeFilter(t *testing.T) {
+	r, err := NewRange("AA", "KK", "AK", "QJs", "72o")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	board := Must("Ks 7h 2d")
+	// AA, KK sets, AK top pair, and 72o two pair
+	u := r.Filter(AtLeast(Holdem, board, MadeTopPair))
+	if n, exp := u.Count(board), 6.0+3+12+7; n != exp {
+		t.Errorf("expected %f, got: %f", exp, n)
+	}
+	u = r.Filter(Not(AtLeast(Holdem, board, MadeWeakPair)))
+	if n, exp := u.Count(board), 4.0; n != exp {
+		t.Errorf("expected %f, got: %f", exp, n)
+	}
+	// missed draws on the river
+	r, err = NewRange("JTs", "AKs", "65s")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	board = Must("9h 8h 2c 3s Kd")
+	u = r.Filter(MissedDraw(Holdem, board))
+	// JTs (4) and 65s (4) missed, AhKh has top pair
+	if n, exp := u.Count(board), 8.0; n != exp {
+		t.Errorf("expected %f, got: %f", exp, n)
+	}
+	if !u.Contains(NewCombo(New(Jack, Heart), New(Ten, Heart))) || u.Contains(NewCombo(New(Ace, Heart), New(King, Heart))) {
+		t.Errorf("expected JhTh and not AhKh, got: %v", u.Combos())
+	}
+	if u := r.Filter(HasDraw(board[:3], FlushDraw)); u.Count(board[:3]) != 3 {
+		t.Errorf("expected 3 flush draws, got: %v", u.Combos())
+	}
+	// conditional equity
+	board = Must("Ks 7h 2d 3c 4s")
+	r = FullRange(DeckFrench)
+	g, err := EquityGrid(Holdem, r.Filter(AtLeast(Holdem, board, MadeTopPair)), board, 0, rand.New(rand.NewPCG(1, 0)))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if e := g.Get("AK"); 0.5 < e {
+		t.Errorf("expected AK equity less than 0.5 against top pair or better, got: %f", e)
+	}
+}
@@ -0,0 +1,137 @@
+package gametree
+
+import (
+	"slices"
+)
+
+// BestResponse returns the best response for the player against the
+// strategy played by the opponent, and the best response's expected value
+// for the player.
+//
+// The best response is a pure strategy, choosing the action with the highest
+// expected value in each of the player's information sets, weighted by the
+// probability of the opponent's strategy and chance reaching each of the
+// information set's nodes. The strategy must return the same probabilities
+// for each node of an information set. The returned strategy is only defined
+// for the player's decision nodes, and is [Uniform] for other nodes.
+func (t *Tree) BestResponse(s Strategy, player int) (Strategy, float64) {
+	br := newBestResponse(t, s, player)
+	v := br.value(t.Root)
+	actions := br.actions
+	return func(n *Node) []float64 {
+		i, ok := actions[n.InfoSet]
+		if !ok || n.Kind != Decision || n.Player != player {
+			return nil
+		}
+		probs := make([]float64, len(n.Children))
+		probs[i] = 1
+		return probs
+	}, v
+}
+
+// Exploitability returns the exploitability of the strategy, the average
+// amount best responses for each player win against the strategy. The
+// strategy is used for both players, and is a Nash equilibrium when the
+// exploitability is 0.
+//
+// Exploitability is commonly used to measure the convergence of strategies
+// trained by counterfactual regret minimization (CFR).
+func (t *Tree) Exploitability(s Strategy) float64 {
+	_, v0 := t.BestResponse(s, 0)
+	_, v1 := t.BestResponse(s, 1)
+	return (v0 + v1) / 2
+}
+
+// bestResponse calculates a best response.
+type bestResponse struct {
+	s        Strategy
+	player   int
+	infosets map[string][]*Node
+	reach    map[*Node]float64
+	values   map[*Node]float64
+	actions  map[string]int
+}
+
+// newBestResponse creates a best response for the player against the
+// strategy, calculating the opponent and chance reach probabilities of each
+// of the player's decision nodes.
+func newBestResponse(t *Tree, s Strategy, player int) *bestResponse {
+	br := &bestResponse{
+		s:        s,
+		player:   player,
+		infosets: make(map[string][]*Node),
+		reach:    make(map[*Node]float64),
+		values:   make(map[*Node]float64),
+		actions:  make(map[string]int),
+	}
+	br.walk(t.Root, 1)
+	return br
+}
+
+// walk walks the node with the opponent and chance reach probability.
+func (br *bestResponse) walk(n *Node, reach float64) {
+	var probs []float64
+	switch {
+	case n.Kind == Chance:
+		probs = n.Probs
+	case n.Kind == Decision && n.Player == br.player:
+		br.infosets[n.InfoSet] = append(br.infosets[n.InfoSet], n)
+		br.reach[n] = reach
+	case n.Kind == Decision:
+		probs = normalize(br.s(n), len(n.Children))
+	}
+	for i, c := range n.Children {
+		p := reach
+		if probs != nil {
+			p *= probs[i]
+		}
+		br.walk(c, p)
+	}
+}
+
+// value returns the node's expected value for the player, when the player
+// plays the best response.
+func (br *bestResponse) value(n *Node) float64 {
+	if v, ok := br.values[n]; ok {
+		return v
+	}
+	var v float64
+	switch {
+	case n.Kind == Terminal:
+		v = n.Payoffs[br.player]
+	case n.Kind == Chance:
+		for i, c := range n.Children {
+			v += n.Probs[i] * br.value(c)
+		}
+	case n.Player == br.player:
+		v = br.value(n.Children[br.action(n.InfoSet)])
+	default:
+		probs := normalize(br.s(n), len(n.Children))
+		for i, c := range n.Children {
+			if probs[i] != 0 {
+				v += probs[i] * br.value(c)
+			}
+		}
+	}
+	br.values[n] = v
+	return v
+}
+
+// action returns the best response action for the information set.
+func (br *bestResponse) action(infoset string) int {
+	if i, ok := br.actions[infoset]; ok {
+		return i
+	}
+	nodes := br.infosets[infoset]
+	v := make([]float64, len(nodes[0].Children))
+	for _, n := range nodes {
+		if reach := br.reach[n]; reach != 0 {
+			for i, c := range n.Children {
+				v[i] += reach * br.value(c)
+			}
+		}
+	}
+	i := slices.Index(v, slices.Max(v))
+	br.actions[infoset] = i
+	return i
+}
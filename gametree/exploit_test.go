@@ -0,0 +1,59 @@
+package gametree
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestBestResponse(t *testing.T) {
+	tests := []struct {
+		typ cardrank.Type
+		s   Strategy
+		exp [2]float64
+	}{
+		{cardrank.Kuhn, kuhnNash, [2]float64{-1.0 / 18, 1.0 / 18}},
+		{cardrank.Kuhn, Uniform, [2]float64{0.5, 5.0 / 12}},
+		{cardrank.Kuhn, alwaysBet, [2]float64{1.0 / 3, 1.0 / 3}},
+		{cardrank.Leduc, Uniform, [2]float64{167.0 / 80, 383.0 / 144}},
+	}
+	for i, test := range tests {
+		tree, err := New(test.typ)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		for player := range 2 {
+			br, v := tree.BestResponse(test.s, player)
+			if math.Abs(v-test.exp[player]) > 1e-9 {
+				t.Errorf("test %d player %d expected %f, got: %f", i, player, test.exp[player], v)
+			}
+			strategies := [2]Strategy{test.s, test.s}
+			strategies[player] = br
+			if exp := exact(tree.Root, strategies, player); math.Abs(v-exp) > 1e-9 {
+				t.Errorf("test %d player %d expected %f, got: %f", i, player, exp, v)
+			}
+		}
+	}
+}
+
+func TestExploitability(t *testing.T) {
+	tests := []struct {
+		typ cardrank.Type
+		s   Strategy
+		exp float64
+	}{
+		{cardrank.Kuhn, kuhnNash, 0},
+		{cardrank.Kuhn, Uniform, 11.0 / 24},
+		{cardrank.Leduc, Uniform, 4.747222222222222 / 2},
+	}
+	for i, test := range tests {
+		tree, err := New(test.typ)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if v := tree.Exploitability(test.s); math.Abs(v-test.exp) > 1e-9 {
+			t.Errorf("test %d expected %f, got: %f", i, test.exp, v)
+		}
+	}
+}
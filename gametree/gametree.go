@@ -0,0 +1,406 @@
+// Package gametree builds extensive-form game trees for the [cardrank.Kuhn]
+// and [cardrank.Leduc] research games, for use by game solvers (such as CFR)
+// and for teaching.
+//
+// Trees contain chance nodes dealing cards from the type's deck, decision
+// nodes for each player's betting actions, and terminal nodes with payoffs
+// determined by folds or by the type's eval at showdown. Strategies can be
+// played against each other (see [Tree.Simulate]), and measured by their
+// exploitability (see [Tree.Exploitability]).
+package gametree
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Kind is a node kind.
+type Kind uint8
+
+// Node kinds.
+const (
+	// Chance is a chance node, dealing a card.
+	Chance Kind = iota
+	// Decision is a player decision node.
+	Decision
+	// Terminal is a terminal node, having payoffs.
+	Terminal
+)
+
+// String satisfies the [fmt.Stringer] interface.
+func (kind Kind) String() string {
+	switch kind {
+	case Chance:
+		return "Chance"
+	case Decision:
+		return "Decision"
+	case Terminal:
+		return "Terminal"
+	}
+	return fmt.Sprintf("Kind(%d)", int(kind))
+}
+
+// Actions. [cardrank.Kuhn] uses [Pass] and [Bet], and [cardrank.Leduc] uses
+// [Fold], [Call], and [Raise] (see [cardrank.InfoSetKey]).
+const (
+	// Pass is a [cardrank.Kuhn] check or fold.
+	Pass byte = 'p'
+	// Bet is a [cardrank.Kuhn] bet or call.
+	Bet byte = 'b'
+	// Fold is a [cardrank.Leduc] fold.
+	Fold byte = 'f'
+	// Call is a [cardrank.Leduc] check or call.
+	Call byte = 'c'
+	// Raise is a [cardrank.Leduc] bet or raise.
+	Raise byte = 'r'
+)
+
+// Node is a game tree node.
+type Node struct {
+	// Kind is the node kind.
+	Kind Kind
+	// Player is the acting player of a decision node.
+	Player int
+	// History is the action history, with betting rounds separated by a '/'.
+	History string
+	// Pockets are the dealt pockets.
+	Pockets [][]cardrank.Card
+	// Board is the dealt board.
+	Board []cardrank.Card
+	// Pot is each player's contribution to the pot.
+	Pot []float64
+	// InfoSet is the acting player's information set key of a decision node
+	// (see [cardrank.InfoSetKey]).
+	InfoSet string
+	// Actions are the actions of a decision node, one per child.
+	Actions []byte
+	// Cards are the dealt cards of a chance node, one per child.
+	Cards []cardrank.Card
+	// Probs are the probabilities of a chance node's children.
+	Probs []float64
+	// Payoffs are each player's payoff of a terminal node.
+	Payoffs []float64
+	// Children are the child nodes.
+	Children []*Node
+}
+
+// Child returns the child for the action or card, or nil when not found.
+func (n *Node) Child(v string) *Node {
+	switch n.Kind {
+	case Decision:
+		if len(v) == 1 {
+			if i := slices.Index(n.Actions, v[0]); i != -1 {
+				return n.Children[i]
+			}
+		}
+	case Chance:
+		if c := cardrank.FromString(v); c != cardrank.InvalidCard {
+			if i := slices.Index(n.Cards, c); i != -1 {
+				return n.Children[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Tree is a game tree.
+type Tree struct {
+	// Type is the game type.
+	Type cardrank.Type
+	// Root is the root node.
+	Root *Node
+}
+
+// New builds the game tree for the type, returning [cardrank.ErrInvalidType]
+// when the type is not [cardrank.Kuhn] or [cardrank.Leduc].
+//
+// [cardrank.Kuhn] defaults to an ante of 1, a bet of 1, and a single bet per
+// round. [cardrank.Leduc] defaults to an ante of 1, bets of 2 and 4 for the
+// first and second rounds, and up to 2 bets (a bet and a raise) per round.
+func New(typ cardrank.Type, opts ...Option) (*Tree, error) {
+	b := &builder{
+		typ: typ,
+	}
+	switch typ {
+	case cardrank.Kuhn:
+		b.ante, b.bets, b.raises = 1, []float64{1}, 1
+	case cardrank.Leduc:
+		b.ante, b.bets, b.raises = 1, []float64{2, 4}, 2
+	default:
+		return nil, cardrank.ErrInvalidType
+	}
+	for _, o := range opts {
+		o(b)
+	}
+	switch {
+	case len(b.bets) != len(typ.Streets()):
+		return nil, fmt.Errorf("%s: expected %d bet sizes, got: %d", typ, len(typ.Streets()), len(b.bets))
+	case b.raises < 0:
+		return nil, fmt.Errorf("%s: invalid raises %d", typ, b.raises)
+	}
+	b.deck = typ.DeckType().Unshuffled()
+	return &Tree{
+		Type: typ,
+		Root: b.deal(state{pot: []float64{b.ante, b.ante}}),
+	}, nil
+}
+
+// Walk walks the tree depth-first, calling f for each node. Children are not
+// walked when f returns false.
+func (t *Tree) Walk(f func(*Node) bool) {
+	walk(t.Root, f)
+}
+
+// walk walks the node depth-first.
+func walk(n *Node, f func(*Node) bool) {
+	if !f(n) {
+		return
+	}
+	for _, c := range n.Children {
+		walk(c, f)
+	}
+}
+
+// Len returns the number of nodes in the tree.
+func (t *Tree) Len() int {
+	var count int
+	t.Walk(func(*Node) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// InfoSets returns the tree's decision nodes grouped by information set key.
+func (t *Tree) InfoSets() map[string][]*Node {
+	m := make(map[string][]*Node)
+	t.Walk(func(n *Node) bool {
+		if n.Kind == Decision {
+			m[n.InfoSet] = append(m[n.InfoSet], n)
+		}
+		return true
+	})
+	return m
+}
+
+// Option is a game tree option.
+type Option func(*builder)
+
+// WithAnte is a game tree option to set the ante posted by each player.
+func WithAnte(ante float64) Option {
+	return func(b *builder) {
+		b.ante = ante
+	}
+}
+
+// WithBets is a game tree option to set the bet size for each betting round.
+func WithBets(bets ...float64) Option {
+	return func(b *builder) {
+		b.bets = bets
+	}
+}
+
+// WithRaises is a game tree option to set the maximum number of bets (the
+// initial bet and any raises) per betting round.
+func WithRaises(raises int) Option {
+	return func(b *builder) {
+		b.raises = raises
+	}
+}
+
+// state is a game state.
+type state struct {
+	pockets [][]cardrank.Card
+	board   []cardrank.Card
+	history string
+	pot     []float64
+}
+
+// used returns the cards used by the state.
+func (s state) used() []cardrank.Card {
+	var v []cardrank.Card
+	for _, pocket := range s.pockets {
+		v = append(v, pocket...)
+	}
+	return append(v, s.board...)
+}
+
+// node creates a node for the state.
+func (s state) node(kind Kind) *Node {
+	return &Node{
+		Kind:    kind,
+		History: s.history,
+		Pockets: s.pockets,
+		Board:   s.board,
+		Pot:     s.pot,
+	}
+}
+
+// builder builds game trees.
+type builder struct {
+	typ    cardrank.Type
+	ante   float64
+	bets   []float64
+	raises int
+	deck   []cardrank.Card
+}
+
+// deal creates a chance node dealing the next pocket or board card.
+func (b *builder) deal(s state) *Node {
+	n := s.node(Chance)
+	used := s.used()
+	for _, c := range b.deck {
+		if slices.Contains(used, c) {
+			continue
+		}
+		next := s
+		switch {
+		case len(s.pockets) < 2:
+			next.pockets = append(slices.Clone(s.pockets), []cardrank.Card{c})
+		default:
+			next.board = append(slices.Clone(s.board), c)
+		}
+		var child *Node
+		switch {
+		case len(next.pockets) < 2:
+			child = b.deal(next)
+		default:
+			child = b.round(next, 0, 0, false, 0)
+		}
+		n.Cards = append(n.Cards, c)
+		n.Children = append(n.Children, child)
+	}
+	for range n.Children {
+		n.Probs = append(n.Probs, 1/float64(len(n.Children)))
+	}
+	return n
+}
+
+// round creates a decision node for the player, with the number of bets made
+// in the round, whether the player is facing a bet, and the number of
+// actions taken in the round.
+func (b *builder) round(s state, player, raises int, facing bool, acted int) *Node {
+	n := s.node(Decision)
+	n.Player = player
+	var err error
+	if n.InfoSet, err = cardrank.InfoSetKey(b.typ, s.pockets[player], s.board, s.history); err != nil {
+		panic(err)
+	}
+	kuhn, other := b.typ == cardrank.Kuhn, 1-player
+	add := func(action byte, child *Node) {
+		n.Actions = append(n.Actions, action)
+		n.Children = append(n.Children, child)
+	}
+	next := func(action byte, pot float64) state {
+		v := slices.Clone(s.pot)
+		v[player] = pot
+		return state{
+			pockets: s.pockets,
+			board:   s.board,
+			history: s.history + string(action),
+			pot:     v,
+		}
+	}
+	bet := s.pot[other] + b.bets[len(s.board)]
+	switch {
+	case facing && kuhn:
+		add(Pass, b.fold(next(Pass, s.pot[player]), player))
+		add(Bet, b.end(next(Bet, s.pot[other])))
+	case facing:
+		add(Fold, b.fold(next(Fold, s.pot[player]), player))
+		add(Call, b.end(next(Call, s.pot[other])))
+		if raises < b.raises {
+			add(Raise, b.round(next(Raise, bet), other, raises+1, true, acted+1))
+		}
+	default:
+		check := Call
+		if kuhn {
+			check = Pass
+		}
+		if v := next(check, s.pot[player]); 0 < acted {
+			add(check, b.end(v))
+		} else {
+			add(check, b.round(v, other, raises, false, acted+1))
+		}
+		if raises < b.raises {
+			action := Raise
+			if kuhn {
+				action = Bet
+			}
+			add(action, b.round(next(action, bet), other, raises+1, true, acted+1))
+		}
+	}
+	return n
+}
+
+// end ends the betting round, dealing the board or creating a showdown node.
+func (b *builder) end(s state) *Node {
+	if len(s.board) < len(b.bets)-1 {
+		s.history += "/"
+		return b.deal(s)
+	}
+	n := s.node(Terminal)
+	n.Payoffs = make([]float64, 2)
+	ev0, ev1 := b.typ.Eval(s.pockets[0], s.board), b.typ.Eval(s.pockets[1], s.board)
+	switch c := ev0.Comp(ev1, false); {
+	case c < 0:
+		n.Payoffs[0], n.Payoffs[1] = s.pot[1], -s.pot[1]
+	case c > 0:
+		n.Payoffs[0], n.Payoffs[1] = -s.pot[0], s.pot[0]
+	}
+	return n
+}
+
+// fold creates a terminal node for the folding player.
+func (b *builder) fold(s state, player int) *Node {
+	n := s.node(Terminal)
+	n.Payoffs = make([]float64, 2)
+	n.Payoffs[player], n.Payoffs[1-player] = -s.pot[player], s.pot[player]
+	return n
+}
+
+// Format satisfies the [fmt.Formatter] interface, writing the tree with one
+// node per line, indented by depth.
+//
+// Supported verbs:
+//
+//	s - tree
+//	v - same as s
+func (t *Tree) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'v':
+		var sb strings.Builder
+		format(&sb, t.Root, "", 0)
+		_, _ = f.Write([]byte(sb.String()))
+	default:
+		fmt.Fprintf(f, "%%!%c(ERROR=unknown verb, tree)", verb)
+	}
+}
+
+// format writes the node and its children to sb.
+func format(sb *strings.Builder, n *Node, edge string, depth int) {
+	sb.WriteString(strings.Repeat("  ", depth))
+	if edge != "" {
+		sb.WriteString(edge + " ")
+	}
+	switch n.Kind {
+	case Chance:
+		fmt.Fprintf(sb, "chance %v %v\n", n.Pockets, n.Board)
+	case Decision:
+		fmt.Fprintf(sb, "player %d %s\n", n.Player, n.InfoSet)
+	case Terminal:
+		fmt.Fprintf(sb, "terminal %q %v\n", n.History, n.Payoffs)
+	}
+	for i, c := range n.Children {
+		switch n.Kind {
+		case Chance:
+			edge = n.Cards[i].String()
+		default:
+			edge = string(n.Actions[i])
+		}
+		format(sb, c, edge, depth+1)
+	}
+}
@@ -0,0 +1,156 @@
+package gametree
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		typ      cardrank.Type
+		opts     []Option
+		nodes    int
+		infosets int
+	}{
+		{cardrank.Kuhn, nil, 58, 12},
+		{cardrank.Leduc, nil, 9457, 288},
+		{cardrank.Leduc, []Option{WithRaises(1)}, 3517, 120},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.Name(), func(t *testing.T) {
+			tree, err := New(test.typ, test.opts...)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if n := tree.Len(); n != test.nodes {
+				t.Errorf("expected %d nodes, got: %d", test.nodes, n)
+			}
+			if n := len(tree.InfoSets()); n != test.infosets {
+				t.Errorf("expected %d info sets, got: %d", test.infosets, n)
+			}
+			tree.Walk(func(n *Node) bool {
+				switch n.Kind {
+				case Chance:
+					var sum float64
+					for _, p := range n.Probs {
+						sum += p
+					}
+					if len(n.Probs) != len(n.Children) || math.Abs(sum-1) > 1e-9 {
+						t.Errorf("%q expected probabilities summing to 1, got: %v", n.History, n.Probs)
+					}
+				case Decision:
+					if len(n.Actions) != len(n.Children) || len(n.Children) == 0 {
+						t.Errorf("%q expected actions for each child", n.History)
+					}
+				case Terminal:
+					if n.Payoffs[0]+n.Payoffs[1] != 0 {
+						t.Errorf("%q expected zero sum payoffs, got: %v", n.History, n.Payoffs)
+					}
+				}
+				return true
+			})
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	if _, err := New(cardrank.Holdem); err != cardrank.ErrInvalidType {
+		t.Errorf("expected %v, got: %v", cardrank.ErrInvalidType, err)
+	}
+	if _, err := New(cardrank.Leduc, WithBets(1)); err == nil {
+		t.Errorf("expected error")
+	}
+	if _, err := New(cardrank.Kuhn, WithRaises(-1)); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestKuhnValue(t *testing.T) {
+	tree, err := New(cardrank.Kuhn)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// a Nash equilibrium, having a game value of -1/18 for the first player
+	strategy := map[string]float64{
+		"J:": 0, "Q:": 0, "K:": 0,
+		"J:pb": 0, "Q:pb": 1.0 / 3, "K:pb": 1,
+		"J:p": 1.0 / 3, "Q:p": 0, "K:p": 1,
+		"J:b": 0, "Q:b": 1.0 / 3, "K:b": 1,
+	}
+	var value func(*Node) float64
+	value = func(n *Node) float64 {
+		switch n.Kind {
+		case Chance:
+			var v float64
+			for i, c := range n.Children {
+				v += n.Probs[i] * value(c)
+			}
+			return v
+		case Decision:
+			p := strategy[n.InfoSet]
+			return (1-p)*value(n.Child("p")) + p*value(n.Child("b"))
+		}
+		return n.Payoffs[0]
+	}
+	if v, exp := value(tree.Root), -1.0/18; math.Abs(v-exp) > 1e-9 {
+		t.Errorf("expected %f, got: %f", exp, v)
+	}
+}
+
+func TestLeducPayoffs(t *testing.T) {
+	tree, err := New(cardrank.Leduc)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tests := []struct {
+		path []string
+		exp  []float64
+	}{
+		{[]string{"Ks", "Qs", "f"}, nil},
+		{[]string{"Ks", "Qs", "r", "f"}, []float64{1, -1}},
+		{[]string{"Ks", "Qs", "r", "r", "f"}, []float64{-3, 3}},
+		{[]string{"Ks", "Qs", "c", "c", "Qh", "r", "c"}, []float64{-5, 5}},
+		{[]string{"Ks", "Qs", "r", "c", "Jh", "r", "r", "c"}, []float64{11, -11}},
+		{[]string{"Ks", "Kh", "c", "c", "Js", "c", "c"}, []float64{0, 0}},
+	}
+	for i, test := range tests {
+		n := tree.Root
+		for _, v := range test.path {
+			if n = n.Child(v); n == nil {
+				break
+			}
+		}
+		switch {
+		case test.exp == nil && n != nil:
+			t.Errorf("test %d expected no node", i)
+		case test.exp == nil:
+		case n == nil || n.Kind != Terminal:
+			t.Errorf("test %d expected terminal node", i)
+		case n.Payoffs[0] != test.exp[0] || n.Payoffs[1] != test.exp[1]:
+			t.Errorf("test %d expected %v, got: %v", i, test.exp, n.Payoffs)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tree, err := New(cardrank.Kuhn)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	s := fmt.Sprintf("%s", tree)
+	for _, exp := range []string{
+		"chance [] []\n",
+		"  Ks chance [[Ks]] []\n",
+		"    Qs player 0 K:\n",
+		"      p player 1 Q:p\n",
+		`        p terminal "pp" [1 -1]`,
+	} {
+		if !strings.Contains(s, exp) {
+			t.Errorf("expected %q in:\n%s", exp, s)
+		}
+	}
+}
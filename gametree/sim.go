@@ -0,0 +1,195 @@
+package gametree
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Strategy returns a player's action probabilities for a decision node, one
+// per action. Probabilities are normalized, and are treated as uniform when
+// nil, of the wrong length, or summing to 0.
+type Strategy func(n *Node) []float64
+
+// Uniform is a strategy that chooses each action with equal probability.
+func Uniform(n *Node) []float64 {
+	return nil
+}
+
+// SimResult is a strategy simulation result.
+type SimResult struct {
+	// N is the number of deals.
+	N int
+	// EV is the first strategy's expected value per deal. The second
+	// strategy's expected value is -EV.
+	EV float64
+	// StdErr is the standard error of EV.
+	StdErr float64
+}
+
+// Interval returns the confidence interval of EV for the z-score (ie, 1.96
+// for a 95% confidence interval).
+func (res SimResult) Interval(z float64) (float64, float64) {
+	return res.EV - z*res.StdErr, res.EV + z*res.StdErr
+}
+
+// Format satisfies the [fmt.Formatter] interface.
+//
+// Supported verbs:
+//
+//	s - EV with 95% confidence interval
+//	v - same as s
+func (res SimResult) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'v':
+		fmt.Fprintf(f, "%.4f ± %.4f (n=%d)", res.EV, 1.96*res.StdErr, res.N)
+	default:
+		fmt.Fprintf(f, "%%!%c(ERROR=unknown verb, sim result)", verb)
+	}
+}
+
+// Simulate plays the strategies against each other over the specified number
+// of random deals, returning the first strategy's expected value.
+//
+// By default, each deal is played twice with the same cards and with the
+// strategies swapping seats (ie, duplicate deals), and the strategies'
+// action probabilities are used to calculate the exact expected value of
+// each deal instead of sampling actions, removing luck from both the deal
+// and the strategies' own randomization (a simplified form of AIVAT). See
+// [WithDuplicate] and [WithSampling]. Without duplicate deals, the first
+// strategy is always seated as the first player.
+func (t *Tree) Simulate(a, b Strategy, deals int, opts ...SimOption) SimResult {
+	sim := &simulator{
+		seed:      1,
+		duplicate: true,
+	}
+	for _, o := range opts {
+		o(sim)
+	}
+	r := sim.r
+	if r == nil {
+		r = cardrank.NewRNG(sim.seed)
+	}
+	var sum, sum2 float64
+	for range deals {
+		sim.u = sim.u[:0]
+		v := sim.play(r, t.Root, [2]Strategy{a, b}, 0, 0)
+		if sim.duplicate {
+			v = (v + sim.play(r, t.Root, [2]Strategy{b, a}, 1, 0)) / 2
+		}
+		sum, sum2 = sum+v, sum2+v*v
+	}
+	res := SimResult{
+		N: deals,
+	}
+	if deals != 0 {
+		n := float64(deals)
+		res.EV = sum / n
+		if 1 < deals {
+			res.StdErr = math.Sqrt(max(0, sum2-sum*sum/n) / (n - 1) / n)
+		}
+	}
+	return res
+}
+
+// simulator plays strategies.
+type simulator struct {
+	seed      uint64
+	r         cardrank.Rand
+	duplicate bool
+	sampling  bool
+	u         []float64
+}
+
+// play plays the node with the strategies for each seat, returning the
+// payoff for the player seated at seat. Chance outcomes are drawn from the
+// shared uniform values, such that duplicate plays receive the same cards.
+func (sim *simulator) play(r cardrank.Rand, n *Node, strategies [2]Strategy, seat, chance int) float64 {
+	switch n.Kind {
+	case Chance:
+		if len(sim.u) <= chance {
+			sim.u = append(sim.u, r.Float64())
+		}
+		return sim.play(r, n.Children[pick(n.Probs, sim.u[chance])], strategies, seat, chance+1)
+	case Decision:
+		probs := normalize(strategies[n.Player](n), len(n.Children))
+		if sim.sampling {
+			return sim.play(r, n.Children[pick(probs, r.Float64())], strategies, seat, chance)
+		}
+		var v float64
+		for i, c := range n.Children {
+			if probs[i] != 0 {
+				v += probs[i] * sim.play(r, c, strategies, seat, chance)
+			}
+		}
+		return v
+	}
+	return n.Payoffs[seat]
+}
+
+// pick returns the index for the uniform value u in [0, 1).
+func pick(probs []float64, u float64) int {
+	for i, p := range probs {
+		if u < p {
+			return i
+		}
+		u -= p
+	}
+	return len(probs) - 1
+}
+
+// normalize normalizes the probabilities.
+func normalize(probs []float64, n int) []float64 {
+	var sum float64
+	for _, p := range probs {
+		sum += max(p, 0)
+	}
+	v := make([]float64, n)
+	for i := range v {
+		switch {
+		case len(probs) != n || sum == 0:
+			v[i] = 1 / float64(n)
+		default:
+			v[i] = max(probs[i], 0) / sum
+		}
+	}
+	return v
+}
+
+// SimOption is a strategy simulation option.
+type SimOption func(*simulator)
+
+// WithSeed is a strategy simulation option to set the random seed. Defaults
+// to 1.
+func WithSeed(seed uint64) SimOption {
+	return func(sim *simulator) {
+		sim.seed = seed
+	}
+}
+
+// WithRand is a strategy simulation option to set the random source,
+// overriding the random seed. See [cardrank.RNG] for reproducible random
+// sources.
+func WithRand(r cardrank.Rand) SimOption {
+	return func(sim *simulator) {
+		sim.r = r
+	}
+}
+
+// WithDuplicate is a strategy simulation option to set whether each deal is
+// played twice with the strategies swapping seats. Defaults to true.
+func WithDuplicate(duplicate bool) SimOption {
+	return func(sim *simulator) {
+		sim.duplicate = duplicate
+	}
+}
+
+// WithSampling is a strategy simulation option to set whether actions are
+// sampled from the strategies' action probabilities, instead of calculating
+// the expected value over all actions. Defaults to false.
+func WithSampling(sampling bool) SimOption {
+	return func(sim *simulator) {
+		sim.sampling = sampling
+	}
+}
@@ -0,0 +1,105 @@
+package gametree
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+// kuhnNash is a Kuhn Nash equilibrium strategy.
+func kuhnNash(n *Node) []float64 {
+	p := map[string]float64{
+		"Q:pb": 1.0 / 3, "K:pb": 1,
+		"J:p": 1.0 / 3, "K:p": 1,
+		"Q:b": 1.0 / 3, "K:b": 1,
+	}[n.InfoSet]
+	return []float64{1 - p, p}
+}
+
+// alwaysBet is a Kuhn strategy that always bets or calls.
+func alwaysBet(n *Node) []float64 {
+	return []float64{0, 1}
+}
+
+// exact returns the exact expected value for the player seated at seat.
+func exact(n *Node, strategies [2]Strategy, seat int) float64 {
+	var v float64
+	switch n.Kind {
+	case Chance:
+		for i, c := range n.Children {
+			v += n.Probs[i] * exact(c, strategies, seat)
+		}
+	case Decision:
+		probs := normalize(strategies[n.Player](n), len(n.Children))
+		for i, c := range n.Children {
+			v += probs[i] * exact(c, strategies, seat)
+		}
+	default:
+		v = n.Payoffs[seat]
+	}
+	return v
+}
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		typ  cardrank.Type
+		a, b Strategy
+	}{
+		{cardrank.Kuhn, kuhnNash, Uniform},
+		{cardrank.Kuhn, kuhnNash, alwaysBet},
+		{cardrank.Kuhn, Uniform, alwaysBet},
+		{cardrank.Leduc, Uniform, alwaysBet},
+	}
+	for i, test := range tests {
+		tree, err := New(test.typ)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		first := exact(tree.Root, [2]Strategy{test.a, test.b}, 0)
+		dupe := (first + exact(tree.Root, [2]Strategy{test.b, test.a}, 1)) / 2
+		for j, opt := range []struct {
+			exp  float64
+			opts []SimOption
+		}{
+			{dupe, nil},
+			{first, []SimOption{WithDuplicate(false)}},
+			{dupe, []SimOption{WithSampling(true)}},
+			{first, []SimOption{WithDuplicate(false), WithSampling(true), WithSeed(3)}},
+		} {
+			exp, res := opt.exp, tree.Simulate(test.a, test.b, 4000, opt.opts...)
+			if lo, hi := res.Interval(4); exp < lo || hi < exp {
+				t.Errorf("test %d/%d expected %f, got: %s", i, j, exp, res)
+			}
+		}
+	}
+}
+
+func TestSimulateVariance(t *testing.T) {
+	tree, err := New(cardrank.Kuhn)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// identical strategies are exactly even with duplicate deals
+	if res := tree.Simulate(kuhnNash, kuhnNash, 1000); res.EV != 0 || res.StdErr != 0 {
+		t.Errorf("expected 0, got: %s", res)
+	}
+	reduced := tree.Simulate(kuhnNash, Uniform, 1000)
+	sampled := tree.Simulate(kuhnNash, Uniform, 1000, WithDuplicate(false), WithSampling(true))
+	if sampled.StdErr <= reduced.StdErr {
+		t.Errorf("expected %f < %f", reduced.StdErr, sampled.StdErr)
+	}
+	if res := tree.Simulate(kuhnNash, Uniform, 1000); res != reduced {
+		t.Errorf("expected %v, got: %v", reduced, res)
+	}
+	if res := tree.Simulate(kuhnNash, Uniform, 1000, WithRand(cardrank.NewRNG(1))); res != reduced {
+		t.Errorf("expected %v, got: %v", reduced, res)
+	}
+	if s, exp := fmt.Sprintf("%s", SimResult{N: 10, EV: 0.5, StdErr: 0.1}), "0.5000 ± 0.1960 (n=10)"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if lo, hi := (SimResult{EV: 1, StdErr: 0.5}).Interval(2); math.Abs(lo) > 1e-9 || math.Abs(hi-2) > 1e-9 {
+		t.Errorf("expected 0 2, got: %f %f", lo, hi)
+	}
+}
@@ -0,0 +1,148 @@
+package cardrank
+
+import (
+	"sort"
+)
+
+// Rand is the interface for a random source. Compatible with math/rand.Rand
+// and math/rand/v2.Rand.
+type Rand interface {
+	Shuffler
+	Float64() float64
+}
+
+// HandGen generates random pockets and boards uniformly from a deck's
+// remaining cards after removing dead cards, for use with Monte Carlo
+// simulations.
+type HandGen struct {
+	r    Rand
+	u    []Card
+	dead map[Card]bool
+	// Tries is the maximum number of attempts made by [HandGen.DealRanges]
+	// to draw non-conflicting pockets.
+	Tries int
+}
+
+// NewHandGen creates a random hand generator for the deck type, using the
+// random source and excluding the dead cards.
+func NewHandGen(typ DeckType, r Rand, dead ...[]Card) *HandGen {
+	return &HandGen{
+		r:     r,
+		u:     typ.Exclude(dead...),
+		dead:  deadMap(dead...),
+		Tries: 1000,
+	}
+}
+
+// Remaining returns the number of cards available to the generator.
+func (g *HandGen) Remaining() int {
+	return len(g.u)
+}
+
+// Cards returns n random cards from the remaining cards, or nil when there are
+// not enough remaining cards.
+func (g *HandGen) Cards(n int) []Card {
+	return g.draw(g.u, n)
+}
+
+// Deal returns count random pockets of n cards each, and a random board of
+// board cards, drawn without replacement from the remaining cards. Returns
+// nil when there are not enough remaining cards.
+func (g *HandGen) Deal(count, n, board int) ([][]Card, []Card) {
+	v := g.draw(g.u, count*n+board)
+	if v == nil {
+		return nil, nil
+	}
+	pockets := make([][]Card, count)
+	for i := range count {
+		pockets[i] = v[i*n : (i+1)*n : (i+1)*n]
+	}
+	return pockets, v[count*n:]
+}
+
+// DealRanges returns a random pocket drawn from each of the ranges, weighted
+// by the combos' weights, and a random board of board cards drawn from the
+// remaining cards.
+//
+// Pockets are drawn independently from each range, excluding combos blocked
+// by the dead cards, and redrawn when any pockets share a card (ie, rejection
+// sampling), up to [HandGen.Tries] times. Returns [ErrEmptyRange] when a range
+// has no available combos, or [ErrRangeConflict] when non-conflicting pockets
+// could not be drawn.
+func (g *HandGen) DealRanges(ranges []Range, board int) ([][]Card, []Card, error) {
+	samplers := make([]*rangeSampler, len(ranges))
+	for i, r := range ranges {
+		if samplers[i] = newRangeSampler(r, g.dead); samplers[i] == nil {
+			return nil, nil, ErrEmptyRange
+		}
+	}
+	pockets := make([][]Card, len(ranges))
+	used := make(map[Card]bool, 2*len(ranges))
+	for range max(1, g.Tries) {
+		clear(used)
+		ok := true
+		for i, s := range samplers {
+			c := s.sample(g.r.Float64())
+			if used[c[0]] || used[c[1]] {
+				ok = false
+				break
+			}
+			used[c[0]], used[c[1]] = true, true
+			pockets[i] = c.Cards()
+		}
+		if !ok {
+			continue
+		}
+		v := g.draw(Exclude(g.u, pockets...), board)
+		if v == nil && board != 0 {
+			return nil, nil, ErrRangeConflict
+		}
+		return pockets, v, nil
+	}
+	return nil, nil, ErrRangeConflict
+}
+
+// draw returns n random cards from v.
+func (g *HandGen) draw(v []Card, n int) []Card {
+	if n < 0 || len(v) < n {
+		return nil
+	}
+	d := make([]Card, len(v))
+	copy(d, v)
+	g.r.Shuffle(len(d), func(i, j int) {
+		d[i], d[j] = d[j], d[i]
+	})
+	return d[:n:n]
+}
+
+// rangeSampler samples weighted combos from a range.
+type rangeSampler struct {
+	v     []Combo
+	cum   []float64
+	total float64
+}
+
+// newRangeSampler creates a range sampler for the combos in the range not
+// blocked by dead cards. Returns nil when there are no combos available.
+func newRangeSampler(r Range, dead map[Card]bool) *rangeSampler {
+	s := new(rangeSampler)
+	for _, c := range r.Combos() {
+		if w := r[c]; 0 < w && !c.Blocked(dead) {
+			s.total += w
+			s.v, s.cum = append(s.v, c), append(s.cum, s.total)
+		}
+	}
+	if len(s.v) == 0 {
+		return nil
+	}
+	return s
+}
+
+// sample returns the combo for f, a random value in [0, 1).
+func (s *rangeSampler) sample(f float64) Combo {
+	i := sort.SearchFloat64s(s.cum, f*s.total)
+	for i < len(s.v)-1 && s.cum[i] <= f*s.total {
+		i++
+	}
+	return s.v[min(i, len(s.v)-1)]
+}
@@ -0,0 +1,93 @@
+package cardrank
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestHandGen(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	dead := Must("As Ks Qh")
+	g := NewHandGen(DeckFrench, r, dead)
+	if n, exp := g.Remaining(), 49; n != exp {
+		t.Fatalf("expected %d, got: %d", exp, n)
+	}
+	counts := make(map[Card]int)
+	for range 10000 {
+		pockets, board := g.Deal(3, 2, 5)
+		if len(pockets) != 3 || len(board) != 5 {
+			t.Fatalf("expected 3 pockets and 5 board cards, got: %v %v", pockets, board)
+		}
+		seen := make(map[Card]bool)
+		for _, c := range append(append(append([]Card{}, pockets[0]...), append(pockets[1], pockets[2]...)...), board...) {
+			if seen[c] {
+				t.Fatalf("duplicate card %s", c)
+			}
+			if slices.Contains(dead, c) {
+				t.Fatalf("dead card %s dealt", c)
+			}
+			seen[c] = true
+			counts[c]++
+		}
+	}
+	// each remaining card is dealt with probability 11/49
+	for c, n := range counts {
+		if exp := 10000 * 11 / 49; n < exp*8/10 || exp*12/10 < n {
+			t.Errorf("expected %s to be dealt ~%d times, got: %d", c, exp, n)
+		}
+	}
+	if n := len(counts); n != 49 {
+		t.Errorf("expected 49 distinct cards, got: %d", n)
+	}
+	if v := g.Cards(50); v != nil {
+		t.Errorf("expected nil, got: %v", v)
+	}
+	if pockets, board := g.Deal(10, 5, 0); pockets != nil || board != nil {
+		t.Errorf("expected nil, got: %v %v", pockets, board)
+	}
+}
+
+func TestHandGenRanges(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	g := NewHandGen(DeckFrench, r, Must("Ah"))
+	r0, _ := NewRange("AA")
+	r1, _ := NewRange("AKs", "KK")
+	r1[NewCombo(New(King, Spade), New(King, Heart))] = 10
+	counts := make(map[string]int)
+	for range 5000 {
+		pockets, board, err := g.DealRanges([]Range{r0, r1}, 5)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(board) != 5 {
+			t.Fatalf("expected 5 board cards, got: %d", len(board))
+		}
+		if pockets[0][0].Rank() != Ace || pockets[0][1].Rank() != Ace {
+			t.Fatalf("expected AA, got: %v", pockets[0])
+		}
+		for _, c := range append(append([]Card{}, pockets[0]...), append(pockets[1], board...)...) {
+			if c == New(Ace, Heart) {
+				t.Fatalf("dead card dealt")
+			}
+		}
+		if pockets[1][0] == pockets[0][0] || pockets[1][0] == pockets[0][1] || pockets[1][1] == pockets[0][0] || pockets[1][1] == pockets[0][1] {
+			t.Fatalf("conflicting pockets %v %v", pockets[0], pockets[1])
+		}
+		counts[HashKey(pockets[1][0], pockets[1][1])]++
+	}
+	// KsKh is weighted 10x, so KK should dominate
+	if counts["KK"] < 3*counts["AKs"] {
+		t.Errorf("expected KK to be weighted, got: %v", counts)
+	}
+	empty, _ := NewRange("AA")
+	g = NewHandGen(DeckFrench, r, Must("As Ah Ad"))
+	if _, _, err := g.DealRanges([]Range{empty}, 0); !errors.Is(err, ErrEmptyRange) {
+		t.Errorf("expected %v, got: %v", ErrEmptyRange, err)
+	}
+	g = NewHandGen(DeckFrench, r, Must("As Ah"))
+	if _, _, err := g.DealRanges([]Range{empty, empty}, 0); !errors.Is(err, ErrRangeConflict) {
+		t.Errorf("expected %v, got: %v", ErrRangeConflict, err)
+	}
+}
@@ -0,0 +1,602 @@
+package cardrank
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// GobEncode satisfies the [encoding/gob.GobEncoder] interface.
+func (c Card) GobEncode() ([]byte, error) {
+	return []byte{gobCard(c)}, nil
+}
+
+// GobDecode satisfies the [encoding/gob.GobDecoder] interface.
+func (c *Card) GobDecode(buf []byte) error {
+	d := &gobDecoder{buf: buf}
+	*c = d.card()
+	return d.done()
+}
+
+// GobEncode satisfies the [encoding/gob.GobEncoder] interface.
+func (d *Deck) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode satisfies the [encoding/gob.GobDecoder] interface.
+func (d *Deck) GobDecode(buf []byte) error {
+	return d.UnmarshalBinary(buf)
+}
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface. The deck
+// is encoded as a header containing the deck's position, limit, and card
+// count, followed by a single byte per card (see [Card.MarshalBinary]).
+func (d *Deck) MarshalBinary() ([]byte, error) {
+	return gobAppendDeck(nil, d), nil
+}
+
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+// Returns [ErrInvalidData] when the deck's position or limit is out of range,
+// or when the deck's cards are invalid or not unique.
+func (d *Deck) UnmarshalBinary(buf []byte) error {
+	dec := &gobDecoder{buf: buf}
+	deck := dec.deck()
+	if err := dec.done(); err != nil {
+		return err
+	}
+	d.i, d.l, d.v = deck.i, deck.l, deck.v
+	return nil
+}
+
+// GobEncode satisfies the [encoding/gob.GobEncoder] interface.
+func (ev *Eval) GobEncode() ([]byte, error) {
+	return gobAppendEval(nil, ev), nil
+}
+
+// GobDecode satisfies the [encoding/gob.GobDecoder] interface.
+func (ev *Eval) GobDecode(buf []byte) error {
+	d := &gobDecoder{buf: buf}
+	d.eval(ev)
+	return d.done()
+}
+
+// GobEncode satisfies the [encoding/gob.GobEncoder] interface.
+func (run *Run) GobEncode() ([]byte, error) {
+	return run.MarshalBinary()
+}
+
+// GobDecode satisfies the [encoding/gob.GobDecoder] interface.
+func (run *Run) GobDecode(buf []byte) error {
+	return run.UnmarshalBinary(buf)
+}
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface. The run's
+// discards, pockets, face up pocket cards, and Hi and Lo boards are encoded
+// as a length followed by a single byte per card (see [Card.MarshalBinary]),
+// followed by each discard's street, reason, and position.
+func (run *Run) MarshalBinary() ([]byte, error) {
+	return gobAppendRun(nil, run), nil
+}
+
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+func (run *Run) UnmarshalBinary(buf []byte) error {
+	d := &gobDecoder{buf: buf}
+	r := d.run()
+	if err := d.done(); err != nil {
+		return err
+	}
+	*run = *r
+	return nil
+}
+
+// GobEncode satisfies the [encoding/gob.GobEncoder] interface.
+func (res *Result) GobEncode() ([]byte, error) {
+	return gobAppendResult(nil, res), nil
+}
+
+// GobDecode satisfies the [encoding/gob.GobDecoder] interface.
+func (res *Result) GobDecode(buf []byte) error {
+	d := &gobDecoder{buf: buf}
+	r := d.result()
+	if err := d.done(); err != nil {
+		return err
+	}
+	*res = *r
+	return nil
+}
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface, allowing
+// a dealer's state to be persisted between requests. The dealer's type, deck
+// (see [Deck.MarshalBinary]), positions, runs (see [Run.MarshalBinary]),
+// results, and street and run state are encoded. The dealer's type must be
+// registered. The deck's audit log is not encoded.
+func (d *Dealer) MarshalBinary() ([]byte, error) {
+	switch _, ok := descs[d.Type]; {
+	case !ok:
+		return nil, ErrInvalidType
+	case d.Deck == nil:
+		return nil, ErrInvalidData
+	}
+	buf := binary.BigEndian.AppendUint16(nil, uint16(d.Type))
+	buf = binary.AppendUvarint(buf, uint64(d.Count))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(d.Active))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(d.drawn))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(d.drew))
+	for _, i := range [...]int{d.runs, d.st, d.s, d.r, d.e, d.exposed} {
+		buf = binary.AppendVarint(buf, int64(i))
+	}
+	buf = gobAppendDeck(buf, d.Deck)
+	buf = binary.AppendUvarint(buf, uint64(len(d.Runs)))
+	for _, run := range d.Runs {
+		buf = gobAppendRun(buf, run)
+	}
+	buf = binary.AppendUvarint(buf, gobLen(d.Results == nil, len(d.Results)))
+	for _, res := range d.Results {
+		buf = gobAppendResult(buf, res)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+// Returns [ErrInvalidType] when the encoded type is not registered, or
+// [ErrInvalidData] when the encoded positions, street and run state, runs, or
+// results are inconsistent with the type and each other.
+func (d *Dealer) UnmarshalBinary(buf []byte) error {
+	dec := &gobDecoder{buf: buf}
+	desc, ok := descs[Type(dec.uint16())]
+	if !ok && dec.err == nil {
+		return ErrInvalidType
+	}
+	r := &Dealer{
+		TypeDesc: desc,
+		Count:    dec.int(),
+		Active:   Positions(dec.uint64()),
+		drawn:    Positions(dec.uint64()),
+		drew:     Positions(dec.uint64()),
+	}
+	for _, i := range [...]*int{&r.runs, &r.st, &r.s, &r.r, &r.e, &r.exposed} {
+		*i = dec.varint()
+	}
+	r.Deck = dec.deck()
+	n := dec.int()
+	if dec.err == nil && (n != r.runs || len(dec.buf) < n) {
+		return ErrInvalidData
+	}
+	r.Runs = make([]*Run, n)
+	for i := range n {
+		if r.Runs[i] = dec.run(); dec.err == nil && len(r.Runs[i].Pockets) != r.Count {
+			return ErrInvalidData
+		}
+	}
+	if n, ok := dec.len(); ok {
+		r.Results = make([]*Result, n)
+		for i := range n {
+			r.Results[i] = dec.result()
+		}
+	}
+	if err := dec.done(); err != nil {
+		return err
+	}
+	if !r.validState() {
+		return ErrInvalidData
+	}
+	*d = *r
+	return nil
+}
+
+// validState returns true when the decoded dealer's positions, street and run
+// state, runs, and results are in range.
+func (d *Dealer) validState() bool {
+	if d.Count < 0 || 64 < d.Count {
+		return false
+	}
+	mask := AllPositions
+	if d.Count < 64 {
+		mask = Positions(1)<<d.Count - 1
+	}
+	n := len(d.Streets)
+	switch {
+	case d.Active&^mask != 0, d.drawn&^mask != 0, d.drew&^mask != 0,
+		d.runs < 1,
+		d.st < -1, n <= d.st,
+		d.s < -1, n < d.s,
+		d.r < -1, d.runs <= d.r,
+		(d.s == -1) != (d.r == -1),
+		d.e < -1, d.runs < d.e,
+		d.exposed < 0, len(d.Runs[0].Discard) < d.exposed,
+		d.Results != nil && len(d.Results) != d.runs:
+		return false
+	}
+	for _, run := range d.Runs {
+		if run.Up != nil && len(run.Up) != d.Count || !d.validSeq(run) {
+			return false
+		}
+	}
+	return true
+}
+
+// validSeq returns true when the run's sequential double board state matches
+// the board cards remaining to be dealt.
+func (d *Dealer) validSeq(run *Run) bool {
+	if !run.drawn {
+		return len(run.seq[0]) == 0 && len(run.seq[1]) == 0
+	}
+	if !d.Double || d.BoardOrder != BoardSequential {
+		return false
+	}
+	// find the next street dealing board cards
+	street, board := 0, 0
+	for ; street < len(d.Streets) && board+d.Streets[street].Board <= len(run.Hi); street++ {
+		board += d.Streets[street].Board
+	}
+	exp := d.seqCount(street)
+	return len(run.seq[0]) == exp[0] && len(run.seq[1]) == exp[1]
+}
+
+// gobInvalid is the encoded value of an invalid card.
+const gobInvalid = 0xff
+
+// gobCard returns the encoded card.
+func gobCard(c Card) byte {
+	if !c.Valid() {
+		return gobInvalid
+	}
+	return byte(c.Index())
+}
+
+// gobLen returns the encoded length, distinguishing nil from empty.
+func gobLen(isNil bool, n int) uint64 {
+	if isNil {
+		return 0
+	}
+	return uint64(n) + 1
+}
+
+// gobAppendCards appends the encoded cards to buf.
+func gobAppendCards(buf []byte, v []Card) []byte {
+	buf = binary.AppendUvarint(buf, gobLen(v == nil, len(v)))
+	for _, c := range v {
+		buf = append(buf, gobCard(c))
+	}
+	return buf
+}
+
+// gobAppendDeck appends the encoded deck to buf.
+func gobAppendDeck(buf []byte, d *Deck) []byte {
+	buf = binary.AppendUvarint(buf, uint64(d.i))
+	buf = binary.AppendUvarint(buf, uint64(d.l))
+	return gobAppendCards(buf, d.v)
+}
+
+// gobAppendRun appends the encoded run to buf.
+func gobAppendRun(buf []byte, run *Run) []byte {
+	buf = gobAppendCards(buf, run.Discard)
+	buf = binary.AppendUvarint(buf, gobLen(run.Pockets == nil, len(run.Pockets)))
+	for _, pocket := range run.Pockets {
+		buf = gobAppendCards(buf, pocket)
+	}
+	buf = binary.AppendUvarint(buf, gobLen(run.Up == nil, len(run.Up)))
+	for _, up := range run.Up {
+		buf = gobAppendCards(buf, up)
+	}
+	buf = gobAppendCards(buf, run.Hi)
+	buf = gobAppendCards(buf, run.Lo)
+	// sequential double board state
+	var drawn byte
+	if run.drawn {
+		drawn = 1
+	}
+	buf = gobAppendCards(append(buf, drawn), run.seq[0])
+	buf = gobAppendCards(buf, run.seq[1])
+	// discard street and reasons
+	buf = binary.AppendUvarint(buf, gobLen(run.Discards == nil, len(run.Discards)))
+	for _, discard := range run.Discards {
+		buf = append(buf, gobCard(discard.Card))
+		buf = binary.AppendVarint(buf, int64(discard.Street))
+		buf = append(buf, byte(discard.Reason))
+		buf = binary.AppendVarint(buf, int64(discard.Position))
+	}
+	return buf
+}
+
+// gobAppendResult appends the encoded result to buf.
+func gobAppendResult(buf []byte, res *Result) []byte {
+	buf = binary.AppendUvarint(buf, gobLen(res.Evals == nil, len(res.Evals)))
+	for _, ev := range res.Evals {
+		if ev == nil {
+			buf = append(buf, 0)
+			continue
+		}
+		buf = gobAppendEval(append(buf, 1), ev)
+	}
+	buf = gobAppendInts(buf, res.HiOrder)
+	buf = binary.AppendUvarint(buf, uint64(res.HiPivot))
+	buf = gobAppendInts(buf, res.LoOrder)
+	return binary.AppendUvarint(buf, uint64(res.LoPivot))
+}
+
+// gobAppendInts appends the encoded ints to buf.
+func gobAppendInts(buf []byte, v []int) []byte {
+	buf = binary.AppendUvarint(buf, gobLen(v == nil, len(v)))
+	for _, i := range v {
+		buf = binary.AppendVarint(buf, int64(i))
+	}
+	return buf
+}
+
+// gobAppendEval appends the encoded eval to buf.
+func gobAppendEval(buf []byte, ev *Eval) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, uint16(ev.Type))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(ev.HiRank))
+	buf = gobAppendCards(buf, ev.HiBest)
+	buf = gobAppendCards(buf, ev.HiUnused)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(ev.LoRank))
+	buf = gobAppendCards(buf, ev.LoBest)
+	return gobAppendCards(buf, ev.LoUnused)
+}
+
+// gobDecoder decodes gob data, recording the first error encountered.
+type gobDecoder struct {
+	buf []byte
+	err error
+}
+
+// done returns the decode error, if any, or an error when there is
+// remaining data.
+func (d *gobDecoder) done() error {
+	if d.err == nil && len(d.buf) != 0 {
+		d.err = ErrInvalidData
+	}
+	return d.err
+}
+
+// byte decodes a byte.
+func (d *gobDecoder) byte() byte {
+	if d.err != nil || len(d.buf) == 0 {
+		d.err = ErrInvalidData
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+// uint16 decodes a uint16.
+func (d *gobDecoder) uint16() uint16 {
+	if d.err != nil || len(d.buf) < 2 {
+		d.err = ErrInvalidData
+		return 0
+	}
+	v := binary.BigEndian.Uint16(d.buf)
+	d.buf = d.buf[2:]
+	return v
+}
+
+// uint64 decodes a uint64.
+func (d *gobDecoder) uint64() uint64 {
+	if d.err != nil || len(d.buf) < 8 {
+		d.err = ErrInvalidData
+		return 0
+	}
+	v := binary.BigEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v
+}
+
+// uvarint decodes a uvarint.
+func (d *gobDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = ErrInvalidData
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+// sum decodes a SHA-256 sum.
+func (d *gobDecoder) sum() [sha256.Size]byte {
+	var v [sha256.Size]byte
+	if d.err != nil || len(d.buf) < sha256.Size {
+		d.err = ErrInvalidData
+		return v
+	}
+	copy(v[:], d.buf)
+	d.buf = d.buf[sha256.Size:]
+	return v
+}
+
+// int decodes a non-negative int.
+func (d *gobDecoder) int() int {
+	return int(d.uvarint())
+}
+
+// varint decodes a varint as an int.
+func (d *gobDecoder) varint() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = ErrInvalidData
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return int(v)
+}
+
+// len decodes an encoded length, returning false when nil.
+func (d *gobDecoder) len() (int, bool) {
+	switch n := d.uvarint(); {
+	case n == 0:
+		return 0, false
+	case uint64(len(d.buf)) < n-1:
+		d.err = ErrInvalidData
+		return 0, false
+	default:
+		return int(n - 1), true
+	}
+}
+
+// bytes decodes a length prefixed byte slice, returning a slice of the
+// decoder's buffer.
+func (d *gobDecoder) bytes() []byte {
+	n := d.int()
+	if d.err != nil || len(d.buf) < n {
+		d.err = ErrInvalidData
+		return nil
+	}
+	v := d.buf[:n:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+// string decodes a string.
+func (d *gobDecoder) string() string {
+	return string(d.bytes())
+}
+
+// card decodes a card.
+func (d *gobDecoder) card() Card {
+	switch b := d.byte(); {
+	case d.err != nil:
+		return InvalidCard
+	case b == gobInvalid:
+		return InvalidCard
+	case 52 <= b:
+		d.err = ErrInvalidCard
+		return InvalidCard
+	default:
+		return FromIndex(int(b))
+	}
+}
+
+// cards decodes cards.
+func (d *gobDecoder) cards() []Card {
+	n, ok := d.len()
+	if !ok {
+		return nil
+	}
+	v := make([]Card, n)
+	for i := range n {
+		v[i] = d.card()
+	}
+	return v
+}
+
+// ints decodes ints.
+func (d *gobDecoder) ints() []int {
+	n, ok := d.len()
+	if !ok {
+		return nil
+	}
+	v := make([]int, n)
+	for i := range n {
+		if v[i] = d.varint(); d.err != nil {
+			return nil
+		}
+	}
+	return v
+}
+
+// deck decodes a deck, checking the deck's position and limit are within the
+// deck's cards, and that the cards are valid and appear no more times than
+// the number of decks in a shoe of the same size.
+func (d *gobDecoder) deck() *Deck {
+	i, l, v := d.int(), d.int(), d.cards()
+	if d.err != nil {
+		return &Deck{}
+	}
+	if i < 0 || l < i || len(v) < l {
+		d.err = ErrInvalidData
+		return &Deck{}
+	}
+	var seen [52]int
+	n := (len(v) + 51) / 52
+	for _, c := range v {
+		if !c.Valid() {
+			d.err = ErrInvalidData
+			return &Deck{}
+		}
+		if seen[c.Index()]++; n < seen[c.Index()] {
+			d.err = ErrInvalidData
+			return &Deck{}
+		}
+	}
+	return &Deck{i: i, l: l, v: v}
+}
+
+// run decodes a run.
+func (d *gobDecoder) run() *Run {
+	r := new(Run)
+	r.Discard = d.cards()
+	if n, ok := d.len(); ok {
+		r.Pockets = make([][]Card, n)
+		for i := range n {
+			r.Pockets[i] = d.cards()
+		}
+	}
+	if n, ok := d.len(); ok {
+		r.Up = make([][]Card, n)
+		for i := range n {
+			r.Up[i] = d.cards()
+		}
+	}
+	r.Hi, r.Lo = d.cards(), d.cards()
+	r.drawn = d.byte() != 0
+	r.seq[0], r.seq[1] = d.cards(), d.cards()
+	if n, ok := d.len(); ok {
+		r.Discards = make([]Discard, n)
+		for i := range n {
+			r.Discards[i] = Discard{
+				Card:     d.card(),
+				Street:   d.varint(),
+				Reason:   DiscardReason(d.byte()),
+				Position: d.varint(),
+			}
+		}
+	}
+	return r
+}
+
+// result decodes a result.
+func (d *gobDecoder) result() *Result {
+	r := new(Result)
+	if n, ok := d.len(); ok {
+		r.Evals = make([]*Eval, n)
+		for i := range n {
+			if d.byte() != 0 {
+				r.Evals[i] = new(Eval)
+				d.eval(r.Evals[i])
+			}
+		}
+	}
+	r.HiOrder, r.HiPivot = d.ints(), d.int()
+	r.LoOrder, r.LoPivot = d.ints(), d.int()
+	// check pivots and positions are in range
+	for _, v := range [...]struct {
+		order []int
+		pivot int
+	}{{r.HiOrder, r.HiPivot}, {r.LoOrder, r.LoPivot}} {
+		if d.err == nil && (v.pivot < 0 || len(v.order) < v.pivot) {
+			d.err = ErrInvalidData
+		}
+		for _, i := range v.order {
+			if d.err == nil && (i < 0 || 64 <= i) {
+				d.err = ErrInvalidData
+			}
+		}
+	}
+	return r
+}
+
+// eval decodes an eval into ev.
+func (d *gobDecoder) eval(ev *Eval) {
+	ev.Type = Type(d.uint16())
+	ev.HiRank = EvalRank(d.uint16())
+	ev.HiBest, ev.HiUnused = d.cards(), d.cards()
+	ev.LoRank = EvalRank(d.uint16())
+	ev.LoBest, ev.LoUnused = d.cards(), d.cards()
+}
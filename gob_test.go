@@ -0,0 +1,310 @@
+package cardrank
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func TestGob(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, typ := range []Type{Holdem, OmahaHiLo, OmahaDouble, Razz, Stud, Short} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(r, 1, 3)
+			d.ChangeRuns(2)
+			d.Deactivate(1)
+			for d.Next() {
+			}
+			for d.NextResult() {
+			}
+			testGob(t, d.Deck, new(Deck))
+			for _, run := range d.Runs {
+				testGob(t, run, new(Run))
+			}
+			for _, res := range d.Results {
+				testGob(t, res, new(Result))
+				for _, ev := range res.Evals {
+					if ev != nil {
+						testGob(t, ev, new(Eval))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGobCard(t *testing.T) {
+	v := []Card{Must("Ah")[0], Must("2c")[0], InvalidCard}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var u []Card
+	if err := gob.NewDecoder(&buf).Decode(&u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(v, u) {
+		t.Errorf("expected %v, got: %v", v, u)
+	}
+	var c Card
+	if err := c.GobDecode([]byte{52}); err != ErrInvalidCard {
+		t.Errorf("expected %v, got: %v", ErrInvalidCard, err)
+	}
+	var res Result
+	if err := res.GobDecode([]byte{5, 1}); err != ErrInvalidData {
+		t.Errorf("expected %v, got: %v", ErrInvalidData, err)
+	}
+}
+
+func testGob[T any](t *testing.T, v, u *T) {
+	t.Helper()
+	// compare encodings, as evals contain internal card storage
+	var a, b bytes.Buffer
+	if err := gob.NewEncoder(&a).Encode(v); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := bytes.Clone(a.Bytes())
+	if err := gob.NewDecoder(&a).Decode(u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := gob.NewEncoder(&b).Encode(u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !bytes.Equal(exp, b.Bytes()) {
+		t.Errorf("expected %#v, got: %#v", v, u)
+	}
+}
+
+func TestDealerBinary(t *testing.T) {
+	for _, typ := range []Type{Holdem, OmahaDouble, Stud, Lowball} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			for n := range len(typ.Streets()) + 1 {
+				a := typ.Dealer(rand.New(rand.NewPCG(3, 4)), 1, 3)
+				for range n {
+					a.Next()
+				}
+				if n == 2 {
+					a.ChangeRuns(2)
+				}
+				buf, err := a.MarshalBinary()
+				if err != nil {
+					t.Fatalf("n %d expected no error, got: %v", n, err)
+				}
+				b := new(Dealer)
+				if err := b.UnmarshalBinary(buf); err != nil {
+					t.Fatalf("n %d expected no error, got: %v", n, err)
+				}
+				// continue dealing both, comparing the final state
+				for ok := true; ok; {
+					ok = a.Next()
+					if b.Next() != ok {
+						t.Fatalf("n %d expected %t", n, ok)
+					}
+				}
+				for ok := true; ok; {
+					ok = a.NextResult()
+					if b.NextResult() != ok {
+						t.Fatalf("n %d expected %t", n, ok)
+					}
+				}
+				exp, err := a.MarshalBinary()
+				if err != nil {
+					t.Fatalf("n %d expected no error, got: %v", n, err)
+				}
+				if buf, err = b.MarshalBinary(); err != nil {
+					t.Fatalf("n %d expected no error, got: %v", n, err)
+				}
+				if !bytes.Equal(exp, buf) {
+					t.Errorf("n %d expected identical dealers", n)
+				}
+				testGob(t, b, new(Dealer))
+			}
+		})
+	}
+}
+
+func TestDealerBinaryInvalid(t *testing.T) {
+	d := Holdem.Dealer(rand.New(rand.NewPCG(3, 4)), 1, 3)
+	d.Next()
+	buf, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tests := []struct {
+		buf []byte
+		err error
+	}{
+		{nil, ErrInvalidData},
+		{buf[:len(buf)-1], ErrInvalidData},
+		{append(bytes.Clone(buf), 0), ErrInvalidData},
+		{append([]byte{'X', 'x'}, buf[2:]...), ErrInvalidType},
+	}
+	for i, test := range tests {
+		if err := new(Dealer).UnmarshalBinary(test.buf); err != test.err {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+	d.Deck = nil
+	if _, err := d.MarshalBinary(); err != ErrInvalidData {
+		t.Errorf("expected %v, got: %v", ErrInvalidData, err)
+	}
+}
+
+func TestDealerBinaryInvalidState(t *testing.T) {
+	tests := []func(*Dealer){
+		func(d *Dealer) { d.Count = 65 },
+		func(d *Dealer) { d.Active = d.Active.With(3) },
+		func(d *Dealer) { d.drawn = PositionsOf(5) },
+		func(d *Dealer) { d.drew = PositionsOf(63) },
+		func(d *Dealer) { d.st = len(d.Streets) },
+		func(d *Dealer) { d.st = -2 },
+		func(d *Dealer) { d.s = len(d.Streets) + 1 },
+		func(d *Dealer) { d.s = -2 },
+		func(d *Dealer) { d.r = 1 },
+		func(d *Dealer) { d.r = -1 },
+		func(d *Dealer) { d.e = 2 },
+		func(d *Dealer) { d.e = -2 },
+		func(d *Dealer) { d.exposed = -1 },
+		func(d *Dealer) { d.exposed = len(d.Runs[0].Discard) + 1 },
+		func(d *Dealer) { d.Results = []*Result{{}, {}} },
+		func(d *Dealer) { d.Runs[0].Up = make([][]Card, 1) },
+		func(d *Dealer) { d.Runs[0].drawn = true },
+		func(d *Dealer) { d.Runs[0].seq[0] = Must("Ah") },
+		func(d *Dealer) { d.Deck.i = d.Deck.l + 1 },
+		func(d *Dealer) { d.Deck.l = len(d.Deck.v) + 1 },
+		func(d *Dealer) { d.Deck.v[1] = d.Deck.v[0] },
+		func(d *Dealer) { d.Deck.v[1] = InvalidCard },
+	}
+	for i, f := range tests {
+		d := Holdem.Dealer(rand.New(rand.NewPCG(3, 4)), 1, 3)
+		d.Next()
+		f(d)
+		buf, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if err := new(Dealer).UnmarshalBinary(buf); err != ErrInvalidData {
+			t.Errorf("test %d expected %v, got: %v", i, ErrInvalidData, err)
+		}
+	}
+	// sequential double board state must match the remaining board cards
+	desc, err := NewType("Hd", Double, "Double", WithDouble(), WithBoardOrder(BoardSequential, false))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	d := NewDealer(*desc, DeckFrench.New(), 2)
+	for n := 0; d.Next(); n++ {
+		if !d.validState() {
+			t.Fatalf("street %d expected valid state", n)
+		}
+	}
+	d.Reset()
+	d.Next()
+	d.Next()
+	d.Runs[0].seq[1] = d.Runs[0].seq[1][1:]
+	if d.validState() {
+		t.Errorf("expected invalid state")
+	}
+}
+
+func TestDeckBinaryInvalid(t *testing.T) {
+	tests := [][]byte{
+		{50, 60, 3, 1, 2},
+		{3, 2, 4, 1, 2, 3},
+		{0, 3, 3, 1, 2},
+		{0, 2, 3, 1, 1},
+		{0, 2, 3, 1, gobInvalid},
+		{0, 2, 3, 1, 52},
+	}
+	for i, buf := range tests {
+		if err := new(Deck).UnmarshalBinary(buf); err == nil {
+			t.Errorf("test %d expected error", i)
+		}
+	}
+	d := new(Deck)
+	if err := d.UnmarshalBinary([]byte{1, 2, 3, 1, 2}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if v := d.Draw(5); len(v) != 1 || d.Remaining() != 0 {
+		t.Errorf("expected 1 card, got: %v", v)
+	}
+}
+
+func TestShoeBinary(t *testing.T) {
+	deck := DeckFrench.Shoe(2)
+	deck.Shuffle(rand.New(rand.NewPCG(3, 4)), 1)
+	deck.Draw(7)
+	testGob(t, deck, new(Deck))
+	buf, err := deck.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	d := new(Deck)
+	if err := d.UnmarshalBinary(buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(deck, d) {
+		t.Errorf("expected identical decks")
+	}
+	// a card appearing more times than the shoe's decks is invalid
+	d.v[2], d.v[3] = d.v[0], d.v[0]
+	if buf, err = d.MarshalBinary(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := new(Deck).UnmarshalBinary(buf); err != ErrInvalidData {
+		t.Errorf("expected %v, got: %v", ErrInvalidData, err)
+	}
+	shoe := DeckFrench.Shoe(2)
+	shoe.Shuffle(rand.New(rand.NewPCG(3, 4)), 1)
+	a := NewDealer(Holdem.Desc(), shoe, 3)
+	a.Next()
+	if buf, err = a.MarshalBinary(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	b := new(Dealer)
+	if err := b.UnmarshalBinary(buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for a.Next() {
+		if !b.Next() {
+			t.Fatalf("expected dealer to continue")
+		}
+	}
+	if !reflect.DeepEqual(a.Runs, b.Runs) {
+		t.Errorf("expected identical runs")
+	}
+	testGob(t, b, new(Dealer))
+	if buf, err = a.Snapshot(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if _, err := RestoreDealer(buf); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func FuzzDealerUnmarshalBinary(f *testing.F) {
+	for _, typ := range []Type{Holdem, OmahaDouble, Stud, Lowball} {
+		d := typ.Dealer(rand.New(rand.NewPCG(3, 4)), 1, 3)
+		for range 3 {
+			buf, err := d.MarshalBinary()
+			if err != nil {
+				f.Fatalf("expected no error, got: %v", err)
+			}
+			f.Add(buf)
+			d.Next()
+		}
+	}
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		d := new(Dealer)
+		if err := d.UnmarshalBinary(buf); err != nil {
+			return
+		}
+		d.RabbitHunt(10)
+		for d.Next() {
+		}
+		for d.NextResult() {
+		}
+	})
+}
@@ -0,0 +1,265 @@
+package cardrank
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Grid is a 13x13 grid of values for the 169 Holdem starting pocket classes
+// (see [HashKey]), the standard representation for ranges and charts.
+//
+// Rows and columns are ordered by rank, [Ace] to [Two]. Pairs are on the
+// diagonal, suited pockets are above the diagonal, and offsuit pockets are
+// below the diagonal. Values may be used as frequencies or weights, or as
+// booleans (0 or 1).
+type Grid [13][13]float64
+
+// GridKey returns the starting pocket key for the grid row and column.
+func GridKey(row, col int) string {
+	if row < 0 || 13 <= row || col < 0 || 13 <= col {
+		return ""
+	}
+	r0, r1 := Ace-Rank(row), Ace-Rank(col)
+	switch {
+	case row < col:
+		return string([]byte{r0.Byte(), r1.Byte(), 's'})
+	case col < row:
+		return string([]byte{r1.Byte(), r0.Byte(), 'o'})
+	}
+	return string([]byte{r0.Byte(), r1.Byte()})
+}
+
+// GridPos returns the grid row and column for the starting pocket key.
+func GridPos(key string) (int, int, error) {
+	if len(key) != 2 && len(key) != 3 {
+		return 0, 0, ErrInvalidPocket
+	}
+	r0, r1 := RankFromRune(rune(key[0])), RankFromRune(rune(key[1]))
+	if r0 == InvalidRank || r1 == InvalidRank {
+		return 0, 0, ErrInvalidPocket
+	}
+	if r0 < r1 {
+		r0, r1 = r1, r0
+	}
+	i, j := int(Ace-r0), int(Ace-r1)
+	switch {
+	case len(key) == 2 && i == j:
+		return i, j, nil
+	case len(key) == 2, i == j:
+		return 0, 0, ErrInvalidPocket
+	}
+	switch key[2] {
+	case 's', 'S':
+		return i, j, nil
+	case 'o', 'O':
+		return j, i, nil
+	}
+	return 0, 0, ErrInvalidPocket
+}
+
+// ParseGrid parses a grid from either 13 rows of 13 values (see
+// [Grid.Format]), or a list of starting pocket keys separated by whitespace
+// or commas, each optionally followed by a colon and weight (ex: "AA KK:0.5
+// AKs, AQ:0.25"). Keys without a suited or offsuit suffix set both the suited
+// and offsuit classes.
+func ParseGrid(s string) (Grid, error) {
+	var g Grid
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	if len(fields) == 169 {
+		if _, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			for i, field := range fields {
+				f, err := strconv.ParseFloat(field, 64)
+				if err != nil {
+					return Grid{}, fmt.Errorf("invalid grid value %q: %w", field, err)
+				}
+				g[i/13][i%13] = f
+			}
+			return g, nil
+		}
+	}
+	for _, field := range fields {
+		key, weight := field, 1.0
+		if i := strings.IndexByte(field, ':'); i != -1 {
+			var err error
+			if weight, err = strconv.ParseFloat(field[i+1:], 64); err != nil {
+				return Grid{}, fmt.Errorf("invalid grid weight %q: %w", field, err)
+			}
+			key = field[:i]
+		}
+		keys := []string{key}
+		if len(key) == 2 && key[0] != key[1] {
+			keys = []string{key + "s", key + "o"}
+		}
+		for _, key := range keys {
+			if err := g.Set(key, weight); err != nil {
+				return Grid{}, fmt.Errorf("invalid grid key %q: %w", field, err)
+			}
+		}
+	}
+	return g, nil
+}
+
+// GridOf creates a grid from the range, with each class's value set to the
+// average weight of the class's combos.
+func GridOf(r Range) Grid {
+	var g Grid
+	for c, w := range r {
+		i, j, err := GridPos(c.Key())
+		if err != nil {
+			continue
+		}
+		g[i][j] += w
+	}
+	for i := range 13 {
+		for j := range 13 {
+			switch {
+			case i == j:
+				g[i][j] /= 6
+			case i < j:
+				g[i][j] /= 4
+			default:
+				g[i][j] /= 12
+			}
+		}
+	}
+	return g
+}
+
+// Get returns the value for the starting pocket key.
+func (g *Grid) Get(key string) float64 {
+	i, j, err := GridPos(key)
+	if err != nil {
+		return 0
+	}
+	return g[i][j]
+}
+
+// Set sets the value for the starting pocket key.
+func (g *Grid) Set(key string, value float64) error {
+	i, j, err := GridPos(key)
+	if err != nil {
+		return err
+	}
+	g[i][j] = value
+	return nil
+}
+
+// Keys returns the starting pocket keys having a non-zero value, ordered by
+// row and column.
+func (g Grid) Keys() []string {
+	var keys []string
+	for i := range 13 {
+		for j := range 13 {
+			if g[i][j] != 0 {
+				keys = append(keys, GridKey(i, j))
+			}
+		}
+	}
+	return keys
+}
+
+// Count returns the weighted number of combos in the grid.
+func (g Grid) Count() float64 {
+	var n float64
+	for i := range 13 {
+		for j := range 13 {
+			switch {
+			case i == j:
+				n += 6 * g[i][j]
+			case i < j:
+				n += 4 * g[i][j]
+			default:
+				n += 12 * g[i][j]
+			}
+		}
+	}
+	return n
+}
+
+// Range returns the grid as a range, with each combo weighted by its class's
+// value.
+func (g Grid) Range() Range {
+	r := make(Range)
+	for i := range 13 {
+		for j := range 13 {
+			if g[i][j] != 0 {
+				_ = r.Add(GridKey(i, j), g[i][j])
+			}
+		}
+	}
+	return r
+}
+
+// Merge returns a grid containing the merged values of g and o using f. When f
+// is nil, the maximum of each value is used.
+func (g Grid) Merge(o Grid, f func(float64, float64) float64) Grid {
+	if f == nil {
+		f = func(a, b float64) float64 {
+			return max(a, b)
+		}
+	}
+	var m Grid
+	for i := range 13 {
+		for j := range 13 {
+			m[i][j] = f(g[i][j], o[i][j])
+		}
+	}
+	return m
+}
+
+// Format satisfies the [fmt.Formatter] interface, writing 13 lines, one per
+// row.
+//
+// Supported verbs:
+//
+//	s - starting pocket keys, with "." for zero values
+//	v - values, formatted as floats (precision defaults to 2)
+func (g Grid) Format(f fmt.State, verb rune) {
+	var buf []byte
+	switch verb {
+	case 's', 'v':
+		prec, ok := f.Precision()
+		if !ok {
+			prec = 2
+		}
+		for i := range 13 {
+			if i != 0 {
+				buf = append(buf, '\n')
+			}
+			for j := range 13 {
+				if j != 0 {
+					buf = append(buf, ' ')
+				}
+				if verb == 'v' {
+					buf = strconv.AppendFloat(buf, g[i][j], 'f', prec, 64)
+					continue
+				}
+				key := "."
+				if g[i][j] != 0 {
+					key = GridKey(i, j)
+				}
+				if buf = append(buf, key...); j != 12 {
+					buf = append(buf, strings.Repeat(" ", 3-len(key))...)
+				}
+			}
+		}
+	default:
+		buf = []byte(fmt.Sprintf("%%!%c(ERROR=unknown verb, grid)", verb))
+	}
+	_, _ = f.Write(buf)
+}
+
+// MarshalText satisfies the [encoding.TextMarshaler] interface.
+func (g Grid) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%.4v", g)), nil
+}
+
+// UnmarshalText satisfies the [encoding.TextUnmarshaler] interface.
+func (g *Grid) UnmarshalText(buf []byte) error {
+	var err error
+	*g, err = ParseGrid(string(buf))
+	return err
+}
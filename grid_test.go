@@ -0,0 +1,138 @@
+package cardrank
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGridKey(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := range 13 {
+		for j := range 13 {
+			key := GridKey(i, j)
+			if seen[key] {
+				t.Errorf("duplicate key %q", key)
+			}
+			seen[key] = true
+			row, col, err := GridPos(key)
+			if err != nil {
+				t.Fatalf("expected no error for %q, got: %v", key, err)
+			}
+			if row != i || col != j {
+				t.Errorf("%q expected %d, %d, got: %d, %d", key, i, j, row, col)
+			}
+		}
+	}
+	tests := []struct {
+		key      string
+		row, col int
+	}{
+		{"AA", 0, 0},
+		{"AKs", 0, 1},
+		{"KAs", 0, 1},
+		{"AKo", 1, 0},
+		{"22", 12, 12},
+		{"32s", 11, 12},
+		{"32o", 12, 11},
+	}
+	for i, test := range tests {
+		row, col, err := GridPos(test.key)
+		if err != nil || row != test.row || col != test.col {
+			t.Errorf("test %d %q expected %d, %d, got: %d, %d %v", i, test.key, test.row, test.col, row, col, err)
+		}
+	}
+	for _, key := range []string{"AK", "AAs", "AKx", "1K", ""} {
+		if _, _, err := GridPos(key); err == nil {
+			t.Errorf("expected error for %q", key)
+		}
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	g, err := ParseGrid("AA, KK:0.5 AKs AQ:0.25")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for key, exp := range map[string]float64{"AA": 1, "KK": 0.5, "AKs": 1, "AKo": 0, "AQs": 0.25, "AQo": 0.25} {
+		if v := g.Get(key); v != exp {
+			t.Errorf("%q expected %f, got: %f", key, exp, v)
+		}
+	}
+	if keys, exp := strings.Join(g.Keys(), " "), "AA AKs AQs KK AQo"; keys != exp {
+		t.Errorf("expected %q, got: %q", exp, keys)
+	}
+	if n, exp := g.Count(), 6+4+1+3+3.0; n != exp {
+		t.Errorf("expected %f, got: %f", exp, n)
+	}
+	buf, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var u Grid
+	if err := u.UnmarshalText(buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if u != g {
+		t.Errorf("expected %v, got: %v", g, u)
+	}
+	if _, err := ParseGrid("AA ZZ"); err == nil {
+		t.Errorf("expected error")
+	}
+	if _, err := ParseGrid("AA:x"); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestGridRange(t *testing.T) {
+	r, err := NewRange("AA", "AKs")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	delete(r, NewCombo(New(Ace, Spade), New(Ace, Heart)))
+	g := GridOf(r)
+	if v, exp := g.Get("AA"), 5.0/6.0; math.Abs(v-exp) > 1e-9 {
+		t.Errorf("expected %f, got: %f", exp, v)
+	}
+	if v, exp := g.Get("AKs"), 1.0; v != exp {
+		t.Errorf("expected %f, got: %f", exp, v)
+	}
+	if n, exp := len(g.Range()), 10; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+}
+
+func TestGridMerge(t *testing.T) {
+	a, _ := ParseGrid("AA:0.5 KK")
+	b, _ := ParseGrid("AA QQ:0.25")
+	m := a.Merge(b, nil)
+	for key, exp := range map[string]float64{"AA": 1, "KK": 1, "QQ": 0.25} {
+		if v := m.Get(key); v != exp {
+			t.Errorf("%q expected %f, got: %f", key, exp, v)
+		}
+	}
+	m = a.Merge(b, func(x, y float64) float64 {
+		return x * y
+	})
+	if keys := m.Keys(); len(keys) != 1 || keys[0] != "AA" || m.Get("AA") != 0.5 {
+		t.Errorf("expected [AA] 0.5, got: %v %f", keys, m.Get("AA"))
+	}
+}
+
+func TestGridFormat(t *testing.T) {
+	g, _ := ParseGrid("AA AKs 22 32o")
+	lines := strings.Split(fmt.Sprintf("%s", g), "\n")
+	if n := len(lines); n != 13 {
+		t.Fatalf("expected 13 lines, got: %d", n)
+	}
+	if s, exp := lines[0], "AA  AKs .   .   .   .   .   .   .   .   .   .   ."; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := lines[12], ".   .   .   .   .   .   .   .   .   .   .   32o 22"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := strings.Split(fmt.Sprintf("%.1v", g), "\n")[0], "1.0 1.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
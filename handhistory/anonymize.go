@@ -0,0 +1,164 @@
+package handhistory
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Clone returns a deep copy of the hand.
+func (h *Hand) Clone() *Hand {
+	hand := *h
+	hand.Seats = make([]*Seat, len(h.Seats))
+	for i, seat := range h.Seats {
+		s := *seat
+		s.Pocket = slices.Clone(seat.Pocket)
+		hand.Seats[i] = &s
+	}
+	hand.Board = slices.Clone(h.Board)
+	hand.Actions = slices.Clone(h.Actions)
+	for i := range hand.Actions {
+		hand.Actions[i].Cards = slices.Clone(h.Actions[i].Cards)
+	}
+	hand.Collected = slices.Clone(h.Collected)
+	return &hand
+}
+
+// Anonymize returns a copy of the hand with player and site identities
+// removed. The hero is named Hero, and the other players are named Player 1,
+// Player 2, and so on in table order, including in the hand's actions and
+// collected pots. The hand id, table name, tournament id, and time are
+// cleared.
+func (h *Hand) Anonymize() *Hand {
+	hand := h.Clone()
+	hand.Id, hand.Table, hand.Tournament, hand.Time = "", "", "", time.Time{}
+	names := make(map[string]string)
+	var n int
+	for _, seat := range hand.Seats {
+		name := "Hero"
+		if !seat.Hero {
+			n++
+			name = "Player " + strconv.Itoa(n)
+		}
+		names[seat.Name], seat.Name = name, name
+	}
+	for i := range hand.Actions {
+		hand.Actions[i].Name = names[hand.Actions[i].Name]
+	}
+	for i := range hand.Collected {
+		hand.Collected[i].Name = names[hand.Collected[i].Name]
+	}
+	return hand
+}
+
+// Renumber returns a copy of the hand with the seats renumbered 1 through
+// the number of seated players, in table order, removing empty seats. The
+// button is moved to the button's renumbered seat, or to the closest seat
+// before the button when the button's seat is empty.
+func (h *Hand) Renumber() *Hand {
+	hand := h.Clone()
+	var button int
+	for i, seat := range hand.Seats {
+		if seat.Seat <= h.Button {
+			button = i + 1
+		}
+		seat.Seat = i + 1
+	}
+	if button == 0 && len(hand.Seats) != 0 && h.Button != 0 {
+		// button is before the first seat, wrap to the last seat
+		button = len(hand.Seats)
+	}
+	hand.Button = button
+	return hand
+}
+
+// NormalizeStakes returns a copy of the hand with the stakes, stacks, and
+// amounts expressed in big blinds, and the currency removed. Hands without a
+// big blind are returned unchanged.
+func (h *Hand) NormalizeStakes() *Hand {
+	hand := h.Clone()
+	if h.BigBlind == 0 {
+		return hand
+	}
+	hand.Currency = ""
+	hand.SmallBlind, hand.BigBlind = h.SmallBlind/h.BigBlind, 1
+	for _, seat := range hand.Seats {
+		seat.Stack /= h.BigBlind
+		seat.Won /= h.BigBlind
+	}
+	for i := range hand.Actions {
+		hand.Actions[i].Amount /= h.BigBlind
+		hand.Actions[i].To /= h.BigBlind
+	}
+	for i := range hand.Collected {
+		hand.Collected[i].Amount /= h.BigBlind
+	}
+	return hand
+}
+
+// CanonicalSuits returns a copy of the hand with the suits relabeled in
+// order of first appearance, as [cardrank.Spade], [cardrank.Heart],
+// [cardrank.Diamond], then [cardrank.Club]. Suits are first assigned from the
+// hero's pocket, followed by the board, and then the remaining pockets in
+// table order. As suits have no strategic meaning, hands that differ only by
+// a relabeling of suits have the same canonical suits.
+func (h *Hand) CanonicalSuits() *Hand {
+	hand := h.Clone()
+	m := make(map[cardrank.Suit]cardrank.Suit)
+	suits := []cardrank.Suit{cardrank.Spade, cardrank.Heart, cardrank.Diamond, cardrank.Club}
+	relabel := func(v []cardrank.Card) {
+		for i, c := range v {
+			suit, ok := m[c.Suit()]
+			if !ok {
+				suit, suits = suits[0], suits[1:]
+				m[c.Suit()] = suit
+			}
+			v[i] = cardrank.New(c.Rank(), suit)
+		}
+	}
+	if hero := hand.Hero(); hero != nil {
+		relabel(hero.Pocket)
+	}
+	relabel(hand.Board)
+	for _, seat := range hand.Seats {
+		if !seat.Hero {
+			relabel(seat.Pocket)
+		}
+	}
+	for _, a := range hand.Actions {
+		relabel(a.Cards)
+	}
+	return hand
+}
+
+// Normalize returns a copy of the hand that is anonymized, renumbered, with
+// stakes normalized, and with canonical suits, preserving the hand's
+// strategic content.
+//
+// See [Hand.Anonymize], [Hand.Renumber], [Hand.NormalizeStakes], and
+// [Hand.CanonicalSuits].
+func (h *Hand) Normalize() *Hand {
+	return h.Anonymize().Renumber().NormalizeStakes().CanonicalSuits()
+}
+
+// Fingerprint returns a hex encoded SHA-256 hash of the normalized hand's
+// strategic content (see [Hand.Normalize]), for use when deduplicating hands.
+// Hands imported from different sites, at different stakes, or with
+// different players or suits will have the same fingerprint when the hands
+// are otherwise the same.
+func (h *Hand) Fingerprint() string {
+	hand := h.Normalize()
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s|%d|%d|%g|%v\n", hand.Type.Id(), hand.Max, hand.Button, hand.SmallBlind, hand.Board)
+	for _, seat := range hand.Seats {
+		fmt.Fprintf(&b, "%d|%g|%t|%t|%v\n", seat.Seat, seat.Stack, seat.Hero, seat.Shown, seat.Pocket)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
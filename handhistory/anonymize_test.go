@@ -0,0 +1,98 @@
+package handhistory
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	hands := parseFile(t, "testdata/pokerstars.txt")
+	h := hands[0].Normalize()
+	switch {
+	case h.Id != "", h.Table != "", !h.Time.IsZero():
+		t.Errorf("expected no id, table, or time, got: %q %q %v", h.Id, h.Table, h.Time)
+	case h.Currency != "", h.SmallBlind != 0.5, h.BigBlind != 1:
+		t.Errorf("expected 0.5/1, got: %s%v/%v", h.Currency, h.SmallBlind, h.BigBlind)
+	case h.Button != 3:
+		t.Errorf("expected button 3, got: %d", h.Button)
+	case len(h.Seats) != 4:
+		t.Fatalf("expected 4 seats, got: %d", len(h.Seats))
+	}
+	var names []string
+	for i, seat := range h.Seats {
+		if seat.Seat != i+1 {
+			t.Errorf("expected seat %d, got: %d", i+1, seat.Seat)
+		}
+		names = append(names, seat.Name)
+	}
+	if s, exp := strings.Join(names, ","), "Player 1,Player 2,Hero,Player 3"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if hero := h.Hero(); hero == nil || hero.Stack != 50125 || fmt.Sprintf("%s", hero.Pocket) != "[As Kh]" {
+		t.Errorf("expected hero with 50125 [As Kh], got: %+v", hero)
+	}
+	if s, exp := fmt.Sprintf("%s %s", h.Board, h.Seat("Player 2").Pocket), "[2d 3h Ks 7c Qd] [7d 7h]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+	// original is unchanged
+	if hands[0].Seats[0].Name != "alice" || hands[0].Board[0].String() != "2c" {
+		t.Errorf("expected original hand to be unchanged")
+	}
+}
+
+func TestRenumber(t *testing.T) {
+	tests := []struct {
+		seats  []int
+		button int
+		exp    int
+	}{
+		{[]int{1, 2, 3}, 2, 2},
+		{[]int{2, 4, 6}, 4, 2},
+		{[]int{2, 4, 6}, 5, 2},
+		{[]int{2, 4, 6}, 1, 3},
+		{[]int{2, 4, 6}, 9, 3},
+		{[]int{2, 4, 6}, 0, 0},
+	}
+	for i, test := range tests {
+		h := &Hand{Button: test.button}
+		for _, n := range test.seats {
+			h.Seats = append(h.Seats, &Seat{Seat: n})
+		}
+		if n := h.Renumber().Button; n != test.exp {
+			t.Errorf("test %d expected button %d, got: %d", i, test.exp, n)
+		}
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a, err := ParseHand(fingerprintHand("alice", "$0.01/$0.02", "$2", "Ah Kd", "2c 3d Kh"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	b, err := ParseHand(fingerprintHand("bob", "$1/$2", "$200", "Ac Ks", "2d 3s Kc"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	c, err := ParseHand(fingerprintHand("alice", "$0.01/$0.02", "$2", "Ah Kd", "2c 3d Qh"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Errorf("expected equal fingerprints")
+	}
+	if a.Fingerprint() == c.Fingerprint() {
+		t.Errorf("expected different fingerprints")
+	}
+}
+
+func fingerprintHand(name, stakes, stack, pocket, flop string) string {
+	return fmt.Sprintf(`PokerStars Hand #1: Hold'em No Limit (%[2]s) - 2020/01/01 00:00:00 ET
+Table 'T' 6-max Seat #1 is the button
+Seat 1: %[1]s (%[3]s in chips)
+Seat 4: hero (%[3]s in chips)
+*** HOLE CARDS ***
+Dealt to hero [%[4]s]
+*** FLOP *** [%[5]s]
+`, name, stakes, stack, pocket, flop)
+}
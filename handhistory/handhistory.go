@@ -0,0 +1,615 @@
+// Package handhistory parses online poker hand history text.
+//
+// Supports PokerStars and GGPoker hand history text formats, extracting
+// the game type, stakes, seats, hole cards, board, actions, showdown hands,
+// and results for use with the package's evaluation and equity APIs, and
+// verifying the showdown's winners (see [Hand.Verify]).
+package handhistory
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Error is a error.
+type Error string
+
+// Error satisfies the [error] interface.
+func (err Error) Error() string {
+	return string(err)
+}
+
+// Error values.
+const (
+	// ErrInvalidHand is the invalid hand error.
+	ErrInvalidHand Error = "invalid hand"
+	// ErrUnknownGame is the unknown game error.
+	ErrUnknownGame Error = "unknown game"
+	// ErrWinnerMismatch is the winner mismatch error.
+	ErrWinnerMismatch Error = "winner mismatch"
+)
+
+// Site is a hand history site format.
+type Site string
+
+// Sites.
+const (
+	PokerStars Site = "PokerStars"
+	GGPoker    Site = "GGPoker"
+)
+
+// Hand is a parsed hand history.
+type Hand struct {
+	// Site is the hand history's site format.
+	Site Site
+	// Id is the site's hand id.
+	Id string
+	// Game is the site's game description (ex: Hold'em No Limit).
+	Game string
+	// Type is the game type.
+	Type cardrank.Type
+	// Currency is the stakes currency symbol, if any.
+	Currency string
+	// SmallBlind is the small blind.
+	SmallBlind float64
+	// BigBlind is the big blind.
+	BigBlind float64
+	// Tournament is the tournament id, if any.
+	Tournament string
+	// Time is the hand's start time.
+	Time time.Time
+	// Table is the table name.
+	Table string
+	// Max is the table's max seats.
+	Max int
+	// Button is the button's seat number.
+	Button int
+	// Seats are the seated players, in table order.
+	Seats []*Seat
+	// Board is the final board.
+	Board []cardrank.Card
+	// Actions are the players' actions, in order.
+	Actions []Action
+	// Collected are the pots collected by the players, in order.
+	Collected []Collect
+}
+
+// ActionKind is a player action kind.
+type ActionKind string
+
+// Action kinds.
+const (
+	ActionPost     ActionKind = "posts"
+	ActionFold     ActionKind = "folds"
+	ActionCheck    ActionKind = "checks"
+	ActionCall     ActionKind = "calls"
+	ActionBet      ActionKind = "bets"
+	ActionRaise    ActionKind = "raises"
+	ActionBringIn  ActionKind = "brings in"
+	ActionDiscard  ActionKind = "discards"
+	ActionStandPat ActionKind = "stands pat"
+)
+
+// Action is a player action.
+type Action struct {
+	// Street is the street's name (ex: HOLE CARDS, FLOP, 3rd STREET), or empty
+	// for actions prior to the deal, such as posting blinds and antes.
+	Street string
+	// Name is the player name.
+	Name string
+	// Kind is the action kind.
+	Kind ActionKind
+	// Amount is the amount posted, called, bet, raised, or brought in.
+	Amount float64
+	// To is the total amount raised to.
+	To float64
+	// AllIn is true when the player is all-in.
+	AllIn bool
+	// Cards are the discarded cards, if known.
+	Cards []cardrank.Card
+}
+
+// Collect is a pot collected by a player.
+type Collect struct {
+	// Name is the player name.
+	Name string
+	// Amount is the amount collected.
+	Amount float64
+	// Pot is the pot collected (ex: pot, main pot, side pot, side pot-1).
+	Pot string
+}
+
+// Seat is a seated player.
+type Seat struct {
+	// Seat is the seat number.
+	Seat int
+	// Name is the player name.
+	Name string
+	// Stack is the starting stack.
+	Stack float64
+	// Pocket is the player's pocket, if known.
+	Pocket []cardrank.Card
+	// Hero is true when the pocket was dealt to the hand history's owner.
+	Hero bool
+	// Shown is true when the pocket was shown (or mucked) at showdown.
+	Shown bool
+	// Won is the total amount won, from the summary.
+	Won float64
+}
+
+// Seat returns the seat for the player name, or nil.
+func (h *Hand) Seat(name string) *Seat {
+	for _, seat := range h.Seats {
+		if seat.Name == name {
+			return seat
+		}
+	}
+	return nil
+}
+
+// Hero returns the hand history owner's seat, or nil.
+func (h *Hand) Hero() *Seat {
+	for _, seat := range h.Seats {
+		if seat.Hero {
+			return seat
+		}
+	}
+	return nil
+}
+
+// Pockets returns the known pockets and their seats.
+func (h *Hand) Pockets() ([][]cardrank.Card, []*Seat) {
+	var pockets [][]cardrank.Card
+	var seats []*Seat
+	for _, seat := range h.Seats {
+		if len(seat.Pocket) != 0 {
+			pockets, seats = append(pockets, seat.Pocket), append(seats, seat)
+		}
+	}
+	return pockets, seats
+}
+
+// Showdown returns the pockets and seats shown at showdown.
+func (h *Hand) Showdown() ([][]cardrank.Card, []*Seat) {
+	var pockets [][]cardrank.Card
+	var seats []*Seat
+	for _, seat := range h.Seats {
+		if seat.Shown && len(seat.Pocket) != 0 {
+			pockets, seats = append(pockets, seat.Pocket), append(seats, seat)
+		}
+	}
+	return pockets, seats
+}
+
+// Winners returns the seats shown at showdown with the best Hi, and the seats
+// with the best qualified Lo for types with a Lo, as evaluated with the
+// hand's type and board. Returns nil when there was no showdown, or when the
+// board or a shown pocket is incomplete.
+func (h *Hand) Winners() ([]*Seat, []*Seat) {
+	pockets, seats := h.Showdown()
+	incomplete := func(pocket []cardrank.Card) bool {
+		return len(pocket) != h.Type.Pocket()
+	}
+	if len(pockets) == 0 || len(h.Board) != h.Type.Board() || slices.ContainsFunc(pockets, incomplete) {
+		return nil, nil
+	}
+	evs := h.Type.EvalPockets(pockets, h.Board)
+	winners := func(low bool) []*Seat {
+		var v []*Seat
+		order, pivot := cardrank.Order(evs, low)
+		for _, i := range order[:pivot] {
+			v = append(v, seats[i])
+		}
+		return v
+	}
+	var lo []*Seat
+	if h.Type.Low() {
+		lo = winners(true)
+	}
+	return winners(false), lo
+}
+
+// Verify verifies the hand's showdown by re-evaluating the pockets shown at
+// showdown (see [Hand.Winners]), checking that the players collecting the
+// main pot are the Hi or Lo winners, and that each winner collected the main
+// pot. Side pots are not verified. When the hand history does not contain
+// the pots collected, the amounts won from the summary are used. Hands
+// without a complete showdown are not verified.
+//
+// Returns an error wrapping [ErrWinnerMismatch] with the name of the first
+// mismatched player.
+func (h *Hand) Verify() error {
+	hi, lo := h.Winners()
+	if hi == nil {
+		return nil
+	}
+	collected := make(map[string]bool)
+	for _, c := range h.Collected {
+		if c.Pot == "pot" || c.Pot == "main pot" {
+			collected[c.Name] = true
+		}
+	}
+	if len(h.Collected) == 0 {
+		for _, seat := range h.Seats {
+			if seat.Won != 0 {
+				collected[seat.Name] = true
+			}
+		}
+	}
+	winners := make(map[string]bool)
+	for _, seat := range append(hi, lo...) {
+		if !collected[seat.Name] {
+			return fmt.Errorf("%s did not collect: %w", seat.Name, ErrWinnerMismatch)
+		}
+		winners[seat.Name] = true
+	}
+	for _, seat := range h.Seats {
+		if collected[seat.Name] && !winners[seat.Name] {
+			return fmt.Errorf("%s collected: %w", seat.Name, ErrWinnerMismatch)
+		}
+	}
+	return nil
+}
+
+// Scanner scans hand histories from a reader.
+type Scanner struct {
+	s     *bufio.Scanner
+	line  string
+	hand  *Hand
+	err   error
+	first bool
+}
+
+// NewScanner creates a new hand history scanner for the reader.
+func NewScanner(r io.Reader) *Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &Scanner{
+		s:     s,
+		first: true,
+	}
+}
+
+// Next scans the next hand, returning false when there are no more hands or
+// when an error was encountered.
+func (s *Scanner) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	var lines []string
+	if s.line != "" {
+		lines, s.line = append(lines, s.line), ""
+	}
+	for s.s.Scan() {
+		line := strings.TrimSpace(s.s.Text())
+		if s.first {
+			line, s.first = strings.TrimPrefix(line, bom), false
+		}
+		if headerRE.MatchString(line) && len(lines) != 0 {
+			s.line = line
+			break
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := s.s.Err(); err != nil {
+		s.err = err
+		return false
+	}
+	if len(lines) == 0 {
+		return false
+	}
+	s.hand, s.err = parse(lines)
+	return s.err == nil
+}
+
+// Hand returns the last scanned hand.
+func (s *Scanner) Hand() *Hand {
+	return s.hand
+}
+
+// Err returns the last error.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// Parse parses all hand histories from the reader.
+func Parse(r io.Reader) ([]*Hand, error) {
+	var hands []*Hand
+	s := NewScanner(r)
+	for s.Next() {
+		hands = append(hands, s.Hand())
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return hands, nil
+}
+
+// ParseHand parses a single hand history.
+func ParseHand(s string) (*Hand, error) {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimPrefix(s, bom), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return parse(lines)
+}
+
+// bom is the UTF-8 byte order mark, which prefixes some hand history files.
+const bom = "\ufeff"
+
+// Hand history regexps.
+var (
+	headerRE   = regexp.MustCompile(`^(PokerStars|Poker)(?: Zoom| Home Game)? Hand #(\w+):\s*(.+)$`)
+	stakesRE   = regexp.MustCompile(`\(([^\d(/]*)([\d.,]+)/[^\d(/]*([\d.,]+)(?: [A-Z]{3})?\)`)
+	tourneyRE  = regexp.MustCompile(`Tournament #(\d+)`)
+	timeRE     = regexp.MustCompile(`(\d{4}/\d{2}/\d{2} \d{1,2}:\d{2}:\d{2})`)
+	tableRE    = regexp.MustCompile(`^Table '([^']*)'(?: (\d+)-max)?.*?(?:Seat #(\d+) is the button)?$`)
+	seatRE     = regexp.MustCompile(`^Seat (\d+): (.+?) \(([^\d\s()]*)([\d.,]+) in chips.*\)`)
+	dealtRE    = regexp.MustCompile(`^Dealt to (.+?) ((?:\[[^\]]*\]\s*)+)$`)
+	streetRE   = regexp.MustCompile(`^\*\*\* (?:FLOP|TURN|RIVER) \*\*\* ((?:\[[^\]]*\]\s*)+)`)
+	showsRE    = regexp.MustCompile(`^(.+?): (?:shows|mucks hand) \[([^\]]*)\]`)
+	summaryRE  = regexp.MustCompile(`^Seat \d+: (.+?)(?: \([^)]*\))* (?:showed|mucked) \[([^\]]*)\]`)
+	boardRE    = regexp.MustCompile(`^Board \[([^\]]*)\]`)
+	bracketsRE = regexp.MustCompile(`\[([^\]]*)\]`)
+	nameRE     = regexp.MustCompile(`^\*\*\* ([^*]+?) \*\*\*`)
+	actionRE   = regexp.MustCompile(`^(.+?): (posts|folds|checks|calls|bets|raises|brings in|discards|stands pat)(.*)$`)
+	amountRE   = regexp.MustCompile(`[^\d\s]*([\d.,]+)`)
+	raiseRE    = regexp.MustCompile(`^ [^\d\s]*([\d.,]+) to [^\d\s]*([\d.,]+)`)
+	collectRE  = regexp.MustCompile(`^(.+?) collected [^\d\s]*([\d.,]+) from ((?:main |side )?pot(?:-\d+)?)`)
+	wonRE      = regexp.MustCompile(`(?:won|collected) \([^\d\s()]*([\d.,]+)\)`)
+	seatNumRE  = regexp.MustCompile(`^Seat (\d+): `)
+)
+
+// games are the site game descriptions, most specific first.
+var games = []struct {
+	name string
+	typ  cardrank.Type
+}{
+	{"6+ Hold'em", cardrank.Short},
+	{"Hold'em", cardrank.Holdem},
+	{"5 Card Omaha Hi/Lo", cardrank.OmahaFiveHiLo},
+	{"5 Card Omaha", cardrank.OmahaFive},
+	{"6 Card Omaha", cardrank.OmahaSix},
+	{"Omaha Hi/Lo", cardrank.OmahaHiLo},
+	{"Omaha", cardrank.Omaha},
+	{"Courchevel Hi/Lo", cardrank.CourchevelHiLo},
+	{"Courchevel", cardrank.Courchevel},
+	{"7 Card Stud Hi/Lo", cardrank.StudHiLo},
+	{"7 Card Stud", cardrank.Stud},
+	{"Razz", cardrank.Razz},
+	{"Triple Draw 2-7 Lowball", cardrank.LowballTriple},
+	{"Single Draw 2-7 Lowball", cardrank.Lowball},
+	{"5 Card Draw", cardrank.Draw},
+	{"Badugi", cardrank.Badugi},
+}
+
+// parse parses the hand history lines.
+func parse(lines []string) (*Hand, error) {
+	if len(lines) == 0 {
+		return nil, ErrInvalidHand
+	}
+	m := headerRE.FindStringSubmatch(lines[0])
+	if m == nil {
+		return nil, ErrInvalidHand
+	}
+	h := &Hand{
+		Site: PokerStars,
+		Id:   m[2],
+	}
+	if m[1] == "Poker" {
+		h.Site = GGPoker
+	}
+	if err := h.header(m[3]); err != nil {
+		return nil, err
+	}
+	summary, dealt, street := false, make(map[string]bool), ""
+	for _, line := range lines[1:] {
+		switch {
+		case strings.HasPrefix(line, "*** SUMMARY ***"):
+			summary = true
+		case summary:
+			if m := seatNumRE.FindStringSubmatch(line); m != nil {
+				h.won(m[1], line)
+			}
+			if m := boardRE.FindStringSubmatch(line); m != nil {
+				board, err := cards(m[1])
+				if err != nil {
+					return nil, err
+				}
+				h.Board = board
+			} else if m := summaryRE.FindStringSubmatch(line); m != nil {
+				if err := h.show(m[1], m[2]); err != nil {
+					return nil, err
+				}
+			}
+		case strings.HasPrefix(line, "Table '"):
+			if m := tableRE.FindStringSubmatch(line); m != nil {
+				h.Table = m[1]
+				h.Max, _ = strconv.Atoi(m[2])
+				h.Button, _ = strconv.Atoi(m[3])
+			}
+		case strings.HasPrefix(line, "Seat "):
+			if m := seatRE.FindStringSubmatch(line); m != nil {
+				n, _ := strconv.Atoi(m[1])
+				h.Seats = append(h.Seats, &Seat{
+					Seat:  n,
+					Name:  m[2],
+					Stack: amount(m[4]),
+				})
+			}
+		case strings.HasPrefix(line, "Dealt to "):
+			if m := dealtRE.FindStringSubmatch(line); m != nil {
+				if err := h.dealt(m[1], m[2], !dealt[m[1]]); err != nil {
+					return nil, err
+				}
+				dealt[m[1]] = true
+			}
+		case strings.HasPrefix(line, "*** "):
+			if m := nameRE.FindStringSubmatch(line); m != nil {
+				street = m[1]
+			}
+			if m := streetRE.FindStringSubmatch(line); m != nil {
+				board, err := brackets(m[1])
+				if err != nil {
+					return nil, err
+				}
+				h.Board = board
+			}
+		default:
+			if m := actionRE.FindStringSubmatch(line); m != nil && h.Seat(m[1]) != nil {
+				if err := h.action(street, m[1], ActionKind(m[2]), m[3]); err != nil {
+					return nil, err
+				}
+			} else if m := collectRE.FindStringSubmatch(line); m != nil {
+				h.Collected = append(h.Collected, Collect{
+					Name:   m[1],
+					Amount: amount(m[2]),
+					Pot:    m[3],
+				})
+			} else if m := showsRE.FindStringSubmatch(line); m != nil {
+				if err := h.show(m[1], m[2]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return h, nil
+}
+
+// header parses the hand header.
+func (h *Hand) header(s string) error {
+	if m := stakesRE.FindStringSubmatch(s); m != nil {
+		h.Currency, h.SmallBlind, h.BigBlind = m[1], amount(m[2]), amount(m[3])
+	}
+	if m := tourneyRE.FindStringSubmatch(s); m != nil {
+		h.Tournament = m[1]
+	}
+	if m := timeRE.FindStringSubmatch(s); m != nil {
+		h.Time, _ = time.Parse("2006/01/02 15:04:05", m[1])
+	}
+	for _, game := range games {
+		if i := strings.Index(s, game.name); i != -1 {
+			h.Type, h.Game = game.typ, s[i:]
+			if j := strings.IndexAny(h.Game, "(-"); j != -1 {
+				h.Game = strings.TrimSpace(h.Game[:j])
+			}
+			return nil
+		}
+	}
+	return ErrUnknownGame
+}
+
+// dealt sets the dealt cards for the player. Only the hand history owner's
+// (hero) cards are retained, as other players are only dealt up cards.
+func (h *Hand) dealt(name, s string, first bool) error {
+	seat := h.Seat(name)
+	if seat == nil {
+		return nil
+	}
+	v, err := brackets(s)
+	if err != nil {
+		return err
+	}
+	if streets := h.Type.Streets(); first && len(streets) != 0 && streets[0].Pocket <= len(v) {
+		seat.Hero = true
+	}
+	if seat.Hero {
+		seat.Pocket = v
+	}
+	return nil
+}
+
+// action adds the player's action, parsing the amounts from the remainder of
+// the action's line.
+func (h *Hand) action(street, name string, kind ActionKind, s string) error {
+	a := Action{
+		Street: street,
+		Name:   name,
+		Kind:   kind,
+		AllIn:  strings.HasSuffix(s, "and is all-in"),
+	}
+	switch kind {
+	case ActionRaise:
+		if m := raiseRE.FindStringSubmatch(s); m != nil {
+			a.Amount, a.To = amount(m[1]), amount(m[2])
+		}
+	case ActionDiscard:
+		v, err := brackets(s)
+		if err != nil {
+			return err
+		}
+		a.Cards = v
+	case ActionPost, ActionCall, ActionBet, ActionBringIn:
+		// posts use the last amount (ex: posts small & big blinds $0.03)
+		if m := amountRE.FindAllStringSubmatch(s, -1); m != nil {
+			a.Amount = amount(m[len(m)-1][1])
+		}
+	}
+	h.Actions = append(h.Actions, a)
+	return nil
+}
+
+// won adds the amounts won or collected on the summary line to the seat.
+func (h *Hand) won(num, line string) {
+	n, _ := strconv.Atoi(num)
+	for _, seat := range h.Seats {
+		if seat.Seat != n {
+			continue
+		}
+		for _, m := range wonRE.FindAllStringSubmatch(line, -1) {
+			seat.Won += amount(m[1])
+		}
+	}
+}
+
+// show sets the shown cards for the player.
+func (h *Hand) show(name, s string) error {
+	seat := h.Seat(name)
+	if seat == nil {
+		return nil
+	}
+	v, err := cards(s)
+	if err != nil {
+		return err
+	}
+	if len(v) != 0 {
+		seat.Pocket, seat.Shown = v, true
+	}
+	return nil
+}
+
+// brackets parses the cards in all bracketed groups.
+func brackets(s string) ([]cardrank.Card, error) {
+	var v []cardrank.Card
+	for _, m := range bracketsRE.FindAllStringSubmatch(s, -1) {
+		c, err := cards(m[1])
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, c...)
+	}
+	return v, nil
+}
+
+// cards parses space separated cards.
+func cards(s string) ([]cardrank.Card, error) {
+	return cardrank.ParseStrict(s)
+}
+
+// amount parses an amount, ignoring thousands separators.
+func amount(s string) float64 {
+	f, _ := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	return f
+}
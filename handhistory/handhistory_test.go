@@ -0,0 +1,222 @@
+package handhistory
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestParsePokerStars(t *testing.T) {
+	hands := parseFile(t, "testdata/pokerstars.txt")
+	if len(hands) != 3 {
+		t.Fatalf("expected 3 hands, got: %d", len(hands))
+	}
+	h := hands[0]
+	switch {
+	case h.Site != PokerStars, h.Id != "212345678901":
+		t.Errorf("expected PokerStars 212345678901, got: %s %s", h.Site, h.Id)
+	case h.Type != cardrank.Holdem, h.Game != "Hold'em No Limit":
+		t.Errorf("expected Holdem, got: %s %q", h.Type, h.Game)
+	case h.Currency != "$", h.SmallBlind != 0.01, h.BigBlind != 0.02:
+		t.Errorf("expected $0.01/$0.02, got: %s%v/%v", h.Currency, h.SmallBlind, h.BigBlind)
+	case !h.Time.Equal(time.Date(2020, 3, 14, 12, 34, 56, 0, time.UTC)):
+		t.Errorf("expected time, got: %v", h.Time)
+	case h.Table != "Alpha II", h.Max != 6, h.Button != 3:
+		t.Errorf("expected table Alpha II 6-max button 3, got: %q %d %d", h.Table, h.Max, h.Button)
+	case len(h.Seats) != 4:
+		t.Fatalf("expected 4 seats, got: %d", len(h.Seats))
+	}
+	if s, exp := fmt.Sprintf("%s", h.Board), "[2c 3d Kh 7s Qc]"; s != exp {
+		t.Errorf("expected board %s, got: %s", exp, s)
+	}
+	if seat := h.Seat("carol (dan)"); seat == nil || seat.Seat != 5 || seat.Stack != 2 {
+		t.Errorf("expected carol (dan) in seat 5, got: %+v", seat)
+	}
+	hero := h.Hero()
+	switch {
+	case hero == nil:
+		t.Fatalf("expected hero")
+	case hero.Name != "hero", hero.Stack != 1002.5, !hero.Shown:
+		t.Errorf("expected hero with 1002.5 shown, got: %+v", hero)
+	}
+	pockets, seats := h.Showdown()
+	if s, exp := fmt.Sprintf("%s", pockets), "[[7c 7d] [Ah Kd]]"; s != exp || seats[0].Name != "bob" {
+		t.Errorf("expected showdown %s, got: %s", exp, s)
+	}
+	if h := hands[1]; h.Type != cardrank.OmahaHiLo || len(h.Board) != 0 || h.Hero() == nil || len(h.Hero().Pocket) != 4 {
+		t.Errorf("expected OmahaHiLo with hero pocket and no board, got: %s %v", h.Type, h.Board)
+	}
+	h = hands[2]
+	switch {
+	case h.Type != cardrank.Stud, h.Tournament != "3012345678":
+		t.Errorf("expected Stud tournament 3012345678, got: %s %q", h.Type, h.Tournament)
+	case h.SmallBlind != 10, h.BigBlind != 20, h.Currency != "":
+		t.Errorf("expected 10/20, got: %s%v/%v", h.Currency, h.SmallBlind, h.BigBlind)
+	case h.Hero() == nil, h.Hero().Name != "hero", h.Seat("bob").Hero:
+		t.Errorf("expected hero to be hero")
+	}
+	if s, exp := fmt.Sprintf("%s", h.Seat("bob").Pocket), "[2c 3c Kd Kc]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+}
+
+func TestParseGGPoker(t *testing.T) {
+	hands := parseFile(t, "testdata/ggpoker.txt")
+	if len(hands) != 1 {
+		t.Fatalf("expected 1 hand, got: %d", len(hands))
+	}
+	h := hands[0]
+	switch {
+	case h.Site != GGPoker, h.Id != "HD1234567", h.Type != cardrank.Holdem:
+		t.Errorf("expected GGPoker HD1234567 Holdem, got: %s %s %s", h.Site, h.Id, h.Type)
+	case h.SmallBlind != 0.05, h.BigBlind != 0.1, h.Button != 1:
+		t.Errorf("expected 0.05/0.1 button 1, got: %v/%v %d", h.SmallBlind, h.BigBlind, h.Button)
+	case h.Hero() == nil, h.Hero().Name != "Hero":
+		t.Fatalf("expected Hero")
+	}
+	pockets, seats := h.Showdown()
+	if len(pockets) != 2 {
+		t.Fatalf("expected 2 pockets, got: %d", len(pockets))
+	}
+	evs := h.Type.EvalPockets(pockets, h.Board)
+	order, pivot := cardrank.Order(evs, false)
+	if pivot != 1 || seats[order[0]].Name != "Hero" {
+		t.Errorf("expected Hero to win, got: %v %d", order, pivot)
+	}
+}
+
+func TestParseActions(t *testing.T) {
+	hands := parseFile(t, "testdata/pokerstars.txt")
+	h := hands[0]
+	exp := []Action{
+		{"", "alice", ActionPost, 0.01, 0, false, nil},
+		{"", "bob", ActionPost, 0.02, 0, false, nil},
+		{"HOLE CARDS", "hero", ActionRaise, 0.04, 0.06, false, nil},
+		{"HOLE CARDS", "alice", ActionFold, 0, 0, false, nil},
+		{"HOLE CARDS", "bob", ActionCall, 0.04, 0, false, nil},
+		{"FLOP", "bob", ActionCheck, 0, 0, false, nil},
+		{"FLOP", "hero", ActionBet, 0.08, 0, false, nil},
+		{"FLOP", "bob", ActionCall, 0.08, 0, false, nil},
+		{"TURN", "bob", ActionCheck, 0, 0, false, nil},
+		{"TURN", "hero", ActionCheck, 0, 0, false, nil},
+		{"RIVER", "bob", ActionBet, 0.1, 0, false, nil},
+		{"RIVER", "hero", ActionCall, 0.1, 0, false, nil},
+	}
+	if len(h.Actions) != len(exp) {
+		t.Fatalf("expected %d actions, got: %d", len(exp), len(h.Actions))
+	}
+	for i, a := range h.Actions {
+		if fmt.Sprintf("%+v", a) != fmt.Sprintf("%+v", exp[i]) {
+			t.Errorf("action %d expected %+v, got: %+v", i, exp[i], a)
+		}
+	}
+	if len(h.Collected) != 1 || h.Collected[0] != (Collect{"bob", 0.48, "pot"}) {
+		t.Errorf("expected bob to collect 0.48, got: %+v", h.Collected)
+	}
+	if seat := h.Seat("bob"); seat.Won != 0.48 {
+		t.Errorf("expected bob to win 0.48, got: %v", seat.Won)
+	}
+	if seat := hands[1].Hero(); seat.Won != 0.2 {
+		t.Errorf("expected hero to win 0.2, got: %v", seat.Won)
+	}
+	if a := hands[2].Actions[0]; a.Street != "" || a.Kind != ActionPost || a.Amount != 2 {
+		t.Errorf("expected ante of 2, got: %+v", a)
+	}
+	hands = parseFile(t, "testdata/ggpoker.txt")
+	if a := hands[0].Actions[4]; a.Kind != ActionRaise || a.Amount != 9.9 || a.To != 10 || !a.AllIn {
+		t.Errorf("expected all-in raise to 10, got: %+v", a)
+	}
+	// anonymized names are used in actions
+	if a := hands[0].Anonymize().Actions[4]; a.Name != "Player 2" {
+		t.Errorf("expected Player 2, got: %q", a.Name)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	var hands []*Hand
+	for _, name := range []string{"testdata/pokerstars.txt", "testdata/ggpoker.txt"} {
+		hands = append(hands, parseFile(t, name)...)
+	}
+	for i, h := range hands {
+		if err := h.Verify(); err != nil {
+			t.Errorf("hand %d expected no error, got: %v", i, err)
+		}
+	}
+	if hi, lo := hands[0].Winners(); len(hi) != 1 || hi[0].Name != "bob" || lo != nil {
+		t.Errorf("expected bob to win, got: %v %v", hi, lo)
+	}
+	if hi, _ := hands[1].Winners(); hi != nil {
+		t.Errorf("expected no showdown, got: %v", hi)
+	}
+	// collected by the loser
+	h := hands[3].Clone()
+	h.Collected[0].Name = "9c8d1e"
+	if err := h.Verify(); !errors.Is(err, ErrWinnerMismatch) {
+		t.Errorf("expected %v, got: %v", ErrWinnerMismatch, err)
+	}
+	// summary winnings of a folded player
+	h = hands[0].Clone()
+	h.Collected, h.Seat("alice").Won = nil, 0.01
+	if err := h.Verify(); !errors.Is(err, ErrWinnerMismatch) {
+		t.Errorf("expected %v, got: %v", ErrWinnerMismatch, err)
+	}
+}
+
+func TestParseGame(t *testing.T) {
+	tests := []struct {
+		game string
+		exp  cardrank.Type
+	}{
+		{"Hold'em No Limit", cardrank.Holdem},
+		{"6+ Hold'em No Limit", cardrank.Short},
+		{"Omaha Pot Limit", cardrank.Omaha},
+		{"Omaha Hi/Lo Pot Limit", cardrank.OmahaHiLo},
+		{"5 Card Omaha Pot Limit", cardrank.OmahaFive},
+		{"5 Card Omaha Hi/Lo Pot Limit", cardrank.OmahaFiveHiLo},
+		{"7 Card Stud Hi/Lo Limit", cardrank.StudHiLo},
+	}
+	for i, test := range tests {
+		h, err := ParseHand("PokerStars Hand #1: " + test.game + " ($1/$2) - 2020/01/01 00:00:00\nSeat 1: a ($1 in chips)")
+		switch {
+		case err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case h.Type != test.exp:
+			t.Errorf("test %d expected %s, got: %s", i, test.exp, h.Type)
+		}
+	}
+}
+
+func TestParseHandErrors(t *testing.T) {
+	tests := []struct {
+		s   string
+		err error
+	}{
+		{"", ErrInvalidHand},
+		{"not a hand", ErrInvalidHand},
+		{"PokerStars Hand #1: Pineapple No Limit ($1/$2) - 2020/01/01 00:00:00", ErrUnknownGame},
+		{"PokerStars Hand #1: Hold'em No Limit ($1/$2) - 2020/01/01 00:00:00\nSeat 1: a ($1 in chips)\nDealt to a [Ah Ah]", cardrank.ErrDuplicateCard},
+	}
+	for i, test := range tests {
+		if _, err := ParseHand(test.s); err == nil || !errors.Is(err, test.err) {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
+
+func parseFile(t *testing.T, name string) []*Hand {
+	t.Helper()
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer f.Close()
+	hands, err := Parse(f)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	return hands
+}
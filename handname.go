@@ -0,0 +1,349 @@
+package cardrank
+
+import (
+	"slices"
+	"strings"
+)
+
+// HandName is a parsed hand description. See [ParseHandName].
+type HandName struct {
+	// Low is true when the description is an ace-to-five low.
+	Low bool
+	// Category is the Cactus rank category (ie, [FullHouse]), or 0 when the
+	// description is a low.
+	Category EvalRank
+	// Rank is the representative rank, the best Cactus (or ace-to-five low,
+	// see [RankAceFiveLow]) rank matching the description.
+	Rank EvalRank
+	// Best are the representative best cards.
+	Best []Card
+}
+
+// ParseHandName parses a human hand description, the inverse of
+// [EvalDesc.Format], returning the rank category and the best representative
+// rank matching the description. Descriptions are case insensitive, and
+// unspecified kickers are the best possible kickers.
+//
+// Examples:
+//
+//	royal flush
+//	king-high straight flush
+//	quad nines, kicker jack
+//	kings full of tens
+//	ace-high flush
+//	wheel
+//	three of a kind, fours
+//	jacks over sevens
+//	pair of aces, kickers king, queen, nine
+//	seven-high
+//	eight-six low
+//	Full House, Sixes full of Fours
+//
+// Returns [ErrInvalidHandName] when the description cannot be parsed, or
+// does not describe a valid hand.
+func ParseHandName(s string) (*HandName, error) {
+	s = strings.ToLower(strings.NewReplacer(",", " ", "-", " ", ".", " ").Replace(s))
+	s = " " + strings.Join(strings.Fields(s), " ") + " "
+	// straight flush names
+	var sf Rank = InvalidRank
+	for r := Five; r <= King; r++ {
+		if name := strings.ToLower(r.StraightFlushName()); strings.Contains(s, " "+name+" ") {
+			s, sf = strings.Replace(s, " "+name+" ", " ", 1), r
+		}
+	}
+	s = strings.NewReplacer(
+		" four of a kind ", " quads ",
+		" three of a kind ", " trips ",
+		" two pair ", " twopair ",
+		" full house ", " fullhouse ",
+		" straight flush ", " straightflush ",
+		" royal flush ", " royal ",
+		" high card ", " ",
+		" set of ", " trips ",
+		" pair of ", " pair ",
+		" one pair ", " pair ",
+	).Replace(s)
+	words := strings.Fields(s)
+	// kickers
+	var kickers []Rank
+	if i := slices.IndexFunc(words, func(w string) bool {
+		return w == "kicker" || w == "kickers"
+	}); i != -1 {
+		for _, w := range words[i+1:] {
+			r, _, ok := rankWord(w)
+			if !ok {
+				if w == "and" {
+					continue
+				}
+				return nil, ErrInvalidHandName
+			}
+			kickers = append(kickers, r)
+		}
+		words = words[:i]
+	}
+	// leading four/three followed by a plural rank (ie, four nines)
+	if 1 < len(words) && (words[0] == "four" || words[0] == "three") {
+		if _, plural, ok := rankWord(words[1]); ok && plural {
+			words[0] = map[string]string{"four": "quads", "three": "trips"}[words[0]]
+		}
+	}
+	kw := make(map[string]bool)
+	var ranks []Rank
+	var plurals int
+	for _, w := range words {
+		switch r, plural, ok := rankWord(w); {
+		case ok:
+			ranks = append(ranks, r)
+			if plural {
+				plurals++
+			}
+		case w == "quad":
+			kw["quads"] = true
+		case w == "trip", w == "set":
+			kw["trips"] = true
+		default:
+			kw[w] = true
+		}
+	}
+	rank := func(i int) Rank {
+		if i < len(ranks) {
+			return ranks[i]
+		}
+		return InvalidRank
+	}
+	switch {
+	case kw["low"]:
+		if kw["wheel"] && len(ranks) == 0 {
+			ranks = []Rank{Five}
+		}
+		return lowHandName(slices.Concat(ranks, kickers))
+	case kw["royal"]:
+		return straightHandName(Ace, true)
+	case sf != InvalidRank:
+		return straightHandName(sf, true)
+	case kw["straightflush"]:
+		if kw["wheel"] {
+			return straightHandName(Five, true)
+		}
+		return straightHandName(rank(0), true)
+	case kw["quads"]:
+		return bestHandName(FourOfAKind, []Rank{rank(0), rank(0), rank(0), rank(0)}, slices.Concat(ranks[min(1, len(ranks)):], kickers))
+	case kw["fullhouse"], kw["full"]:
+		if len(ranks) != 2 {
+			return nil, ErrInvalidHandName
+		}
+		return bestHandName(FullHouse, []Rank{ranks[0], ranks[0], ranks[0], ranks[1], ranks[1]}, kickers)
+	case kw["flush"]:
+		if len(ranks) == 0 {
+			return nil, ErrInvalidHandName
+		}
+		return bestHandName(Flush, nil, slices.Concat(ranks, kickers))
+	case kw["straight"]:
+		if kw["wheel"] {
+			return straightHandName(Five, false)
+		}
+		return straightHandName(rank(0), false)
+	case kw["wheel"] && len(ranks) == 0:
+		return straightHandName(Five, false)
+	case kw["trips"]:
+		return bestHandName(ThreeOfAKind, []Rank{rank(0), rank(0), rank(0)}, slices.Concat(ranks[min(1, len(ranks)):], kickers))
+	case kw["twopair"], plurals == 2 && len(ranks) == 2:
+		if len(ranks) < 2 {
+			return nil, ErrInvalidHandName
+		}
+		a, b := max(ranks[0], ranks[1]), min(ranks[0], ranks[1])
+		return bestHandName(TwoPair, []Rank{a, a, b, b}, slices.Concat(ranks[2:], kickers))
+	case kw["pair"], plurals == 1 && len(ranks) == 1:
+		return bestHandName(Pair, []Rank{rank(0), rank(0)}, slices.Concat(ranks[min(1, len(ranks)):], kickers))
+	case kw["high"] && len(ranks) != 0:
+		return bestHandName(Nothing, nil, slices.Concat(ranks, kickers))
+	}
+	return nil, ErrInvalidHandName
+}
+
+// straightHandName returns the hand name for a straight (or straight flush)
+// with the high rank.
+func straightHandName(high Rank, flush bool) (*HandName, error) {
+	if high < Five || Ace < high {
+		return nil, ErrInvalidHandName
+	}
+	v := make([]Rank, 5)
+	for i := range 5 {
+		if r := int(high) - i; r < 0 {
+			v[i] = Ace
+		} else {
+			v[i] = Rank(r)
+		}
+	}
+	category := Straight
+	if flush {
+		category = StraightFlush
+	}
+	return handName(category, v, flush)
+}
+
+// bestHandName returns the best hand name for the category, having the fixed
+// and kicker ranks, with any remaining cards being the best possible
+// kickers.
+func bestHandName(category EvalRank, fixed, kickers []Rank) (*HandName, error) {
+	v := slices.Concat(fixed, kickers)
+	if 5 < len(v) || slices.Contains(v, InvalidRank) {
+		return nil, ErrInvalidHandName
+	}
+	// kickers are descending, and cannot pair the fixed ranks
+	for i, r := range kickers {
+		if slices.Contains(fixed, r) || (i != 0 && kickers[i-1] <= r) {
+			return nil, ErrInvalidHandName
+		}
+	}
+	if len(v) == 5 {
+		return handName(category, v, category == Flush)
+	}
+	// remaining kickers are lower than the specified kickers
+	high := Ace
+	if len(kickers) != 0 {
+		high = kickers[len(kickers)-1] - 1
+	}
+	var free []Rank
+	for r := high; r != InvalidRank; r-- {
+		if !slices.Contains(v, r) {
+			free = append(free, r)
+		}
+	}
+	// the first combination of free ranks (in descending order) having the
+	// category is the best
+	flush := category == Flush
+	for g, u := NewCombinGen(free, 5-len(v)); g.Next(); {
+		if h, err := handName(category, slices.Concat(v, u), flush); err == nil {
+			return h, nil
+		}
+	}
+	return nil, ErrInvalidHandName
+}
+
+// handName returns the hand name for the ranks, when the ranks have the
+// category.
+func handName(category EvalRank, v []Rank, flush bool) (*HandName, error) {
+	suits := [4]Suit{Spade, Heart, Club, Diamond}
+	cards := make([]Card, len(v))
+	for i, r := range v {
+		switch {
+		case flush:
+			cards[i] = New(r, Spade)
+		default:
+			cards[i] = New(r, suits[i%4])
+		}
+	}
+	rank := Cactus(cards[0], cards[1], cards[2], cards[3], cards[4])
+	if rank.Fixed() != category {
+		return nil, ErrInvalidHandName
+	}
+	return &HandName{
+		Category: category,
+		Rank:     rank,
+		Best:     cards,
+	}, nil
+}
+
+// lowHandName returns the best ace-to-five low hand name for the descending
+// ranks, with any remaining ranks being the lowest possible ranks.
+func lowHandName(ranks []Rank) (*HandName, error) {
+	if len(ranks) == 0 || 5 < len(ranks) {
+		return nil, ErrInvalidHandName
+	}
+	// ace is low
+	low := func(r Rank) int {
+		if r == Ace {
+			return -1
+		}
+		return int(r)
+	}
+	for i := 1; i < len(ranks); i++ {
+		if low(ranks[i-1]) <= low(ranks[i]) {
+			return nil, ErrInvalidHandName
+		}
+	}
+	v := slices.Clone(ranks)
+	for _, r := range []Rank{Ace, Two, Three, Four, Five, Six, Seven, Eight, Nine, Ten, Jack, Queen} {
+		if len(v) == 5 || low(v[len(ranks)-1]) <= low(r) {
+			break
+		}
+		v = append(v, r)
+	}
+	if len(v) != 5 {
+		return nil, ErrInvalidHandName
+	}
+	// order remaining ranks descending, with the ace last
+	slices.SortFunc(v[len(ranks):], func(a, b Rank) int {
+		return low(b) - low(a)
+	})
+	suits := [4]Suit{Spade, Heart, Club, Diamond}
+	cards := make([]Card, 5)
+	for i, r := range v {
+		cards[i] = New(r, suits[i%4])
+	}
+	return &HandName{
+		Low:  true,
+		Rank: RankAceFiveLow(0, cards[0], cards[1], cards[2], cards[3], cards[4]),
+		Best: cards,
+	}, nil
+}
+
+// rankWord returns the rank for the word, and whether the word is plural.
+func rankWord(w string) (Rank, bool, bool) {
+	switch w {
+	case "ace", "a":
+		return Ace, false, true
+	case "aces":
+		return Ace, true, true
+	case "king", "k":
+		return King, false, true
+	case "kings":
+		return King, true, true
+	case "queen", "q":
+		return Queen, false, true
+	case "queens":
+		return Queen, true, true
+	case "jack", "j":
+		return Jack, false, true
+	case "jacks":
+		return Jack, true, true
+	case "ten", "t", "10":
+		return Ten, false, true
+	case "tens":
+		return Ten, true, true
+	case "nine", "9":
+		return Nine, false, true
+	case "nines":
+		return Nine, true, true
+	case "eight", "8":
+		return Eight, false, true
+	case "eights":
+		return Eight, true, true
+	case "seven", "7":
+		return Seven, false, true
+	case "sevens":
+		return Seven, true, true
+	case "six", "6":
+		return Six, false, true
+	case "sixes":
+		return Six, true, true
+	case "five", "5":
+		return Five, false, true
+	case "fives":
+		return Five, true, true
+	case "four", "4":
+		return Four, false, true
+	case "fours":
+		return Four, true, true
+	case "three", "trey", "3":
+		return Three, false, true
+	case "threes", "treys":
+		return Three, true, true
+	case "two", "deuce", "2":
+		return Two, false, true
+	case "twos", "deuces":
+		return Two, true, true
+	}
+	return InvalidRank, false, false
+}
@@ -0,0 +1,70 @@
+package cardrank
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestParseHandName(t *testing.T) {
+	tests := []struct {
+		s        string
+		category EvalRank
+		best     string
+	}{
+		{"royal flush", StraightFlush, "As Ks Qs Js Ts"},
+		{"king-high straight flush", StraightFlush, "Ks Qs Js Ts 9s"},
+		{"steel wheel", StraightFlush, "5s 4s 3s 2s As"},
+		{"quad nines, kicker jack", FourOfAKind, "9s 9h 9c 9d Js"},
+		{"four fours", FourOfAKind, "4s 4h 4c 4d As"},
+		{"kings full of tens", FullHouse, "Ks Kh Kc Td Ts"},
+		{"ace-high flush", Flush, "As Ks Qs Js 9s"},
+		{"wheel", Straight, "5s 4h 3c 2d As"},
+		{"Straight, Eight-high", Straight, "8s 7h 6c 5d 4s"},
+		{"three of a kind, fours", ThreeOfAKind, "4s 4h 4c Ad Ks"},
+		{"set of deuces", ThreeOfAKind, "2s 2h 2c Ad Ks"},
+		{"jacks over sevens", TwoPair, "Js Jh 7c 7d As"},
+		{"two pair, aces and kings", TwoPair, "As Ah Kc Kd Qs"},
+		{"pair of aces, kickers king, queen, nine", Pair, "As Ah Kc Qd 9s"},
+		{"pocket rockets", 0, ""},
+		{"seven-high", Nothing, "7s 6h 5c 4d 2s"},
+		{"eight-six low", 0, "8s 6h 3c 2d As"},
+		{"wheel low", 0, "5s 4h 3c 2d As"},
+		{"Eight, Six, Four, Two, Ace-low", 0, "8s 6h 4c 2d As"},
+		{"six-eight low", 0, ""},
+		{"kings full of kings", 0, ""},
+		{"pair of aces, kicker ace", 0, ""},
+		{"four-high straight", 0, ""},
+		{"", 0, ""},
+	}
+	for i, test := range tests {
+		h, err := ParseHandName(test.s)
+		switch {
+		case test.best == "" && err != ErrInvalidHandName:
+			t.Errorf("test %d %q expected error, got: %v", i, test.s, err)
+		case test.best == "":
+		case err != nil:
+			t.Errorf("test %d %q expected no error, got: %v", i, test.s, err)
+		case h.Category != test.category, h.Low != (test.category == 0):
+			t.Errorf("test %d %q expected %s, got: %s (%t)", i, test.s, test.category.Title(), h.Category.Title(), h.Low)
+		case fmt.Sprintf("%s", h.Best) != "["+test.best+"]":
+			t.Errorf("test %d %q expected [%s], got: %s", i, test.s, test.best, h.Best)
+		}
+	}
+}
+
+func TestParseHandNameDesc(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 0))
+	for range 2000 {
+		v := DeckFrench.Shuffle(r, 1).Draw(7)
+		ev := Holdem.Eval(v[:2], v[2:])
+		s := fmt.Sprintf("%s", ev.Desc(false))
+		h, err := ParseHandName(s)
+		switch {
+		case err != nil:
+			t.Fatalf("%q expected no error, got: %v", s, err)
+		case h.Rank != ev.HiRank:
+			t.Errorf("%q expected rank %d, got: %d", s, ev.HiRank, h.Rank)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Package httpapi provides a [http.Handler] exposing cardrank evaluation,
+// odds, and dealing as a JSON API.
+//
+// Endpoints (all POST, with JSON request and response bodies):
+//
+//	/eval - evaluates a pocket and board (see [EvalRequest], [EvalResponse])
+//	/odds - calculates odds for pockets and a board (see [OddsRequest], [OddsResponse])
+//	/deal - deals pockets and a board (see [DealRequest], [DealResponse])
+//
+// Types may be specified by either id ("Hh") or name ("Holdem"), and cards
+// are specified as strings ("Ah"). Errors are returned with a non-200 status
+// and an [ErrorResponse]. Calculations are canceled when the request's
+// context is canceled.
+//
+// Example:
+//
+//	func main() {
+//		log.Fatal(http.ListenAndServe(":8080", httpapi.New()))
+//	}
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+
+	"github.com/cardrank/cardrank"
+)
+
+// EvalRequest is a request to evaluate a pocket and board.
+type EvalRequest struct {
+	Type   cardrank.Type   `json:"type"`
+	Pocket []cardrank.Card `json:"pocket"`
+	Board  []cardrank.Card `json:"board"`
+}
+
+// EvalResponse is the response to an eval request.
+type EvalResponse struct {
+	Hi EvalDesc `json:"hi"`
+	// Lo is set for Hi/Lo and double board types.
+	Lo *EvalDesc `json:"lo,omitempty"`
+}
+
+// EvalDesc is a Hi or Lo eval description.
+type EvalDesc struct {
+	Rank   int             `json:"rank"`
+	Desc   string          `json:"desc"`
+	Best   []cardrank.Card `json:"best"`
+	Unused []cardrank.Card `json:"unused"`
+}
+
+// OddsRequest is a request to calculate odds.
+type OddsRequest struct {
+	Type    cardrank.Type     `json:"type"`
+	Pockets [][]cardrank.Card `json:"pockets"`
+	Board   []cardrank.Card   `json:"board"`
+}
+
+// OddsResponse is the response to an odds request.
+type OddsResponse struct {
+	Hi Odds `json:"hi"`
+	// Lo is set for Hi/Lo types.
+	Lo *Odds `json:"lo,omitempty"`
+}
+
+// Odds are calculated odds.
+type Odds struct {
+	Total    int       `json:"total"`
+	Counts   []int     `json:"counts"`
+	Percents []float64 `json:"percents"`
+}
+
+// DealRequest is a request to deal pockets and a board.
+type DealRequest struct {
+	Type  cardrank.Type `json:"type"`
+	Count int           `json:"count"`
+	Seed  uint64        `json:"seed"`
+}
+
+// DealResponse is the response to a deal request.
+type DealResponse struct {
+	Pockets [][]cardrank.Card `json:"pockets"`
+	Board   []cardrank.Card   `json:"board"`
+}
+
+// ErrorResponse is an error response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler is a cardrank JSON API handler.
+type Handler struct {
+	mux *http.ServeMux
+}
+
+// New creates a new cardrank JSON API handler.
+func New() *Handler {
+	h := &Handler{
+		mux: http.NewServeMux(),
+	}
+	h.mux.HandleFunc("POST /eval", handle(h.Eval))
+	h.mux.HandleFunc("POST /odds", handle(h.Odds))
+	h.mux.HandleFunc("POST /deal", handle(h.Deal))
+	return h
+}
+
+// ServeHTTP satisfies the [http.Handler] interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	h.mux.ServeHTTP(w, req)
+}
+
+// Eval evaluates the request.
+func (h *Handler) Eval(_ context.Context, req *EvalRequest) (*EvalResponse, error) {
+	if err := validate(req.Type, append(append([]cardrank.Card{}, req.Pocket...), req.Board...)); err != nil {
+		return nil, err
+	}
+	if len(req.Pocket) != req.Type.Pocket() || len(req.Board) != req.Type.Board() {
+		return nil, fmt.Errorf("%s requires %d pocket and %d board cards: %w", req.Type, req.Type.Pocket(), req.Type.Board(), cardrank.ErrInvalidPocket)
+	}
+	ev := req.Type.Eval(req.Pocket, req.Board)
+	res := &EvalResponse{
+		Hi: descOf(ev, false),
+	}
+	if req.Type.Low() || req.Type.Double() {
+		lo := descOf(ev, true)
+		res.Lo = &lo
+	}
+	return res, nil
+}
+
+// Odds calculates the odds for the request.
+func (h *Handler) Odds(ctx context.Context, req *OddsRequest) (*OddsResponse, error) {
+	if len(req.Pockets) < 2 {
+		return nil, fmt.Errorf("at least 2 pockets required: %w", cardrank.ErrInvalidPocket)
+	}
+	v := append([]cardrank.Card{}, req.Board...)
+	for _, pocket := range req.Pockets {
+		v = append(v, pocket...)
+	}
+	if err := validate(req.Type, v); err != nil {
+		return nil, err
+	}
+	for _, pocket := range req.Pockets {
+		if len(pocket) != req.Type.Pocket() {
+			return nil, fmt.Errorf("%s requires %d pocket cards: %w", req.Type, req.Type.Pocket(), cardrank.ErrInvalidPocket)
+		}
+	}
+	if req.Type.Board() < len(req.Board) {
+		return nil, fmt.Errorf("%s allows at most %d board cards: %w", req.Type, req.Type.Board(), cardrank.ErrInvalidCard)
+	}
+	hi, lo, ok := req.Type.Odds(ctx, req.Pockets, req.Board)
+	switch {
+	case ctx.Err() != nil:
+		return nil, ctx.Err()
+	case !ok:
+		return nil, errors.New("unable to calculate odds")
+	case hi == nil:
+		return nil, fmt.Errorf("%s odds are not available", req.Type)
+	}
+	res := &OddsResponse{
+		Hi: oddsOf(hi),
+	}
+	if lo != nil {
+		o := oddsOf(lo)
+		res.Lo = &o
+	}
+	return res, nil
+}
+
+// Deal deals the request.
+func (h *Handler) Deal(_ context.Context, req *DealRequest) (*DealResponse, error) {
+	if err := validate(req.Type, nil); err != nil {
+		return nil, err
+	}
+	if req.Count < 1 || req.Type.Max() < req.Count {
+		return nil, fmt.Errorf("invalid count %d", req.Count)
+	}
+	pockets, board := req.Type.Deal(rand.New(rand.NewPCG(req.Seed, req.Seed)), 1, req.Count)
+	return &DealResponse{
+		Pockets: pockets,
+		Board:   board,
+	}, nil
+}
+
+// handle wraps f as a http handler func, decoding the JSON request and
+// encoding the JSON response.
+func handle[T, U any](f func(context.Context, *T) (*U, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(T)
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(req); err != nil {
+			write(w, http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+		res, err := f(r.Context(), req)
+		switch {
+		case err != nil && r.Context().Err() != nil:
+			write(w, http.StatusServiceUnavailable, ErrorResponse{Error: err.Error()})
+		case err != nil:
+			write(w, http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		default:
+			write(w, http.StatusOK, res)
+		}
+	}
+}
+
+// write writes v as JSON to w.
+func write(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// validate validates the type and that the cards are valid and unique.
+func validate(typ cardrank.Type, v []cardrank.Card) error {
+	if typ.Name() == "" {
+		return cardrank.ErrInvalidType
+	}
+	m := make(map[cardrank.Card]bool, len(v))
+	for _, c := range v {
+		switch {
+		case !c.Valid():
+			return cardrank.ErrInvalidCard
+		case m[c]:
+			return fmt.Errorf("%s: %w", c, cardrank.ErrDuplicateCard)
+		}
+		m[c] = true
+	}
+	return nil
+}
+
+// descOf returns the description for the eval.
+func descOf(ev *cardrank.Eval, low bool) EvalDesc {
+	rank, best, unused := ev.HiRank, ev.HiBest, ev.HiUnused
+	if low {
+		rank, best, unused = ev.LoRank, ev.LoBest, ev.LoUnused
+	}
+	return EvalDesc{
+		Rank:   int(rank),
+		Desc:   fmt.Sprintf("%s", ev.Desc(low)),
+		Best:   best,
+		Unused: unused,
+	}
+}
+
+// oddsOf returns the odds.
+func oddsOf(odds *cardrank.Odds) Odds {
+	percents := make([]float64, len(odds.Counts))
+	for i := range percents {
+		percents[i] = float64(odds.Percent(i))
+	}
+	return Odds{
+		Total:    odds.Total,
+		Counts:   odds.Counts,
+		Percents: percents,
+	}
+}
@@ -0,0 +1,96 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(New())
+	defer srv.Close()
+	tests := []struct {
+		path string
+		req  string
+		code int
+		exp  string
+	}{
+		{"/eval", `{"type":"Holdem","pocket":["Ah","Kh"],"board":["Qh","Jh","Th","2c","3d"]}`, 200, `"desc":"Straight Flush, Ace-high, Royal"`},
+		{"/eval", `{"type":"Hh","pocket":["Ah","Kh"],"board":["Qh","Jh","Th","2c","3d"]}`, 200, `"rank":1,`},
+		{"/eval", `{"type":"Holdem","pocket":["Ah","Kh"],"board":["Qh","Jh","Th"]}`, 400, `"error"`},
+		{"/eval", `{"type":"OmahaHiLo","pocket":["Ah","2h","3c","Kd"],"board":["4h","5s","9c","Jd","Qh"]}`, 200, `"lo":{`},
+		{"/eval", `{"type":"Holdem","pocket":["Ah","Ah"],"board":[]}`, 400, `"error"`},
+		{"/eval", `{"type":"Bogus","pocket":[],"board":[]}`, 400, `"error"`},
+		{"/eval", `{"type":"Holdem","bogus":1}`, 400, `"error"`},
+		{"/odds", `{"type":"Holdem","pockets":[["Ah","Ad"],["Kh","Kd"]],"board":["2c","3c","7s","8d"]}`, 200, `"total":44`},
+		{"/odds", `{"type":"Holdem","pockets":[["Ah","Ad"]],"board":[]}`, 400, `"error"`},
+		{"/odds", `{"type":"Stud","pockets":[["Ah","Ad","2c","3c","7s","8d","9h"],["Kh","Kd","4c","5c","Ts","Jd","Qh"]],"board":[]}`, 400, `"error"`},
+		{"/odds", `{"type":"Kuhn","pockets":[["Ks"],["Qs"]],"board":[]}`, 400, `"error"`},
+		{"/deal", `{"type":"Holdem","count":3,"seed":42}`, 200, `"board":[`},
+		{"/deal", `{"type":"Holdem","count":0,"seed":42}`, 400, `"error"`},
+	}
+	for i, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			res, err := http.Post(srv.URL+test.path, "application/json", strings.NewReader(test.req))
+			if err != nil {
+				t.Fatalf("test %d expected no error, got: %v", i, err)
+			}
+			defer res.Body.Close()
+			if res.StatusCode != test.code {
+				t.Errorf("test %d expected %d, got: %d", i, test.code, res.StatusCode)
+			}
+			var v json.RawMessage
+			if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+				t.Fatalf("test %d expected no error, got: %v", i, err)
+			}
+			if s := string(v); !strings.Contains(s, test.exp) {
+				t.Errorf("test %d expected %q in %s", i, test.exp, s)
+			}
+		})
+	}
+}
+
+func TestHandlerMethod(t *testing.T) {
+	w := httptest.NewRecorder()
+	New().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/eval", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected %d, got: %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
+
+func TestDealDeterministic(t *testing.T) {
+	h := New()
+	a, err := h.Deal(context.Background(), &DealRequest{Type: cardrank.Holdem, Count: 4, Seed: 7})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	b, err := h.Deal(context.Background(), &DealRequest{Type: cardrank.Holdem, Count: 4, Seed: 7})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(a.Pockets) != 4 || len(a.Board) != 5 {
+		t.Fatalf("expected 4 pockets and 5 board cards, got: %d %d", len(a.Pockets), len(a.Board))
+	}
+	for i := range a.Pockets {
+		if s, exp := cardrank.Cards(b.Pockets[i]), cardrank.Cards(a.Pockets[i]); len(s) != len(exp) || s[0] != exp[0] || s[1] != exp[1] {
+			t.Errorf("expected pocket %d %v, got: %v", i, exp, s)
+		}
+	}
+}
+
+func TestOddsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := New().Odds(ctx, &OddsRequest{
+		Type:    cardrank.Holdem,
+		Pockets: [][]cardrank.Card{cardrank.Must("Ah Ad"), cardrank.Must("Kh Kd")},
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -0,0 +1,72 @@
+package cardrank
+
+import (
+	"slices"
+	"strings"
+)
+
+// InfoSetKey returns the canonical information set key for a [Kuhn] or
+// [Leduc] player, built from the player's private pocket card, the public
+// board card (when dealt), and the betting action history. Useful as the
+// information set key for CFR and other game solver implementations.
+//
+// Keys are the pocket card's rank, the board card's rank (when dealt), a
+// colon, and the actions. As suits do not affect [Kuhn] or [Leduc] outcomes,
+// suits are not included, making keys the same for suit isomorphic deals.
+//
+// [Kuhn] actions are 'p' (pass) and 'b' (bet). [Leduc] actions are 'c' (check
+// or call), 'r' (bet or raise), and 'f' (fold), with a '/' separating the
+// first and second betting rounds. The '/' must be present only when the
+// [Leduc] board card has been dealt.
+//
+// Examples:
+//
+//	K:pb     // Kuhn, King, pass then bet
+//	Q:rc     // Leduc, Queen, raise then call
+//	JQ:rc/r  // Leduc, Jack with a Queen board, raise, call, then raise
+func InfoSetKey(typ Type, pocket, board []Card, actions string) (string, error) {
+	buf, err := AppendInfoSetKey(make([]byte, 0, 3+len(actions)), typ, pocket, board, actions)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// AppendInfoSetKey appends the canonical information set key for a [Kuhn] or
+// [Leduc] player to buf. See [InfoSetKey].
+func AppendInfoSetKey(buf []byte, typ Type, pocket, board []Card, actions string) ([]byte, error) {
+	var valid string
+	var rounds int
+	switch typ {
+	case Kuhn:
+		valid = "pb"
+	case Leduc:
+		valid, rounds = "crf", 1
+	default:
+		return buf, ErrInvalidType
+	}
+	// check cards
+	deck := typ.DeckType().v()
+	switch {
+	case len(pocket) != 1 || !slices.Contains(deck, pocket[0]):
+		return buf, ErrInvalidPocket
+	case rounds < len(board),
+		len(board) == 1 && (!slices.Contains(deck, board[0]) || board[0] == pocket[0]):
+		return buf, ErrInvalidBoard
+	}
+	// check actions
+	if strings.Count(actions, "/") != len(board) {
+		return buf, ErrInvalidAction
+	}
+	for i := range len(actions) {
+		if actions[i] != '/' && strings.IndexByte(valid, actions[i]) == -1 {
+			return buf, ErrInvalidAction
+		}
+	}
+	buf = append(buf, pocket[0].Rank().Byte())
+	if len(board) != 0 {
+		buf = append(buf, board[0].Rank().Byte())
+	}
+	buf = append(buf, ':')
+	return append(buf, actions...), nil
+}
@@ -0,0 +1,61 @@
+package cardrank
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestInfoSetKey(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		pocket  string
+		board   string
+		actions string
+		exp     string
+		err     error
+	}{
+		{Kuhn, "Ks", "", "", "K:", nil},
+		{Kuhn, "Ks", "", "pb", "K:pb", nil},
+		{Kuhn, "Js", "", "pbb", "J:pbb", nil},
+		{Leduc, "Qs", "", "rc", "Q:rc", nil},
+		{Leduc, "Qh", "", "rc", "Q:rc", nil},
+		{Leduc, "Js", "Qh", "rc/r", "JQ:rc/r", nil},
+		{Leduc, "Jh", "Qs", "rc/r", "JQ:rc/r", nil},
+		{Leduc, "Kh", "Ks", "cc/", "KK:cc/", nil},
+		{Holdem, "Ks", "", "", "", ErrInvalidType},
+		{Kuhn, "Kh", "", "", "", ErrInvalidPocket},
+		{Kuhn, "Ks Qs", "", "", "", ErrInvalidPocket},
+		{Kuhn, "Ks", "Qs", "/", "", ErrInvalidBoard},
+		{Leduc, "As", "", "", "", ErrInvalidPocket},
+		{Leduc, "Ks", "Ks", "cc/", "", ErrInvalidBoard},
+		{Leduc, "Ks", "Qs Qh", "cc/", "", ErrInvalidBoard},
+		{Kuhn, "Ks", "", "pr", "", ErrInvalidAction},
+		{Leduc, "Ks", "", "pb", "", ErrInvalidAction},
+		{Leduc, "Ks", "", "cc/", "", ErrInvalidAction},
+		{Leduc, "Ks", "Qs", "cc", "", ErrInvalidAction},
+	}
+	for i, test := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			key, err := InfoSetKey(test.typ, Must(test.pocket), Must(test.board), test.actions)
+			switch {
+			case err != test.err:
+				t.Fatalf("expected error %v, got: %v", test.err, err)
+			case key != test.exp:
+				t.Errorf("expected %q, got: %q", test.exp, key)
+			}
+		})
+	}
+}
+
+func TestAppendInfoSetKey(t *testing.T) {
+	pocket, board := Must("Jh"), Must("Qs")
+	buf := make([]byte, 0, 16)
+	if n := testing.AllocsPerRun(100, func() {
+		buf, _ = AppendInfoSetKey(buf[:0], Leduc, pocket, board, "rrc/cr")
+	}); n != 0 {
+		t.Errorf("expected 0 allocs, got: %v", n)
+	}
+	if s, exp := string(buf), "JQ:rrc/cr"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
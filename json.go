@@ -0,0 +1,223 @@
+package cardrank
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// evalJSON is the JSON representation of an [Eval].
+type evalJSON struct {
+	Type     Type     `json:"type"`
+	HiRank   EvalRank `json:"hi_rank"`
+	HiBest   []Card   `json:"hi_best"`
+	HiUnused []Card   `json:"hi_unused"`
+	HiDesc   string   `json:"hi_desc,omitempty"`
+	LoRank   EvalRank `json:"lo_rank"`
+	LoBest   []Card   `json:"lo_best"`
+	LoUnused []Card   `json:"lo_unused"`
+	LoDesc   string   `json:"lo_desc,omitempty"`
+}
+
+// MarshalJSON satisfies the [json.Marshaler] interface.
+//
+// The eval is encoded as an object with the type id, and the Hi/Lo rank,
+// best, and unused cards. For convenience, valid Hi/Lo ranks include a
+// description (see [EvalDesc]), which is ignored when unmarshaling:
+//
+//	{
+//	  "type": "Hh",
+//	  "hi_rank": 1,
+//	  "hi_best": ["Ah", "Kh", "Qh", "Jh", "Th"],
+//	  "hi_unused": ["2c", "3d"],
+//	  "hi_desc": "Straight Flush, Ace-high, Royal",
+//	  "lo_rank": 65535,
+//	  "lo_best": null,
+//	  "lo_unused": null
+//	}
+func (ev *Eval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(evalJSON{
+		Type:     ev.Type,
+		HiRank:   ev.HiRank,
+		HiBest:   ev.HiBest,
+		HiUnused: ev.HiUnused,
+		HiDesc:   jsonDesc(ev, false),
+		LoRank:   ev.LoRank,
+		LoBest:   ev.LoBest,
+		LoUnused: ev.LoUnused,
+		LoDesc:   jsonDesc(ev, true),
+	})
+}
+
+// UnmarshalJSON satisfies the [json.Unmarshaler] interface.
+func (ev *Eval) UnmarshalJSON(buf []byte) error {
+	var v evalJSON
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return err
+	}
+	*ev = Eval{
+		Type:     v.Type,
+		HiRank:   v.HiRank,
+		HiBest:   v.HiBest,
+		HiUnused: v.HiUnused,
+		LoRank:   v.LoRank,
+		LoBest:   v.LoBest,
+		LoUnused: v.LoUnused,
+	}
+	return nil
+}
+
+// evalDescJSON is the JSON representation of an [EvalDesc].
+type evalDescJSON struct {
+	Type   DescType `json:"type"`
+	Rank   EvalRank `json:"rank"`
+	Best   []Card   `json:"best"`
+	Unused []Card   `json:"unused"`
+	Desc   string   `json:"desc"`
+}
+
+// MarshalJSON satisfies the [json.Marshaler] interface.
+//
+// The description is encoded as an object with the description type name,
+// rank, best, and unused cards, and the full description (which is ignored
+// when unmarshaling):
+//
+//	{
+//	  "type": "Cactus",
+//	  "rank": 1,
+//	  "best": ["Ah", "Kh", "Qh", "Jh", "Th"],
+//	  "unused": ["2c", "3d"],
+//	  "desc": "Straight Flush, Ace-high, Royal"
+//	}
+func (desc *EvalDesc) MarshalJSON() ([]byte, error) {
+	return json.Marshal(evalDescJSON{
+		Type:   desc.Type,
+		Rank:   desc.Rank,
+		Best:   desc.Best,
+		Unused: desc.Unused,
+		Desc:   fmt.Sprintf("%s", desc),
+	})
+}
+
+// UnmarshalJSON satisfies the [json.Unmarshaler] interface.
+func (desc *EvalDesc) UnmarshalJSON(buf []byte) error {
+	var v evalDescJSON
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return err
+	}
+	*desc = EvalDesc{
+		Type:   v.Type,
+		Rank:   v.Rank,
+		Best:   v.Best,
+		Unused: v.Unused,
+	}
+	return nil
+}
+
+// resultJSON is the JSON representation of a [Result].
+type resultJSON struct {
+	Evals   []*Eval `json:"evals"`
+	HiOrder []int   `json:"hi_order"`
+	HiPivot int     `json:"hi_pivot"`
+	LoOrder []int   `json:"lo_order"`
+	LoPivot int     `json:"lo_pivot"`
+}
+
+// MarshalJSON satisfies the [json.Marshaler] interface.
+//
+// The result is encoded as an object with the evals (see [Eval.MarshalJSON]),
+// with inactive positions encoded as null, and the Hi/Lo orders and pivots:
+//
+//	{
+//	  "evals": [{...}, null, {...}],
+//	  "hi_order": [2, 0],
+//	  "hi_pivot": 1,
+//	  "lo_order": null,
+//	  "lo_pivot": 0
+//	}
+func (res *Result) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resultJSON(*res))
+}
+
+// UnmarshalJSON satisfies the [json.Unmarshaler] interface.
+func (res *Result) UnmarshalJSON(buf []byte) error {
+	var v resultJSON
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return err
+	}
+	*res = Result(v)
+	return nil
+}
+
+// winJSON is the JSON representation of a [Win].
+type winJSON struct {
+	Evals []*Eval  `json:"evals"`
+	Order []int    `json:"order"`
+	Pivot int      `json:"pivot"`
+	Low   bool     `json:"low"`
+	Scoop bool     `json:"scoop"`
+	Names []string `json:"names"`
+	Verb  string   `json:"verb,omitempty"`
+	Desc  string   `json:"desc,omitempty"`
+}
+
+// MarshalJSON satisfies the [json.Marshaler] interface.
+//
+// The win is encoded as an object with the evals (see [Eval.MarshalJSON]),
+// order, pivot, low and scoop flags, and names. For convenience, valid wins
+// include the win verb and description (see [Win.Format]), which are
+// ignored when unmarshaling:
+//
+//	{
+//	  "evals": [{...}, {...}],
+//	  "order": [1, 0],
+//	  "pivot": 1,
+//	  "low": false,
+//	  "scoop": false,
+//	  "names": ["Alice", "Bob"],
+//	  "verb": "wins",
+//	  "desc": "Bob wins with Two Pair, Kings over Sevens, kicker Ace"
+//	}
+func (win *Win) MarshalJSON() ([]byte, error) {
+	v := winJSON{
+		Evals: win.Evals,
+		Order: win.Order,
+		Pivot: win.Pivot,
+		Low:   win.Low,
+		Scoop: win.Scoop,
+		Names: win.Names,
+	}
+	if 0 < win.Pivot && win.Pivot <= len(win.Order) && len(win.Evals) != 0 && !win.Invalid() {
+		v.Verb, v.Desc = win.Verb(), fmt.Sprintf("%S", win)
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON satisfies the [json.Unmarshaler] interface.
+func (win *Win) UnmarshalJSON(buf []byte) error {
+	var v winJSON
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return err
+	}
+	*win = Win{
+		Evals: v.Evals,
+		Order: v.Order,
+		Pivot: v.Pivot,
+		Low:   v.Low,
+		Scoop: v.Scoop,
+		Names: v.Names,
+	}
+	return nil
+}
+
+// jsonDesc returns the Hi or Lo description of the eval, or an empty string
+// when the rank is not valid.
+func jsonDesc(ev *Eval, low bool) string {
+	rank := ev.HiRank
+	if low {
+		rank = ev.LoRank
+	}
+	if rank == 0 || rank == Invalid {
+		return ""
+	}
+	return fmt.Sprintf("%s", ev.Desc(low))
+}
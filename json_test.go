@@ -0,0 +1,96 @@
+package cardrank
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestEvalJSON(t *testing.T) {
+	ev := Holdem.Eval(Must("Ah Kh"), Must("Qh Jh Th 2c 3d"))
+	buf, err := json.Marshal(ev)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := `{"type":"Hh","hi_rank":1,"hi_best":["Ah","Kh","Qh","Jh","Th"],"hi_unused":["3d","2c"],"hi_desc":"Straight Flush, Ace-high, Royal","lo_rank":65535,"lo_best":null,"lo_unused":null}`
+	if s := string(buf); s != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, s)
+	}
+	testJSON(t, ev, new(Eval))
+	desc := ev.Desc(false)
+	buf, err = json.Marshal(desc)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp = `{"type":"Cactus","rank":1,"best":["Ah","Kh","Qh","Jh","Th"],"unused":["3d","2c"],"desc":"Straight Flush, Ace-high, Royal"}`
+	if s := string(buf); s != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, s)
+	}
+	testJSON(t, desc, new(EvalDesc))
+}
+
+func TestResultJSON(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for _, typ := range []Type{Holdem, OmahaHiLo, OmahaDouble, Razz, Badugi} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(r, 1, 4)
+			d.Deactivate(2)
+			for d.Next() {
+			}
+			for d.NextResult() {
+			}
+			for _, res := range d.Results {
+				testJSON(t, res, new(Result))
+				hi, lo := res.Win("alice", "bob", "carol", "dave")
+				buf := testJSON(t, hi, new(Win))
+				if !strings.Contains(string(buf), `"desc":"`) {
+					t.Errorf("expected desc in %s", buf)
+				}
+				if lo != nil {
+					testJSON(t, lo, new(Win))
+				}
+			}
+		})
+	}
+}
+
+func TestDescTypeText(t *testing.T) {
+	for _, typ := range []DescType{DescCactus, DescFlushOver, DescSoko, DescLow, DescLowball, DescRazz, DescHigh, DescThree} {
+		buf, err := typ.MarshalText()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		var d DescType
+		if err := d.UnmarshalText(buf); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if d != typ {
+			t.Errorf("expected %s, got: %s", typ, d)
+		}
+	}
+	if _, err := DescType('z').MarshalText(); err != ErrInvalidType {
+		t.Errorf("expected %v, got: %v", ErrInvalidType, err)
+	}
+}
+
+func testJSON[T any](t *testing.T, v, u *T) []byte {
+	t.Helper()
+	buf, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := json.Unmarshal(buf, u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// compare encodings, as evals contain internal card storage
+	b, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !bytes.Equal(buf, b) {
+		t.Errorf("expected %#v, got: %#v", v, u)
+	}
+	return buf
+}
@@ -0,0 +1,145 @@
+package cardrank
+
+// Translator is the interface for translating card rank and suit names.
+type Translator interface {
+	// RankName returns the translated name for the rank.
+	RankName(rank Rank, plural bool) string
+	// SuitName returns the translated name for the suit.
+	SuitName(suit Suit, plural bool) string
+}
+
+// WinTranslator is the interface for translating win verbs. Used by
+// [Win.Summary] when the package translator also satisfies the interface.
+type WinTranslator interface {
+	// VerbName returns the translated name for the win verb (see
+	// [Win.Verb]), or an empty string when there is no translation.
+	VerbName(verb string) string
+}
+
+// translator is the package translator.
+var translator Translator
+
+// SetTranslator sets the translator used for rank and suit names, affecting
+// [Rank.Name], [Rank.PluralName], [Suit.Name], [Suit.PluralName], and the
+// [Card.Format] name verbs (n N p P t T l L). Passing nil restores the default
+// English names.
+//
+// Not safe for concurrent use, and should be called before any cards are
+// formatted (ie, during init).
+func SetTranslator(t Translator) {
+	translator = t
+}
+
+// NameTable is a [Translator] using fixed tables of rank and suit names.
+type NameTable struct {
+	// Ranks are the rank names, indexed by [Rank.Index].
+	Ranks [13]string
+	// PluralRanks are the plural rank names, indexed by [Rank.Index].
+	PluralRanks [13]string
+	// Suits are the suit names, indexed by [Suit.Index].
+	Suits [4]string
+	// PluralSuits are the plural suit names, indexed by [Suit.Index].
+	PluralSuits [4]string
+	// Verbs are the win verb names, keyed by [Win.Verb].
+	Verbs map[string]string
+}
+
+// RankName satisfies the [Translator] interface.
+func (t *NameTable) RankName(rank Rank, plural bool) string {
+	switch {
+	case Ace < rank:
+		return ""
+	case plural:
+		return t.PluralRanks[rank.Index()]
+	}
+	return t.Ranks[rank.Index()]
+}
+
+// SuitName satisfies the [Translator] interface.
+func (t *NameTable) SuitName(suit Suit, plural bool) string {
+	switch {
+	case suit != Spade && suit != Heart && suit != Diamond && suit != Club:
+		return ""
+	case plural:
+		return t.PluralSuits[suit.Index()]
+	}
+	return t.Suits[suit.Index()]
+}
+
+// VerbName satisfies the [WinTranslator] interface.
+func (t *NameTable) VerbName(verb string) string {
+	return t.Verbs[verb]
+}
+
+// verbName returns the translated name for the win verb.
+func verbName(verb string) string {
+	if t, ok := translator.(WinTranslator); ok {
+		if s := t.VerbName(verb); s != "" {
+			return s
+		}
+	}
+	return verb
+}
+
+// GermanNames are German rank, suit, and win verb names.
+var GermanNames = &NameTable{
+	Ranks: [13]string{
+		"Zwei", "Drei", "Vier", "Fünf", "Sechs", "Sieben", "Acht",
+		"Neun", "Zehn", "Bube", "Dame", "König", "Ass",
+	},
+	PluralRanks: [13]string{
+		"Zweien", "Dreien", "Vieren", "Fünfen", "Sechsen", "Siebenen", "Achten",
+		"Neunen", "Zehnen", "Buben", "Damen", "Könige", "Asse",
+	},
+	Suits:       [4]string{"Pik", "Herz", "Karo", "Kreuz"},
+	PluralSuits: [4]string{"Pik", "Herz", "Karo", "Kreuz"},
+	Verbs: map[string]string{
+		"wins":   "gewinnt",
+		"split":  "teilen",
+		"push":   "unentschieden",
+		"scoops": "gewinnt alles",
+		"none":   "keiner",
+	},
+}
+
+// FrenchNames are French rank, suit, and win verb names.
+var FrenchNames = &NameTable{
+	Ranks: [13]string{
+		"Deux", "Trois", "Quatre", "Cinq", "Six", "Sept", "Huit",
+		"Neuf", "Dix", "Valet", "Dame", "Roi", "As",
+	},
+	PluralRanks: [13]string{
+		"Deux", "Trois", "Quatre", "Cinq", "Six", "Sept", "Huit",
+		"Neuf", "Dix", "Valets", "Dames", "Rois", "As",
+	},
+	Suits:       [4]string{"Pique", "Cœur", "Carreau", "Trèfle"},
+	PluralSuits: [4]string{"Piques", "Cœurs", "Carreaux", "Trèfles"},
+	Verbs: map[string]string{
+		"wins":   "gagne",
+		"split":  "partagent",
+		"push":   "égalité",
+		"scoops": "rafle tout",
+		"none":   "aucun",
+	},
+}
+
+// SpanishNames are Spanish rank, suit, and win verb names.
+var SpanishNames = &NameTable{
+	Ranks: [13]string{
+		"Dos", "Tres", "Cuatro", "Cinco", "Seis", "Siete", "Ocho",
+		"Nueve", "Diez", "Jota", "Reina", "Rey", "As",
+	},
+	PluralRanks: [13]string{
+		"Doses", "Treses", "Cuatros", "Cincos", "Seises", "Sietes", "Ochos",
+		"Nueves", "Dieces", "Jotas", "Reinas", "Reyes", "Ases",
+	},
+	Suits:       [4]string{"Pica", "Corazón", "Diamante", "Trébol"},
+	PluralSuits: [4]string{"Picas", "Corazones", "Diamantes", "Tréboles"},
+	Verbs: map[string]string{
+		"wins":   "gana",
+		"split":  "dividen",
+		"push":   "empate",
+		"scoops": "se lleva todo",
+		"none":   "ninguno",
+	},
+}
@@ -0,0 +1,37 @@
+package cardrank
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTranslator(t *testing.T) {
+	defer SetTranslator(nil)
+	tests := []struct {
+		t   Translator
+		s   string
+		exp string
+	}{
+		{nil, "Jh", "Jack Jacks heart Hearts jack"},
+		{GermanNames, "Jh", "Bube Buben herz Herz bube"},
+		{GermanNames, "Kc", "König Könige kreuz Kreuz könig"},
+		{FrenchNames, "Qd", "Dame Dames carreau Carreaux dame"},
+		{FrenchNames, "Ah", "As As cœur Cœurs as"},
+		{SpanishNames, "Ks", "Rey Reyes pica Picas rey"},
+		{nil, "6s", "Six Sixes spade Spades six"},
+	}
+	for i, test := range tests {
+		SetTranslator(test.t)
+		c := FromString(test.s)
+		if s := fmt.Sprintf("%N %P %t %L %n", c, c, c, c, c); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+	}
+	SetTranslator(GermanNames)
+	if s, exp := InvalidRank.Name(), ""; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := InvalidSuit.PluralName(), ""; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build noinit
+
+package cardrank
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	Init()
+	os.Exit(m.Run())
+}
@@ -0,0 +1,402 @@
+package cardrank
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// OFCRow is an [OFC] row.
+type OFCRow uint8
+
+// OFC rows.
+const (
+	// OFCTop is the 3 card top row.
+	OFCTop OFCRow = iota
+	// OFCMiddle is the 5 card middle row.
+	OFCMiddle
+	// OFCBottom is the 5 card bottom row.
+	OFCBottom
+)
+
+// String satisfies the [fmt.Stringer] interface.
+func (row OFCRow) String() string {
+	switch row {
+	case OFCTop:
+		return "Top"
+	case OFCMiddle:
+		return "Middle"
+	case OFCBottom:
+		return "Bottom"
+	}
+	return fmt.Sprintf("OFCRow(%d)", int(row))
+}
+
+// Size returns the row's card count.
+func (row OFCRow) Size() int {
+	if row == OFCTop {
+		return 3
+	}
+	return 5
+}
+
+// ofcSize is the total card count of the [OFC] rows.
+const ofcSize = 13
+
+// ofcMaxRoyalties is the maximum [OFC] royalties (a Royal middle and bottom,
+// and trip Aces on top).
+const ofcMaxRoyalties = 50 + 25 + 22
+
+// ofcFouled is the [OFC] eval rank of a fouled hand.
+const ofcFouled = EvalRank(ofcMaxRoyalties + 2)
+
+// OFCHand tracks the placement of a position's cards in the top, middle, and
+// bottom rows of an [OFC] or [OFCPineapple] hand.
+//
+// Rows must increase in strength from top to bottom, otherwise the hand is
+// fouled (see [OFCHand.Fouled]). Completed hands are scored against each
+// other with [OFCScore].
+type OFCHand struct {
+	// Rows are the placed cards of the top, middle, and bottom rows.
+	Rows [3][]Card
+	// Discards are the discarded cards (see [OFCPineapple]).
+	Discards []Card
+}
+
+// NewOFCHand creates a new [OFC] hand.
+func NewOFCHand() *OFCHand {
+	return &OFCHand{}
+}
+
+// Place places the cards in the row. Returns [ErrInvalidCount] when the row
+// does not have room for the cards, [ErrInvalidCard] for invalid cards, or
+// [ErrDuplicateCard] when a card was already placed or discarded.
+func (h *OFCHand) Place(row OFCRow, cards ...Card) error {
+	switch {
+	case OFCBottom < row, row.Size() < len(h.Rows[row])+len(cards):
+		return ErrInvalidCount
+	}
+	if err := h.check(cards); err != nil {
+		return err
+	}
+	h.Rows[row] = append(h.Rows[row], cards...)
+	return nil
+}
+
+// Discard discards the cards. Returns [ErrInvalidCard] for invalid cards, or
+// [ErrDuplicateCard] when a card was already placed or discarded.
+func (h *OFCHand) Discard(cards ...Card) error {
+	if err := h.check(cards); err != nil {
+		return err
+	}
+	h.Discards = append(h.Discards, cards...)
+	return nil
+}
+
+// check checks that the cards are valid, and have not been placed or
+// discarded.
+func (h *OFCHand) check(cards []Card) error {
+	for i, c := range cards {
+		switch {
+		case c == InvalidCard, Ace < c.Rank():
+			return ErrInvalidCard
+		case slices.Contains(cards[i+1:], c),
+			slices.Contains(h.Rows[OFCTop], c),
+			slices.Contains(h.Rows[OFCMiddle], c),
+			slices.Contains(h.Rows[OFCBottom], c),
+			slices.Contains(h.Discards, c):
+			return ErrDuplicateCard
+		}
+	}
+	return nil
+}
+
+// Open returns the count of unplaced cards for the row.
+func (h *OFCHand) Open(row OFCRow) int {
+	return row.Size() - len(h.Rows[row])
+}
+
+// Complete returns true when all rows have been placed.
+func (h *OFCHand) Complete() bool {
+	return h.Open(OFCTop) == 0 && h.Open(OFCMiddle) == 0 && h.Open(OFCBottom) == 0
+}
+
+// Pocket returns the placed cards in row order (top, middle, then bottom),
+// followed by the discards, for use with [OFC] or [OFCPineapple] evals.
+func (h *OFCHand) Pocket() []Card {
+	return slices.Concat(h.Rows[OFCTop], h.Rows[OFCMiddle], h.Rows[OFCBottom], h.Discards)
+}
+
+// Fouled returns true when the completed hand's rows do not increase in
+// strength from top to bottom. Incomplete hands are not fouled.
+func (h *OFCHand) Fouled() bool {
+	if !h.Complete() {
+		return false
+	}
+	return newOFCRows(h.Pocket()).fouled()
+}
+
+// Royalties returns the royalties (bonus points) of the completed hand's
+// rows, or 0 when incomplete or fouled.
+//
+// Top royalties are 1 point for a pair of [Six]'s, increasing by 1 for each
+// pair rank through 9 points for a pair of [Ace]'s, and 10 points for trip
+// [Two]'s, increasing by 1 for each trips rank through 22 points for trip
+// [Ace]'s.
+//
+// Middle royalties are 2 points for [ThreeOfAKind], 4 for a [Straight], 8 for
+// a [Flush], 12 for a [FullHouse], 20 for [FourOfAKind], 30 for a
+// [StraightFlush], and 50 for a Royal.
+//
+// Bottom royalties are 2 points for a [Straight], 4 for a [Flush], 6 for a
+// [FullHouse], 10 for [FourOfAKind], 15 for a [StraightFlush], and 25 for a
+// Royal.
+func (h *OFCHand) Royalties() int {
+	if !h.Complete() {
+		return 0
+	}
+	return newOFCRows(h.Pocket()).royalties()
+}
+
+// Fantasyland returns true when the completed hand qualifies for
+// Fantasyland, having a pair of [Queen]'s or better on top without fouling.
+func (h *OFCHand) Fantasyland() bool {
+	if !h.Complete() {
+		return false
+	}
+	rows := newOFCRows(h.Pocket())
+	top := rows[OFCTop]
+	return !rows.fouled() && (top.rank == ThreeOfAKind || top.rank == Pair && Queen <= top.ranks[0])
+}
+
+// FantasylandRepeat returns true when the completed hand remains in
+// Fantasyland, having trips on top, a [FullHouse] or better in the middle, or
+// [FourOfAKind] or better on the bottom, without fouling.
+func (h *OFCHand) FantasylandRepeat() bool {
+	if !h.Complete() {
+		return false
+	}
+	rows := newOFCRows(h.Pocket())
+	return !rows.fouled() && (rows[OFCTop].rank == ThreeOfAKind ||
+		rows[OFCMiddle].rank <= FullHouse ||
+		rows[OFCBottom].rank <= FourOfAKind)
+}
+
+// OFCScore returns the points won by completed hand a from completed hand b,
+// using standard 1-6 scoring. Each row won is worth 1 point, with 3
+// additional points when winning all rows (a scoop), plus the difference in
+// royalties (see [OFCHand.Royalties]). A fouled hand loses all rows to a hand
+// that is not fouled. The points won by b are the negation of the points won
+// by a.
+func OFCScore(a, b *OFCHand) int {
+	if !a.Complete() || !b.Complete() {
+		return 0
+	}
+	ra, rb := newOFCRows(a.Pocket()), newOFCRows(b.Pocket())
+	fa, fb := ra.fouled(), rb.fouled()
+	switch {
+	case fa && fb:
+		return 0
+	case fa:
+		return -6 - rb.royalties()
+	case fb:
+		return 6 + ra.royalties()
+	}
+	var n int
+	for i := range ra {
+		n -= ra[i].comp(rb[i])
+	}
+	switch n {
+	case 3:
+		n = 6
+	case -3:
+		n = -6
+	}
+	return n + ra.royalties() - rb.royalties()
+}
+
+// ofcRow is an evaluated [OFC] row.
+type ofcRow struct {
+	// rank is the Cactus rank of a 5 card row, or the category of a 3 card
+	// row.
+	rank EvalRank
+	// ranks are the card ranks, ordered by count and then by rank.
+	ranks []Rank
+}
+
+// newOFCRow evaluates the 3 or 5 cards in v.
+func newOFCRow(v []Card) ofcRow {
+	counts := make(map[Rank]int)
+	ranks := make([]Rank, len(v))
+	for i, c := range v {
+		ranks[i] = c.Rank()
+		counts[ranks[i]]++
+	}
+	slices.SortFunc(ranks, func(a, b Rank) int {
+		if n := cmp.Compare(counts[b], counts[a]); n != 0 {
+			return n
+		}
+		return cmp.Compare(b, a)
+	})
+	row := ofcRow{
+		ranks: ranks,
+	}
+	switch {
+	case len(v) == 5:
+		row.rank = RankCactus(v[0], v[1], v[2], v[3], v[4])
+	case counts[ranks[0]] == 3:
+		row.rank = ThreeOfAKind
+	case counts[ranks[0]] == 2:
+		row.rank = Pair
+	default:
+		row.rank = Nothing
+	}
+	return row
+}
+
+// comp compares the row to b, returning -1 when the row is stronger, 1 when
+// b is stronger, or 0 when equal. A 3 card row is compared to a 5 card row by
+// category, and then by the card ranks of the 3 card row.
+func (row ofcRow) comp(b ofcRow) int {
+	if len(row.ranks) == len(b.ranks) && len(row.ranks) == 5 {
+		return cmp.Compare(row.rank, b.rank)
+	}
+	if n := cmp.Compare(row.rank.Fixed(), b.rank.Fixed()); n != 0 {
+		return n
+	}
+	for i := range min(len(row.ranks), len(b.ranks)) {
+		if n := cmp.Compare(b.ranks[i], row.ranks[i]); n != 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+// royalty returns the row's royalty.
+func (row ofcRow) royalty(typ OFCRow) int {
+	if typ == OFCTop {
+		switch {
+		case row.rank == ThreeOfAKind:
+			return 10 + int(row.ranks[0]-Two)
+		case row.rank == Pair && Six <= row.ranks[0]:
+			return 1 + int(row.ranks[0]-Six)
+		}
+		return 0
+	}
+	i := 0
+	if typ == OFCMiddle {
+		i = 1
+	}
+	switch r := row.rank; {
+	case r == 1:
+		return [...]int{25, 50}[i]
+	case r <= StraightFlush:
+		return [...]int{15, 30}[i]
+	case r <= FourOfAKind:
+		return [...]int{10, 20}[i]
+	case r <= FullHouse:
+		return [...]int{6, 12}[i]
+	case r <= Flush:
+		return [...]int{4, 8}[i]
+	case r <= Straight:
+		return [...]int{2, 4}[i]
+	case r <= ThreeOfAKind:
+		return [...]int{0, 2}[i]
+	}
+	return 0
+}
+
+// ofcRows are evaluated [OFC] rows.
+type ofcRows [3]ofcRow
+
+// newOFCRows evaluates the first 13 cards of v as the top, middle, and bottom
+// rows.
+func newOFCRows(v []Card) ofcRows {
+	return ofcRows{
+		newOFCRow(v[:3]),
+		newOFCRow(v[3:8]),
+		newOFCRow(v[8:13]),
+	}
+}
+
+// fouled returns true when the rows do not increase in strength.
+func (rows ofcRows) fouled() bool {
+	return rows[OFCTop].comp(rows[OFCMiddle]) < 0 || rows[OFCMiddle].comp(rows[OFCBottom]) < 0
+}
+
+// royalties returns the total royalties of the rows, or 0 when fouled.
+func (rows ofcRows) royalties() int {
+	if rows.fouled() {
+		return 0
+	}
+	var n int
+	for i, row := range rows {
+		n += row.royalty(OFCRow(i))
+	}
+	return n
+}
+
+// NewOFCEval creates a [OFC] eval func, evaluating the first 13 pocket cards
+// as the placed top (3 cards), middle (5 cards), and bottom (5 cards) rows
+// (see [OFCHand.Pocket]). Any remaining pocket cards are unused (ie,
+// discarded). Unlike other evals, the pocket order is significant.
+//
+// As [OFC] hands are scored against each other row by row (see [OFCScore]),
+// the rank orders completed hands by royalties, with fouled hands ranked
+// worst.
+func NewOFCEval() EvalFunc {
+	return func(ev *Eval, p, _ []Card) {
+		if len(p) < ofcSize {
+			return
+		}
+		rows := newOFCRows(p)
+		switch {
+		case rows.fouled():
+			ev.HiRank = ofcFouled
+		default:
+			ev.HiRank = EvalRank(ofcMaxRoyalties-rows.royalties()) + 1
+		}
+		ev.HiBest, ev.HiUnused = p[:ofcSize:ofcSize], p[ofcSize:]
+	}
+}
+
+// OFCDesc writes a [OFC] description to f for the rank, best, and unused
+// cards.
+//
+// Examples:
+//
+//	Pair, Queens / Straight, Nine-high / Flush, Ace-high (13 royalties)
+//	Ace-high / Two Pair, Nines over Sixes / Full House (6 royalties)
+//	Fouled
+func OFCDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
+	switch {
+	case rank == 0, ofcFouled < rank, len(best) != ofcSize:
+		_, _ = f.Write([]byte("None"))
+		return
+	case rank == ofcFouled:
+		_, _ = f.Write([]byte("Fouled"))
+		return
+	case verb == 'e':
+		fmt.Fprintf(f, "%d royalties", ofcMaxRoyalties-int(rank)+1)
+		return
+	}
+	// top
+	top := newOFCRow(best[:3])
+	switch r := top.ranks[0]; top.rank {
+	case ThreeOfAKind:
+		fmt.Fprintf(f, "Three of a Kind, %s", r.PluralName())
+	case Pair:
+		fmt.Fprintf(f, "Pair, %s", r.PluralName())
+	default:
+		fmt.Fprintf(f, "%s-high", r.Name())
+	}
+	// middle and bottom
+	for _, v := range [][]Card{best[3:8], best[8:13]} {
+		_, _ = f.Write([]byte(" / "))
+		v = slices.Clone(v)
+		r := RankCactus(v[0], v[1], v[2], v[3], v[4])
+		bestCactus(r, v, nil, 0, nil)
+		CactusDesc(f, 'S', r, v, nil)
+	}
+	fmt.Fprintf(f, " (%d royalties)", ofcMaxRoyalties-int(rank)+1)
+}
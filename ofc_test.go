@@ -0,0 +1,229 @@
+package cardrank
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestOFCHand(t *testing.T) {
+	tests := []struct {
+		top, middle, bottom string
+		fouled              bool
+		royalties           int
+		fantasyland         bool
+		repeat              bool
+		s                   string
+	}{
+		{"Kd 3h 2d", "Kc 9s 8c 6h 2h", "6s 6d Ts 7c 8h", false, 0, false, false, "King-high / King-high / Pair, Sixes (0 royalties)"},
+		{"Ah 2d Jd", "Qc Tc 9h 8d 3d", "5c 5s Jc Jh 9c", true, 0, false, false, "Fouled"},
+		{"6h 6d Ac", "7s 7d 3c 3h 2c", "9h 9c 9d 4s 4d", false, 1 + 6, false, false, "Pair, Sixes / Two Pair, Sevens over Threes / Full House (7 royalties)"},
+		{"Qh Qd 2c", "9s 9d 9c 4h 2s", "Ah Kh Jh 8h 3h", false, 7 + 2 + 4, true, false, "Pair, Queens / Three of a Kind, Nines / Flush, Ace-high (13 royalties)"},
+		{"Qh Qd 2c", "Ks Kd 3c 4h 2s", "Ah Kh Jh 8h 3h", false, 7 + 4, true, false, "Pair, Queens / Pair, Kings / Flush, Ace-high (11 royalties)"},
+		{"Qh Qd 2c", "Qs Jd 3c 4h 2s", "Ah Kh Jh 8h 3h", true, 0, false, false, "Fouled"},
+		{"Qh Qd Kc", "Qs Qc Ac 4h 2s", "Ah Kh Jh 8h 3h", false, 7 + 4, true, false, "Pair, Queens / Pair, Queens / Flush, Ace-high (11 royalties)"},
+		{"Qh Qd Ac", "Qs Qc Kc 4h 2s", "Ah Kh Jh 8h 3h", true, 0, false, false, "Fouled"},
+		{"2h 2d 2c", "5s 6s 7s 8s 9s", "Ah Kh Qh Jh Th", false, 10 + 30 + 25, true, true, "Three of a Kind, Twos / Straight Flush, Nine-high / Straight Flush, Ace-high (65 royalties)"},
+		{"As Ad Ac", "Ks Qs Js Ts 9s", "Ah Kh Qh Jh Th", false, 22 + 30 + 25, true, true, "Three of a Kind, Aces / Straight Flush, King-high / Straight Flush, Ace-high (77 royalties)"},
+		{"Js 5d 3c", "Ks Kd Kc 4h 4s", "Ah Ad Ac As Th", false, 12 + 10, false, true, "Jack-high / Full House / Four of a Kind, Aces (22 royalties)"},
+		{"As Kd 3c", "As Kd 4c 3h 2s", "Ah Kh Jh 8h 3h", false, 4, false, false, "Ace-high / Ace-high / Flush, Ace-high (4 royalties)"},
+		{"As Kd 5c", "As Kd 4c 3h 2s", "Ah Kh Jh 8h 3h", true, 0, false, false, "Fouled"},
+		{"7s 5d 3c", "As 2d 3c 4h 5s", "9h 8c 7d 6h 5c", false, 2 + 4, false, false, "Seven-high / Straight, Five-high / Straight, Nine-high (6 royalties)"},
+	}
+	for i, test := range tests {
+		h := &OFCHand{Rows: [3][]Card{Must(test.top), Must(test.middle), Must(test.bottom)}}
+		if b := h.Fouled(); b != test.fouled {
+			t.Errorf("test %d expected fouled %t, got: %t", i, test.fouled, b)
+		}
+		if n := h.Royalties(); n != test.royalties {
+			t.Errorf("test %d expected royalties %d, got: %d", i, test.royalties, n)
+		}
+		if b := h.Fantasyland(); b != test.fantasyland {
+			t.Errorf("test %d expected fantasyland %t, got: %t", i, test.fantasyland, b)
+		}
+		if b := h.FantasylandRepeat(); b != test.repeat {
+			t.Errorf("test %d expected fantasyland repeat %t, got: %t", i, test.repeat, b)
+		}
+		ev := OFC.Eval(h.Pocket(), nil)
+		if s := fmt.Sprintf("%s", ev.Desc(false)); s != test.s {
+			t.Errorf("test %d expected %q, got: %q", i, test.s, s)
+		}
+		if exp := EvalRank(ofcMaxRoyalties-test.royalties) + 1; !test.fouled && ev.HiRank != exp {
+			t.Errorf("test %d expected rank %d, got: %d", i, exp, ev.HiRank)
+		}
+	}
+}
+
+func TestOFCHandPlace(t *testing.T) {
+	h := NewOFCHand()
+	tests := []struct {
+		row   OFCRow
+		cards string
+		err   error
+	}{
+		{OFCTop, "Ah Kh", nil},
+		{OFCTop, "Qh Jh", ErrInvalidCount},
+		{OFCMiddle, "Ah", ErrDuplicateCard},
+		{OFCMiddle, "2c 2c", ErrDuplicateCard},
+		{OFCBottom + 1, "2c", ErrInvalidCount},
+		{OFCBottom, "2c 3c 4c 5c 6c", nil},
+		{OFCBottom, "7c", ErrInvalidCount},
+	}
+	for i, test := range tests {
+		if err := h.Place(test.row, Must(test.cards)...); !errors.Is(err, test.err) {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+	if err := h.Place(OFCMiddle, InvalidCard); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("expected %v, got: %v", ErrInvalidCard, err)
+	}
+	if err := h.Discard(Must("Kh")...); !errors.Is(err, ErrDuplicateCard) {
+		t.Errorf("expected %v, got: %v", ErrDuplicateCard, err)
+	}
+	if err := h.Discard(Must("Td")...); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	if n, exp := h.Open(OFCTop), 1; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+	if n, exp := h.Open(OFCMiddle), 5; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+	if h.Complete() || h.Fouled() || h.Royalties() != 0 || h.Fantasyland() {
+		t.Errorf("expected incomplete hand to not be fouled, and have no royalties or fantasyland")
+	}
+	if s, exp := fmt.Sprintf("%s", h.Pocket()), "[Ah Kh 2c 3c 4c 5c 6c Td]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+}
+
+func TestOFCScore(t *testing.T) {
+	hand := func(top, middle, bottom string) *OFCHand {
+		return &OFCHand{Rows: [3][]Card{Must(top), Must(middle), Must(bottom)}}
+	}
+	tests := []struct {
+		a, b *OFCHand
+		exp  int
+	}{
+		// scoop, plus royalties
+		{
+			hand("Qh Qd 2c", "9s 9d 9c 4h 2s", "Ah Kh Jh 8h 3h"),
+			hand("Kd 3h 2d", "Kc Ts 8c 6h 2h", "6s 6c Ts 7c 8h"),
+			6 + 13,
+		},
+		// 2 rows to 1
+		{
+			hand("Kd 3h 2d", "Kc Ts 8c 6h 2h", "Ah Kh Jh 8h 3h"),
+			hand("Qh Qd 2c", "Qs Qc 9c 4h 2s", "Ks Kd Ts 7c 8h"),
+			-1 + 4 - 7,
+		},
+		// 2 rows to 1, fouled
+		{
+			hand("Kd 3h 2d", "Kc Ts 8c 6h 2h", "Ah Kh Jh 8h 3h"),
+			hand("Qh Qd 2c", "Qs Qc 9c 4h 2s", "6s 6c Ts 7c 8h"),
+			6 + 4,
+		},
+		// split rows
+		{
+			hand("Kd 3h 2d", "Kc Ts 8c 6h 2h", "Ah Qs Js 8s 3d"),
+			hand("Ks 3c 2c", "Kh Th 8d 6d 2s", "Ac Jc 9c 7c 4c"),
+			-1 - 4,
+		},
+		// fouled
+		{
+			hand("Ah 2d Jd", "Qc Tc 9h 8d 3d", "5c 5s Jc Jh 9c"),
+			hand("Kd 3h 2d", "Kc Ts 8c 6h 2h", "Ah Kh Jh 8h 3h"),
+			-6 - 4,
+		},
+		// both fouled
+		{
+			hand("Ah 2d Jd", "Qc Tc 9h 8d 3d", "5c 5s Jc Jh 9c"),
+			hand("Ah 2d Jd", "Qc Tc 9h 8d 3d", "5c 5s Jc Jh 9c"),
+			0,
+		},
+		// incomplete
+		{
+			hand("Ah", "", ""),
+			hand("Kd 3h 2d", "Kc Ts 8c 6h 2h", "Ah Kh Jh 8h 3h"),
+			0,
+		},
+	}
+	for i, test := range tests {
+		if n := OFCScore(test.a, test.b); n != test.exp {
+			t.Errorf("test %d expected %d, got: %d", i, test.exp, n)
+		}
+		if n := OFCScore(test.b, test.a); n != -test.exp {
+			t.Errorf("test %d expected %d, got: %d", i, -test.exp, n)
+		}
+	}
+}
+
+func TestOFCDealer(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		count   int
+		streets int
+		pocket  int
+	}{
+		{OFC, 4, 9, 13},
+		{OFCPineapple, 3, 5, 17},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.Name(), func(t *testing.T) {
+			d := test.typ.Dealer(rand.New(rand.NewPCG(1, 2)), 1, test.count)
+			if n := len(d.Streets); n != test.streets {
+				t.Fatalf("expected %d streets, got: %d", test.streets, n)
+			}
+			hands := make([]*OFCHand, test.count)
+			for i := range hands {
+				hands[i] = NewOFCHand()
+			}
+			for d.Next() {
+				_, run := d.Run()
+				for i, h := range hands {
+					// place the dealt cards, filling the bottom first and
+					// discarding the last dealt card for pineapple
+					v := run.Pockets[i][len(h.Pocket()):]
+					if test.typ == OFCPineapple && d.Street() != 0 {
+						if err := h.Discard(v[2]); err != nil {
+							t.Fatalf("expected no error, got: %v", err)
+						}
+						v = v[:2]
+					}
+					for _, c := range v {
+						row := OFCBottom
+						for ; h.Open(row) == 0; row-- {
+						}
+						if err := h.Place(row, c); err != nil {
+							t.Fatalf("expected no error, got: %v", err)
+						}
+					}
+				}
+			}
+			for i, h := range hands {
+				if !h.Complete() {
+					t.Fatalf("expected hand %d to be complete", i)
+				}
+				if n := len(h.Pocket()); n != test.pocket {
+					t.Errorf("expected %d pocket cards, got: %d", test.pocket, n)
+				}
+				ev := test.typ.Eval(h.Pocket(), nil)
+				if exp := EvalRank(ofcMaxRoyalties-h.Royalties()) + 1; h.Fouled() && ev.HiRank != ofcFouled || !h.Fouled() && ev.HiRank != exp {
+					t.Errorf("hand %d unexpected rank %d", i, ev.HiRank)
+				}
+			}
+			var total int
+			for i := range hands {
+				for j := range hands {
+					if i != j {
+						total += OFCScore(hands[i], hands[j])
+					}
+				}
+			}
+			if total != 0 {
+				t.Errorf("expected zero sum scores, got: %d", total)
+			}
+		})
+	}
+}
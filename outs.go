@@ -0,0 +1,64 @@
+package cardrank
+
+import (
+	"slices"
+)
+
+// Outs returns the cards that improve the Hi rank category (see
+// [EvalRank.Fixed]) of the pocket and board for the type when dealt as the
+// next board card, keyed by the improved category. Cards in the pocket, board,
+// or any dead cards are not outs. The type must deal a board and have a
+// Cactus eval (see [Type.Cactus]), such as [Holdem] or [Omaha].
+//
+// For example, a 2 card pocket having a flush draw on the flop will have the
+// 9 remaining cards of the suit as [Flush] outs, and an inside straight draw
+// will have 4 [Straight] outs. Outs making a straight flush are only
+// [StraightFlush] outs. Cards that improve the category using only the board
+// (ie, pairing the board) are included.
+//
+// Returns nil when the board has less than 3 cards, the board is complete, or
+// the type is not supported.
+func Outs(typ Type, pocket, board []Card, dead ...[]Card) map[EvalRank][]Card {
+	desc, ok := descs[typ]
+	if !ok || !desc.Eval.Cactus() || len(board) < 3 || desc.board <= len(board) {
+		return nil
+	}
+	ev := GetEval(typ)
+	defer PutEval(ev)
+	// category returns the category of the pocket and board
+	category := func(b []Card) EvalRank {
+		ev.Reset(typ)
+		if desc.Eval == EvalOmaha {
+			ev.Eval(pocket, b)
+		} else {
+			// evaluate as a single hand, as incomplete boards are otherwise
+			// ranked by pocket only
+			ev.Eval(slices.Concat(pocket, b), nil)
+		}
+		return typ.cactusRank(ev.HiRank).Fixed()
+	}
+	cat := category(board)
+	known := slices.Concat(append(slices.Clone(dead), pocket, board)...)
+	v := make([]Card, len(board)+1)
+	copy(v, board)
+	outs := make(map[EvalRank][]Card)
+	for _, c := range typ.DeckType().Exclude(known) {
+		v[len(board)] = c
+		if r := category(v); r < cat {
+			outs[r] = append(outs[r], c)
+		}
+	}
+	return outs
+}
+
+// cactusRank converts the type's Hi eval rank to a Cactus rank, undoing any
+// FlushOver or TripsOver ordering.
+func (typ Type) cactusRank(r EvalRank) EvalRank {
+	if typ.FlushOver() {
+		r = r.FromFlushOver()
+	}
+	if typ.TripsOver() {
+		r = r.FromTripsOver()
+	}
+	return r
+}
@@ -0,0 +1,71 @@
+package cardrank
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestOuts(t *testing.T) {
+	tests := []struct {
+		typ    Type
+		pocket string
+		board  string
+		dead   string
+		exp    map[EvalRank]string
+	}{
+		{
+			Holdem, "Ah Kh", "2h 7h Qc", "",
+			map[EvalRank]string{
+				Flush: "[3h 4h 5h 6h 8h 9h Th Jh Qh]",
+				Pair:  "[2s 7s Qs Ks As 2d 7d Qd Kd Ad 2c 7c Kc Ac]",
+			},
+		},
+		{
+			Holdem, "Ah Kh", "2h 7h Qc", "Qh Qs 3h",
+			map[EvalRank]string{
+				Flush: "[4h 5h 6h 8h 9h Th Jh]",
+				Pair:  "[2s 7s Ks As 2d 7d Qd Kd Ad 2c 7c Kc Ac]",
+			},
+		},
+		{
+			Holdem, "9c 8d", "Jh 5s Qc 2d", "",
+			map[EvalRank]string{
+				Straight: "[Ts Th Td Tc]",
+				Pair:     "[2s 8s 9s Js Qs 2h 5h 8h 9h Qh 5d 9d Jd Qd 2c 5c 8c Jc]",
+			},
+		},
+		{
+			Holdem, "6h 6d", "6c Kd Ks", "",
+			map[EvalRank]string{
+				FourOfAKind: "[6s]",
+			},
+		},
+		{
+			Omaha, "Ah Kh 2c 3d", "Qh 7h 8s", "",
+			map[EvalRank]string{
+				Flush: "[2h 3h 4h 5h 6h 8h 9h Th Jh]",
+				Pair:  "[2s 3s 7s Qs Ks As 2d 7d 8d Qd Kd Ad 3c 7c 8c Qc Kc Ac]",
+			},
+		},
+		{Holdem, "Ah Kh", "2h 7h", "", nil},
+		{Holdem, "Ah Kh", "2h 7h Qc Jc 2c", "", nil},
+		{Badugi, "Ah Kh 2c 3d", "", "", nil},
+	}
+	for i, test := range tests {
+		outs := Outs(test.typ, Must(test.pocket), Must(test.board), Must(test.dead))
+		switch {
+		case test.exp == nil && outs != nil:
+			t.Errorf("test %d expected nil, got: %v", i, outs)
+		case test.exp == nil:
+			continue
+		}
+		if len(outs) != len(test.exp) {
+			t.Errorf("test %d expected %d categories, got: %d", i, len(test.exp), len(outs))
+		}
+		for r, exp := range test.exp {
+			if s := fmt.Sprintf("%s", outs[r]); s != exp {
+				t.Errorf("test %d expected %s outs %s, got: %s", i, r.Title(), exp, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package cardrank
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Percentile returns the proportion (0 to 1) of all 5 card hands dealt from
+// the type's deck that the Hi rank beats, such as for displaying "your hand
+// beats 92% of hands". Hands having the same rank are not counted as beaten.
+// The distribution is calculated on first use for the type, by ranking each
+// 5 card combination of the type's deck with the type's 5 card rank func.
+//
+// Returns -1 when the type's eval does not rank 5 card hands (ie, [Badugi]).
+func (r EvalRank) Percentile(typ Type) float64 {
+	desc, ok := descs[typ]
+	if !ok {
+		return -1
+	}
+	f := desc.Eval.rankFunc()
+	if f == nil {
+		return -1
+	}
+	v, _ := percentiles.LoadOrStore(typ, new(rankDist))
+	dist := v.(*rankDist)
+	dist.once.Do(func() {
+		dist.init(f, desc.Deck.v())
+	})
+	i, ok := slices.BinarySearch(dist.ranks, r)
+	if ok {
+		i++
+	}
+	return float64(dist.total-dist.counts[i]) / float64(dist.total)
+}
+
+// Percentile returns the proportion of all 5 card hands for the eval's type
+// that the eval's Hi rank beats (see [EvalRank.Percentile]).
+func (ev *Eval) Percentile() float64 {
+	return ev.HiRank.Percentile(ev.Type)
+}
+
+// rankFunc returns the eval type's 5 card rank func, or nil when the eval
+// does not rank 5 card hands.
+func (typ EvalType) rankFunc() RankFunc {
+	switch typ {
+	case EvalCactus, EvalJacksOrBetter, EvalOmaha:
+		return RankCactus
+	case EvalShort:
+		return RankShort
+	case EvalShortTrips:
+		return RankShortTrips
+	case EvalManila:
+		return RankManila
+	case EvalSpanish:
+		return RankSpanish
+	case EvalSoko:
+		return RankSoko
+	case EvalLowball:
+		return RankLowball
+	case EvalRazz:
+		return RankRazz
+	}
+	return nil
+}
+
+// percentiles are the rank distributions for each type.
+var percentiles sync.Map
+
+// rankDist is a rank distribution of 5 card hands.
+type rankDist struct {
+	once sync.Once
+	// ranks are the distinct ranks, best (lowest) first.
+	ranks []EvalRank
+	// counts are the cumulative counts of hands ranked better than each
+	// rank, with the last being the total.
+	counts []int
+	total  int
+}
+
+// init inits the distribution, ranking each 5 card combination of the deck.
+func (dist *rankDist) init(f RankFunc, v []Card) {
+	m := make(map[EvalRank]int)
+	n := len(v)
+	for c0 := 0; c0 < n; c0++ {
+		for c1 := c0 + 1; c1 < n; c1++ {
+			for c2 := c1 + 1; c2 < n; c2++ {
+				for c3 := c2 + 1; c3 < n; c3++ {
+					for c4 := c3 + 1; c4 < n; c4++ {
+						m[f(v[c0], v[c1], v[c2], v[c3], v[c4])]++
+					}
+				}
+			}
+		}
+	}
+	dist.ranks = slices.Sorted(maps.Keys(m))
+	dist.counts = make([]int, len(dist.ranks)+1)
+	for i, r := range dist.ranks {
+		dist.counts[i+1] = dist.counts[i] + m[r]
+	}
+	dist.total = dist.counts[len(dist.ranks)]
+}
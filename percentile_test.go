@@ -0,0 +1,36 @@
+package cardrank
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvalRankPercentile(t *testing.T) {
+	const total = 2598960
+	tests := []struct {
+		typ Type
+		r   EvalRank
+		exp float64
+	}{
+		{Holdem, 1, float64(total-4) / total},
+		{Holdem, StraightFlush, float64(total-40) / total},
+		{Holdem, Pair, 1302540.0 / total},
+		{Holdem, Nothing, 0},
+		{Holdem, Invalid, 0},
+		{Omaha, Pair + 1, (1302540.0 - 1020) / total},
+		{Short, 1, float64(376992-4) / 376992},
+		{Short, Straight.ToFlushOver(), (16128 + 36288 + 193536 + 122400) / 376992.0},
+		{ShortTrips, ThreeOfAKind.ToTripsOver().ToFlushOver(), (6120 + 36288 + 193536 + 122400) / 376992.0},
+		{Badugi, 1, -1},
+		{Type(0), 1, -1},
+	}
+	for i, test := range tests {
+		if p := test.r.Percentile(test.typ); math.Abs(p-test.exp) > 1e-9 {
+			t.Errorf("test %d %s expected %f, got: %f", i, test.typ, test.exp, p)
+		}
+	}
+	ev := Holdem.Eval(Must("As Ah Kd Qc 3s 7d 2c"), nil)
+	if p, exp := ev.Percentile(), ev.HiRank.Percentile(Holdem); p != exp || p < 0.9 || 0.95 < p {
+		t.Errorf("expected %f, got: %f", exp, p)
+	}
+}
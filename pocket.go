@@ -0,0 +1,439 @@
+package cardrank
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// PocketTexture contains structure metrics for pocket (hole) cards, such as a
+// 2 card Holdem pocket or a 4 card Omaha pocket.
+type PocketTexture struct {
+	// Count is the number of cards.
+	Count int
+	// Pairs is the number of ranks occurring 2 or more times.
+	Pairs int
+	// Trips is true when any rank occurs 3 or more times.
+	Trips bool
+	// Suited is the number of suits occurring 2 or more times.
+	Suited int
+	// Monotone is true when all cards are of the same suit.
+	Monotone bool
+	// Rainbow is true when all cards are of different suits.
+	Rainbow bool
+	// Gaps is the total number of ranks missing between the distinct ranks,
+	// with Aces counted low when that results in fewer gaps.
+	Gaps int
+	// MaxGap is the largest number of ranks missing between any 2 adjacent
+	// distinct ranks.
+	MaxGap int
+	// Connected is the number of adjacent distinct ranks having no gap.
+	Connected int
+}
+
+// NewPocketTexture creates the structure metrics for the pocket.
+func NewPocketTexture(pocket []Card) PocketTexture {
+	t := PocketTexture{
+		Count: len(pocket),
+	}
+	ranks, suits := make(map[Rank]int), make(map[Suit]int)
+	for _, c := range pocket {
+		ranks[c.Rank()]++
+		suits[c.Suit()]++
+	}
+	for _, n := range ranks {
+		if 2 <= n {
+			t.Pairs++
+		}
+		if 3 <= n {
+			t.Trips = true
+		}
+	}
+	for _, n := range suits {
+		if 2 <= n {
+			t.Suited++
+		}
+	}
+	t.Monotone = 1 < len(pocket) && len(suits) == 1
+	t.Rainbow = len(suits) == len(pocket)
+	var hi, lo []int
+	for r := range ranks {
+		hi, lo = append(hi, int(r)), append(lo, (int(r)+1)%13)
+	}
+	t.Gaps, t.MaxGap, t.Connected = gaps(hi)
+	if _, ok := ranks[Ace]; ok {
+		if g, m, c := gaps(lo); g < t.Gaps {
+			t.Gaps, t.MaxGap, t.Connected = g, m, c
+		}
+	}
+	return t
+}
+
+// Pair returns true when the pocket contains a pair.
+func (t PocketTexture) Pair() bool {
+	return t.Pairs != 0
+}
+
+// DoubleSuited returns true when the pocket has exactly 2 suits, each
+// occurring 2 times (ex: AsKsQhJh).
+func (t PocketTexture) DoubleSuited() bool {
+	return t.Count == 4 && t.Suited == 2
+}
+
+// Rundown returns true when all cards are of distinct ranks with no gaps
+// (ex: JT98).
+func (t PocketTexture) Rundown() bool {
+	return 1 < t.Count && t.Pairs == 0 && t.Gaps == 0
+}
+
+// Playability returns a descriptor of the pocket's structure.
+//
+// For 2 card pockets, returns one of Pocket Pair, Suited Connector, Suited
+// One-Gapper, Suited, Offsuit Connector, Offsuit One-Gapper, or Offsuit.
+//
+// For other pockets, returns the suit texture (Monotone, Double-Suited,
+// Single-Suited, Rainbow) followed by the rank texture (Trips,
+// Double-Paired, Paired, Rundown, Connected, or Disconnected), for example
+// Double-Suited Rundown.
+func (t PocketTexture) Playability() string {
+	if t.Count == 2 {
+		suited := "Offsuit"
+		if t.Suited != 0 {
+			suited = "Suited"
+		}
+		switch {
+		case t.Pairs != 0:
+			return "Pocket Pair"
+		case t.Gaps == 0:
+			return suited + " Connector"
+		case t.Gaps == 1:
+			return suited + " One-Gapper"
+		}
+		return suited
+	}
+	var v []string
+	switch {
+	case t.Monotone:
+		v = append(v, "Monotone")
+	case t.DoubleSuited():
+		v = append(v, "Double-Suited")
+	case t.Suited != 0:
+		v = append(v, "Single-Suited")
+	default:
+		v = append(v, "Rainbow")
+	}
+	switch {
+	case t.Trips:
+		v = append(v, "Trips")
+	case t.Pairs == 2:
+		v = append(v, "Double-Paired")
+	case t.Pairs != 0:
+		v = append(v, "Paired")
+	case t.Gaps == 0:
+		v = append(v, "Rundown")
+	case t.MaxGap <= 2:
+		v = append(v, "Connected")
+	default:
+		v = append(v, "Disconnected")
+	}
+	return strings.Join(v, " ")
+}
+
+// sklansky are the Sklansky-Malmuth starting pocket groups.
+var sklansky = [8]string{
+	"AA KK QQ JJ AKs",
+	"TT AQs AJs KQs AKo",
+	"99 ATs KJs QJs JTs AQo",
+	"88 KTs QTs J9s T9s 98s AJo KQo",
+	"77 A9s A8s A7s A6s A5s A4s A3s A2s Q9s T8s 97s 87s 76s KJo QJo JTo",
+	"66 55 K9s J8s 86s 75s 54s ATo KTo QTo",
+	"44 33 22 K8s K7s K6s K5s K4s K3s K2s Q8s T7s 64s 53s 43s J9o T9o 98o",
+	"J7s 96s 85s 74s 42s 32s A9o K9o Q9o J8o T8o 87o 76o 65o 54o",
+}
+
+// sklanskyGroups is the map of starting pocket keys to Sklansky-Malmuth
+// groups, lazily built on first use.
+var (
+	sklanskyGroups map[string]int
+	sklanskyOnce   sync.Once
+)
+
+// loadSklansky loads the Sklansky-Malmuth groups.
+func loadSklansky() {
+	sklanskyOnce.Do(func() {
+		sklanskyGroups = make(map[string]int)
+		for i, s := range sklansky {
+			for _, key := range strings.Fields(s) {
+				sklanskyGroups[key] = i + 1
+			}
+		}
+	})
+}
+
+// SklanskyGroup returns the Sklansky-Malmuth group (1-8) for a 2 card Holdem
+// pocket, 9 when the pocket is not in any group, or 0 when the pocket is
+// invalid.
+func SklanskyGroup(pocket []Card) int {
+	if len(pocket) != 2 || !pocket[0].Valid() || !pocket[1].Valid() || pocket[0] == pocket[1] {
+		return 0
+	}
+	loadSklansky()
+	if group, ok := sklanskyGroups[HashKey(pocket[0], pocket[1])]; ok {
+		return group
+	}
+	return 9
+}
+
+// ChenScore returns the Chen formula score for a 2 card Holdem pocket, or 0
+// when the pocket is invalid.
+//
+// Scores the highest card (Ace 10, King 8, Queen 7, Jack 6, otherwise half
+// the card's value), doubles pairs (minimum 5), adds 2 when suited, subtracts
+// 1, 2, 4, or 5 for 1, 2, 3, or 4 or more gaps, adds 1 for connected or one
+// gap pockets below a [Queen], then rounds half points up. Scores range from
+// -1 (72o) to 20 (AA).
+func ChenScore(pocket []Card) int {
+	if len(pocket) != 2 || !pocket[0].Valid() || !pocket[1].Valid() || pocket[0] == pocket[1] {
+		return 0
+	}
+	r0, r1 := pocket[0].Rank(), pocket[1].Rank()
+	if r0 < r1 {
+		r0, r1 = r1, r0
+	}
+	var score float64
+	switch r0 {
+	case Ace:
+		score = 10
+	case King:
+		score = 8
+	case Queen:
+		score = 7
+	case Jack:
+		score = 6
+	default:
+		score = float64(r0+2) / 2
+	}
+	if r0 == r1 {
+		return int(math.Ceil(max(score*2, 5)))
+	}
+	if pocket[0].Suit() == pocket[1].Suit() {
+		score += 2
+	}
+	switch gap := r0 - r1 - 1; {
+	case gap == 1:
+		score--
+	case gap == 2:
+		score -= 2
+	case gap == 3:
+		score -= 4
+	case 4 <= gap:
+		score -= 5
+	}
+	if r0-r1 <= 2 && r0 < Queen {
+		score++
+	}
+	return int(math.Ceil(score))
+}
+
+// OmahaPoints returns a Hutchison style point count for a 4 or 5 card Omaha
+// starting pocket, or 0 when the pocket is invalid. Higher scores indicate
+// stronger pockets, for use as a preflop play quality gate (ex: 20+ for 4
+// card pockets, and roughly 25+ for 5 card pockets) without a full equity
+// calculation.
+//
+// Points are the sum of:
+//
+//   - suits: for each suit held 2 or more times, 4 when the suit's highest
+//     card is an [Ace], 3 for a [King], 2 for a [Queen], otherwise 1, less 2
+//     when the suit is held 3 or more times
+//   - pairs: for each rank held exactly 2 times, 8 for Aces, 7 for Kings, 6
+//     for Queens, 5 for Jacks, 4 for Tens, 3 for Nines, 2 for Eights, 1 for
+//     Sevens, otherwise 0
+//   - straights: for each combination of 2 distinct ranks able to make a
+//     straight together, 3 when connected, 2 with 1 gap, 1 with 2 gaps, less
+//     1 when the higher rank is below a [Seven]
+func OmahaPoints(pocket []Card) int {
+	if len(pocket) < 4 || 5 < len(pocket) {
+		return 0
+	}
+	ranks, suits := make(map[Rank]int), make(map[Suit]Rank)
+	counts := make(map[Suit]int)
+	for i, c := range pocket {
+		if !c.Valid() || slices.Contains(pocket[:i], c) {
+			return 0
+		}
+		r, suit := c.Rank(), c.Suit()
+		ranks[r]++
+		counts[suit]++
+		suits[suit] = max(suits[suit], r)
+	}
+	var points int
+	for suit, n := range counts {
+		if n < 2 {
+			continue
+		}
+		switch suits[suit] {
+		case Ace:
+			points += 4
+		case King:
+			points += 3
+		case Queen:
+			points += 2
+		default:
+			points++
+		}
+		if 3 <= n {
+			points -= 2
+		}
+	}
+	var distinct []Rank
+	for r, n := range ranks {
+		if n == 2 && Seven <= r {
+			points += int(r-Seven) + 1
+		}
+		distinct = append(distinct, r)
+	}
+	for i := range distinct {
+		for j := range distinct {
+			hi, lo := distinct[i], distinct[j]
+			if hi <= lo {
+				continue
+			}
+			gap := int(hi-lo) - 1
+			if hi == Ace && lo <= Five {
+				// wheel, Ace low
+				hi, gap = lo, int(lo-Two)
+			}
+			if 2 < gap {
+				continue
+			}
+			if points += 3 - gap; hi < Seven {
+				points--
+			}
+		}
+	}
+	return points
+}
+
+// CanonicalKey returns the canonical class key for the pocket, identical for
+// all pockets equivalent under suit isomorphism, for compact logging and range
+// work.
+//
+// For 2 card pockets, returns the starting pocket key (see [HashKey]). For
+// other pockets (ie, Omaha), cards sharing a suit with other cards are grouped
+// in parentheses, with groups ordered by size and then rank, followed by
+// cards of unique suits, with ranks ordered high to low.
+//
+// Examples:
+//
+//	AhKh     - AKs
+//	AsKsQhJh - (AK)(QJ)  (double-suited)
+//	AsAhKsQd - (AK)AQ    (single-suited)
+//	AsKsQsJh - (AKQ)J
+//	AsAhKdQc - AAKQ      (rainbow)
+func CanonicalKey(pocket []Card) string {
+	if len(pocket) == 2 {
+		return HashKey(pocket[0], pocket[1])
+	}
+	groups := make(map[Suit][]Rank)
+	for _, c := range pocket {
+		groups[c.Suit()] = append(groups[c.Suit()], c.Rank())
+	}
+	var suited [][]Rank
+	var single []Rank
+	for _, v := range groups {
+		sort.Slice(v, func(i, j int) bool {
+			return v[j] < v[i]
+		})
+		if len(v) == 1 {
+			single = append(single, v[0])
+		} else {
+			suited = append(suited, v)
+		}
+	}
+	sort.Slice(suited, func(i, j int) bool {
+		if len(suited[i]) != len(suited[j]) {
+			return len(suited[j]) < len(suited[i])
+		}
+		for k := range suited[i] {
+			if suited[i][k] != suited[j][k] {
+				return suited[j][k] < suited[i][k]
+			}
+		}
+		return false
+	})
+	sort.Slice(single, func(i, j int) bool {
+		return single[j] < single[i]
+	})
+	var buf []byte
+	for _, v := range suited {
+		buf = append(buf, '(')
+		for _, r := range v {
+			buf = append(buf, r.Byte())
+		}
+		buf = append(buf, ')')
+	}
+	for _, r := range single {
+		buf = append(buf, r.Byte())
+	}
+	return string(buf)
+}
+
+// CanonicalPocket returns a representative pocket for the canonical class key
+// (see [CanonicalKey]), assigning suits in the order [Spade], [Heart],
+// [Diamond], [Club]. 2 card keys may be any key accepted by [KeyCombos].
+func CanonicalPocket(key string) ([]Card, error) {
+	if len(key) <= 3 && !strings.ContainsAny(key, "()") {
+		v, err := KeyCombos(key)
+		if err != nil {
+			return nil, err
+		}
+		return v[0].Cards(), nil
+	}
+	suits := []Suit{Spade, Heart, Diamond, Club}
+	var pocket []Card
+	var group bool
+	var n int
+	for i := 0; i < len(key); i++ {
+		switch ch := key[i]; {
+		case ch == '(' && !group:
+			group, n = true, 0
+		case ch == ')' && group && 2 <= n:
+			group, suits = false, suits[1:]
+		default:
+			r := RankFromRune(rune(ch))
+			if r == InvalidRank || len(suits) == 0 {
+				return nil, ErrInvalidPocket
+			}
+			c := New(r, suits[0])
+			if slices.Contains(pocket, c) {
+				return nil, ErrInvalidPocket
+			}
+			pocket = append(pocket, c)
+			if n++; !group {
+				suits = suits[1:]
+			}
+		}
+	}
+	if group || len(pocket) < 2 {
+		return nil, ErrInvalidPocket
+	}
+	return pocket, nil
+}
+
+// gaps returns the total gaps, max gap, and connected count for the distinct
+// ranks.
+func gaps(v []int) (int, int, int) {
+	sort.Ints(v)
+	var total, m, connected int
+	for i := 1; i < len(v); i++ {
+		n := v[i] - v[i-1] - 1
+		if n == 0 {
+			connected++
+		}
+		total, m = total+n, max(m, n)
+	}
+	return total, m, connected
+}
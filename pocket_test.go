@@ -0,0 +1,192 @@
+package cardrank
+
+import (
+	"testing"
+)
+
+func TestPocketTexture(t *testing.T) {
+	tests := []struct {
+		s         string
+		pairs     int
+		suited    int
+		gaps      int
+		maxGap    int
+		connected int
+		exp       string
+	}{
+		{"As Ah", 1, 0, 0, 0, 0, "Pocket Pair"},
+		{"Ks Qs", 0, 1, 0, 0, 1, "Suited Connector"},
+		{"Ks Js", 0, 1, 1, 1, 0, "Suited One-Gapper"},
+		{"Ks 7s", 0, 1, 5, 5, 0, "Suited"},
+		{"As 2h", 0, 0, 0, 0, 1, "Offsuit Connector"},
+		{"9c 7d", 0, 0, 1, 1, 0, "Offsuit One-Gapper"},
+		{"Kc 2d", 0, 0, 10, 10, 0, "Offsuit"},
+		{"Js Ts 9h 8h", 0, 2, 0, 0, 3, "Double-Suited Rundown"},
+		{"As Ks Qh Jd", 0, 1, 0, 0, 3, "Single-Suited Rundown"},
+		{"As Kh Jd 9c", 0, 0, 2, 1, 1, "Rainbow Connected"},
+		{"As Ah Ks Kh", 2, 2, 0, 0, 1, "Double-Suited Double-Paired"},
+		{"As Ah 7s 2c", 1, 1, 4, 4, 1, "Single-Suited Paired"},
+		{"As Ks 7s 2s", 0, 1, 9, 5, 1, "Monotone Disconnected"},
+		{"As Ah Ad 2c", 1, 0, 0, 0, 1, "Rainbow Trips"},
+		{"6s 5h 4d 3c 2s", 0, 1, 0, 0, 4, "Single-Suited Rundown"},
+	}
+	for i, test := range tests {
+		tx := NewPocketTexture(Must(test.s))
+		if tx.Pairs != test.pairs {
+			t.Errorf("test %d %q expected pairs %d, got: %d", i, test.s, test.pairs, tx.Pairs)
+		}
+		if tx.Suited != test.suited {
+			t.Errorf("test %d %q expected suited %d, got: %d", i, test.s, test.suited, tx.Suited)
+		}
+		if tx.Gaps != test.gaps {
+			t.Errorf("test %d %q expected gaps %d, got: %d", i, test.s, test.gaps, tx.Gaps)
+		}
+		if tx.MaxGap != test.maxGap {
+			t.Errorf("test %d %q expected max gap %d, got: %d", i, test.s, test.maxGap, tx.MaxGap)
+		}
+		if tx.Connected != test.connected {
+			t.Errorf("test %d %q expected connected %d, got: %d", i, test.s, test.connected, tx.Connected)
+		}
+		if s := tx.Playability(); s != test.exp {
+			t.Errorf("test %d %q expected %q, got: %q", i, test.s, test.exp, s)
+		}
+	}
+}
+
+func TestSklanskyGroup(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp int
+	}{
+		{"As Ad", 1},
+		{"Ks As", 1},
+		{"Kh As", 2},
+		{"Ah Th", 3},
+		{"9h 8h", 4},
+		{"5c 4c", 6},
+		{"2c 2d", 7},
+		{"5d 4c", 8},
+		{"7c 2d", 9},
+		{"As", 0},
+		{"As As", 0},
+	}
+	m := make(map[int]int)
+	for i, test := range tests {
+		if group := SklanskyGroup(Must(test.s)); group != test.exp {
+			t.Errorf("test %d %q expected %d, got: %d", i, test.s, test.exp, group)
+		}
+	}
+	for key, group := range sklanskyGroups {
+		v, err := KeyCombos(key)
+		if err != nil {
+			t.Fatalf("expected no error for %q, got: %v", key, err)
+		}
+		m[group] += len(v)
+	}
+	// combos per group
+	for group, exp := range map[int]int{1: 28, 2: 30, 3: 34, 4: 50, 5: 94, 6: 68, 7: 102, 8: 132} {
+		if n := m[group]; n != exp {
+			t.Errorf("group %d expected %d combos, got: %d", group, exp, n)
+		}
+	}
+}
+
+func TestChenScore(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp int
+	}{
+		{"As Ah", 20},
+		{"Ks Kh", 16},
+		{"5s 5h", 5},
+		{"2s 2h", 5},
+		{"7s 7h", 7},
+		{"As Ks", 12},
+		{"Ah Kd", 10},
+		{"Js Ts", 9},
+		{"Th 9d", 6},
+		{"5s 7s", 6},
+		{"Kc 3c", 5},
+		{"7s 2h", -1},
+		{"Qs Js", 9},
+		{"As", 0},
+	}
+	for i, test := range tests {
+		if score := ChenScore(Must(test.s)); score != test.exp {
+			t.Errorf("test %d %q expected %d, got: %d", i, test.s, test.exp, score)
+		}
+	}
+}
+
+func TestCanonicalKey(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+	}{
+		{"Ah Kh", "AKs"},
+		{"Kd Ac", "AKo"},
+		{"Qs Qh", "QQ"},
+		{"As Ks Qh Jh", "(AK)(QJ)"},
+		{"Qd Jd Ac Kc", "(AK)(QJ)"},
+		{"As Ah Ks Kh", "(AK)(AK)"},
+		{"As Ah Ks Qd", "(AK)AQ"},
+		{"As Ks Qs Jh", "(AKQ)J"},
+		{"As Ah Kd Qc", "AAKQ"},
+		{"2s 3s 4s 5s", "(5432)"},
+		{"As Ks Qh Jh Td", "(AK)(QJ)T"},
+		{"9s 8s 7h 6h 5d 4d", "(98)(76)(54)"},
+	}
+	for i, test := range tests {
+		v := Must(test.s)
+		key := CanonicalKey(v)
+		if key != test.exp {
+			t.Errorf("test %d %q expected %q, got: %q", i, test.s, test.exp, key)
+		}
+		pocket, err := CanonicalPocket(key)
+		if err != nil {
+			t.Fatalf("test %d %q expected no error, got: %v", i, key, err)
+		}
+		if n := len(pocket); n != len(v) {
+			t.Fatalf("test %d %q expected %d cards, got: %d", i, key, len(v), n)
+		}
+		if s := CanonicalKey(pocket); s != key {
+			t.Errorf("test %d %q expected round trip, got: %q (%v)", i, key, s, pocket)
+		}
+	}
+	for _, key := range []string{"", "A", "(A)KQJ", "(AK", "AK)QJ", "(AA)KQ", "ZKQJ", "AAAAA", "ABCD"} {
+		if _, err := CanonicalPocket(key); err == nil {
+			t.Errorf("expected error for %q", key)
+		}
+	}
+}
+
+func TestOmahaPoints(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp int
+	}{
+		// suits 4+4, pairs 8+7, AK 3
+		{"As Ah Ks Kh", 26},
+		// suits 4+2, AK 3, AQ 2, AJ 1, KQ 3, KJ 2, QJ 3
+		{"As Ks Qh Jh", 20},
+		// suits 1+1, JT 3, J9 2, J8 1, T9 3, T8 2, 98 3
+		{"Js Ts 9h 8h", 16},
+		// rainbow, pair 8, AK 3, A2 wheel 3-1
+		{"As Ah Kd 2c", 13},
+		// suits 4, A2 wheel 3-1, A3 2-1, 32 3-1
+		{"As 2s 3h 9d", 9},
+		// monotone, suits 4-2, AK 3, AQ 2, KQ 3, A2 wheel 3-1
+		{"As Ks Qs 2s", 12},
+		// trips count no pair points
+		{"As Ah Ad Ks", 4 + 3},
+		// 5 card, suits 4+4, pairs 8+6, AK 3, AQ 2, KQ 3
+		{"As Ah Ks Qh Qd", 4 + 4 + 8 + 6 + 3 + 2 + 3},
+		{"As Ks Qh", 0},
+		{"As As Ks Kh", 0},
+	}
+	for i, test := range tests {
+		if n := OmahaPoints(Must(test.s)); n != test.exp {
+			t.Errorf("test %d %q expected %d, got: %d", i, test.s, test.exp, n)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package cardrank
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Positions is a set of positions, stored as a bitset. Supports positions 0
+// through 63.
+type Positions uint64
+
+// AllPositions is the set of all positions.
+const AllPositions = ^Positions(0)
+
+// PositionsOf creates a set of the positions.
+func PositionsOf(positions ...int) Positions {
+	return Positions(0).With(positions...)
+}
+
+// PositionsFromMap creates a set of the positions having a true value in m.
+// A nil map returns [AllPositions].
+func PositionsFromMap(m map[int]bool) Positions {
+	if m == nil {
+		return AllPositions
+	}
+	var p Positions
+	for i, ok := range m {
+		if ok {
+			p = p.With(i)
+		}
+	}
+	return p
+}
+
+// Has returns true when the set contains the position.
+func (p Positions) Has(position int) bool {
+	return 0 <= position && position < 64 && p&(1<<position) != 0
+}
+
+// With returns a copy of the set with the positions added.
+func (p Positions) With(positions ...int) Positions {
+	for _, i := range positions {
+		if 0 <= i && i < 64 {
+			p |= 1 << i
+		}
+	}
+	return p
+}
+
+// Without returns a copy of the set with the positions removed.
+func (p Positions) Without(positions ...int) Positions {
+	for _, i := range positions {
+		if 0 <= i && i < 64 {
+			p &^= 1 << i
+		}
+	}
+	return p
+}
+
+// Len returns the number of positions in the set.
+func (p Positions) Len() int {
+	return bits.OnesCount64(uint64(p))
+}
+
+// First returns the lowest position in the set, or -1 when empty.
+func (p Positions) First() int {
+	if p == 0 {
+		return -1
+	}
+	return bits.TrailingZeros64(uint64(p))
+}
+
+// Slice returns the positions in the set, in order.
+func (p Positions) Slice() []int {
+	v := make([]int, 0, p.Len())
+	for ; p != 0; p &= p - 1 {
+		v = append(v, bits.TrailingZeros64(uint64(p)))
+	}
+	return v
+}
+
+// Map returns the set as a map of positions.
+func (p Positions) Map() map[int]bool {
+	m := make(map[int]bool, p.Len())
+	for ; p != 0; p &= p - 1 {
+		m[bits.TrailingZeros64(uint64(p))] = true
+	}
+	return m
+}
+
+// Format satisfies the [fmt.Formatter] interface.
+//
+// Supported verbs:
+//
+//	d - positions (ex: [0 1 3])
+//	x - bitset as hex (ex: b)
+//	s - same as d
+//	v - same as d
+func (p Positions) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'x':
+		_, _ = f.Write([]byte(strconv.FormatUint(uint64(p), 16)))
+	case 'd', 's', 'v':
+		v := p.Slice()
+		s := make([]string, len(v))
+		for i, n := range v {
+			s[i] = strconv.Itoa(n)
+		}
+		_, _ = f.Write([]byte("[" + strings.Join(s, " ") + "]"))
+	default:
+		fmt.Fprintf(f, "%%!%c(ERROR=unknown verb, positions)", verb)
+	}
+}
@@ -0,0 +1,52 @@
+package cardrank
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPositions(t *testing.T) {
+	p := PositionsOf(0, 1, 3, 63, 64, -1)
+	if n, exp := p.Len(), 4; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+	if s, exp := fmt.Sprintf("%d", p), "[0 1 3 63]"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	if s, exp := fmt.Sprintf("%x", PositionsOf(0, 1, 3)), "b"; s != exp {
+		t.Errorf("expected %q, got: %q", exp, s)
+	}
+	p = p.Without(0, 63)
+	switch {
+	case p.Has(0), !p.Has(1), p.Has(2), !p.Has(3), p.Has(64), p.Has(-1):
+		t.Errorf("unexpected positions %d", p)
+	case p.First() != 1, Positions(0).First() != -1:
+		t.Errorf("expected first 1, got: %d", p.First())
+	}
+	if m, exp := p.Map(), map[int]bool{1: true, 3: true}; !reflect.DeepEqual(m, exp) {
+		t.Errorf("expected %v, got: %v", exp, m)
+	}
+	if q := PositionsFromMap(map[int]bool{1: true, 2: false, 3: true}); q != p {
+		t.Errorf("expected %d, got: %d", p, q)
+	}
+	if q := PositionsFromMap(nil); q != AllPositions || q.Len() != 64 {
+		t.Errorf("expected all positions, got: %x", q)
+	}
+}
+
+func TestDealerActive(t *testing.T) {
+	d := Holdem.Dealer(nil, 0, 4)
+	if !d.Deactivate(1, 2) {
+		t.Fatalf("expected deactivate to succeed")
+	}
+	if exp, m := map[int]bool{0: true, 3: true}, d.ActiveMap(); !reflect.DeepEqual(m, exp) {
+		t.Errorf("expected %v, got: %v", exp, m)
+	}
+	if !d.IsActive(0) || d.IsActive(1) {
+		t.Errorf("expected 0 active and 1 inactive")
+	}
+	if v, exp := d.Inactive(), []int{1, 2}; !reflect.DeepEqual(v, exp) {
+		t.Errorf("expected %v, got: %v", exp, v)
+	}
+}
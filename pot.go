@@ -0,0 +1,137 @@
+package cardrank
+
+import (
+	"slices"
+)
+
+// Pot is a pot of chips, with each position's total contribution, used to
+// distribute the pot's chips to the winners of a hand, including main and side
+// pots, odd chips, and Hi/Lo splits.
+type Pot struct {
+	// Contributions are each position's total contribution to the pot.
+	Contributions []int64
+	// Button is the button position. Odd chips are awarded to the winners in
+	// position order, starting with the first position after the button.
+	Button int
+}
+
+// NewPot creates a pot for the button position and each position's
+// contribution.
+func NewPot(button int, contributions ...int64) *Pot {
+	return &Pot{
+		Contributions: contributions,
+		Button:        button,
+	}
+}
+
+// Add adds the amount to the position's contribution, growing the pot's
+// contributions as needed.
+func (p *Pot) Add(position int, amount int64) {
+	if n := position + 1 - len(p.Contributions); 0 < n {
+		p.Contributions = append(p.Contributions, make([]int64, n)...)
+	}
+	p.Contributions[position] += amount
+}
+
+// Total returns the total of all contributions to the pot.
+func (p *Pot) Total() int64 {
+	var total int64
+	for _, c := range p.Contributions {
+		total += c
+	}
+	return total
+}
+
+// SidePots returns the amount and the eligible positions of the main pot and
+// each side pot, with live positions eligible to win each pot they
+// contributed to in full. Contributions by positions that are not live are
+// dead money, and any contribution greater than the largest live contribution
+// is added to the last pot (see [SidePots]). The main pot is first.
+func (p *Pot) SidePots(live Positions) ([]int64, [][]int) {
+	return sidePots(p.Contributions, live)
+}
+
+// Distribute returns the chips won by each position for the results of each
+// run of a hand (see [Dealer.Results]). Positions having an eval in the first
+// result are live, and all other contributions are dead money.
+//
+// Each main and side pot is split equally between the runs, with any odd
+// chips awarded to the earlier runs. For each run, the pot is awarded to the
+// pot's eligible positions with the best Hi, or, when an eligible position has
+// a qualified Lo (or for [Type.Double] types, the second board's Hi), split in
+// half between the best Hi and the best Lo, with the odd chip awarded to the
+// Hi. Each half is split equally between its winners, quartering as needed,
+// with the odd chips awarded in position order starting with the first
+// position after the button.
+//
+// Returns [ErrInvalidCount] when there are no results, a contribution is
+// negative, or a result's evals do not match the number of contributions.
+func (p *Pot) Distribute(results ...*Result) ([]int64, error) {
+	n := len(p.Contributions)
+	if len(results) == 0 || slices.ContainsFunc(p.Contributions, func(c int64) bool { return c < 0 }) {
+		return nil, ErrInvalidCount
+	}
+	for _, res := range results {
+		if len(res.Evals) != n || len(res.HiOrder) != n {
+			return nil, ErrInvalidCount
+		}
+	}
+	var live Positions
+	for i, ev := range results[0].Evals {
+		if ev != nil {
+			live = live.With(i)
+		}
+	}
+	chips := make([]int64, n)
+	amounts, eligible := p.SidePots(live)
+	m := int64(len(results))
+	for j, amount := range amounts {
+		for k, res := range results {
+			share := amount / m
+			if int64(k) < amount%m {
+				share++
+			}
+			if lo := potWinners(res.Evals, res.LoOrder, eligible[j], true); len(lo) != 0 {
+				half := share / 2
+				p.split(chips, half, lo)
+				share -= half
+			}
+			p.split(chips, share, potWinners(res.Evals, res.HiOrder, eligible[j], false))
+		}
+	}
+	return chips, nil
+}
+
+// split splits the amount equally between the winners, awarding odd chips in
+// position order starting with the first position after the button.
+func (p *Pot) split(chips []int64, amount int64, winners []int) {
+	n := len(p.Contributions)
+	slices.SortFunc(winners, func(a, b int) int {
+		return ((a-p.Button-1)%n+n)%n - ((b-p.Button-1)%n+n)%n
+	})
+	m := int64(len(winners))
+	for k, i := range winners {
+		chips[i] += amount / m
+		if int64(k) < amount%m {
+			chips[i]++
+		}
+	}
+}
+
+// potWinners returns the eligible positions with the best Hi or qualified Lo
+// eval, using the result's order.
+func potWinners(evs []*Eval, order, eligible []int, low bool) []int {
+	var v []int
+	for _, i := range order {
+		switch ev := evs[i]; {
+		case !slices.Contains(eligible, i):
+			continue
+		case ev == nil,
+			low && (ev.LoRank == 0 || ev.LoRank == Invalid),
+			len(v) != 0 && ev.Comp(evs[v[0]], low) != 0:
+			return v
+		}
+		v = append(v, i)
+	}
+	return v
+}
@@ -0,0 +1,98 @@
+package cardrank
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPotDistribute(t *testing.T) {
+	tests := []struct {
+		typ           Type
+		pockets       []string
+		boards        []string
+		contributions []int64
+		button        int
+		exp           []int64
+	}{
+		{Holdem, []string{"Ah Ad", "Kh Kd"}, []string{"2c 7d 9h Ts 3s"}, []int64{50, 50}, 0, []int64{100, 0}},
+		{Holdem, []string{"Ah Ad", "Kh Kd"}, []string{"2c 7d 9h Ts 3s"}, []int64{50, 80}, 0, []int64{100, 30}},
+		{Holdem, []string{"Ah Ad", "Kh Kd", "Qh Qd"}, []string{"2c 7d 9h Ts 3s"}, []int64{20, 50, 100}, 0, []int64{60, 60, 50}},
+		{Holdem, []string{"Qh Qd", "Kh Kd", "Ah Ad"}, []string{"2c 7d 9h Ts 3s"}, []int64{20, 50, 100}, 0, []int64{0, 0, 170}},
+		{Holdem, []string{"2c 3c", "4d 5d", "6h 7h", ""}, []string{"As Ks Qs Js Ts"}, []int64{50, 50, 50, 1}, 0, []int64{50, 51, 50, 0}},
+		{Holdem, []string{"2c 3c", "4d 5d", "6h 7h", ""}, []string{"As Ks Qs Js Ts"}, []int64{50, 50, 50, 1}, 2, []int64{51, 50, 50, 0}},
+		{Holdem, []string{"2c 3c", "4d 5d", "6h 7h", ""}, []string{"As Ks Qs Js Ts"}, []int64{50, 50, 50, 2}, 3, []int64{51, 51, 50, 0}},
+		{OmahaHiLo, []string{"4c 5d Kd Kh", "4h 5h Qc Qd", "7c 8c 9c 9d"}, []string{"Ac 2d 7h 9s Ks"}, []int64{100, 100, 100}, 0, []int64{225, 75, 0}},
+		{OmahaHiLo, []string{"4c 5d Kd Kh", "4h 5h Qc Qd", "7c 8c 9c 9d", ""}, []string{"Ac 2d 7h 9s Ks"}, []int64{100, 100, 100, 2}, 3, []int64{227, 75, 0, 0}},
+		{OmahaHiLo, []string{"4c 5d Kd Kh", "Qh Jh Qc Qd"}, []string{"Ac 2d 9h 9s Ks"}, []int64{100, 100}, 0, []int64{200, 0}},
+		{Holdem, []string{"Ah Ad", "Kh Kd", ""}, []string{"2c 7d 9h Ts 3s", "Kc 7d 9h Ts 3s"}, []int64{51, 50, 1}, 0, []int64{52, 50, 0}},
+	}
+	for i, test := range tests {
+		run := NewRun(len(test.pockets))
+		var active Positions
+		for j, s := range test.pockets {
+			if run.Pockets[j] = Must(s); s != "" {
+				active = active.With(j)
+			}
+		}
+		var results []*Result
+		for _, board := range test.boards {
+			run.Hi = Must(board)
+			results = append(results, NewResult(test.typ, run, active, false))
+		}
+		pot := NewPot(test.button, test.contributions...)
+		chips, err := pot.Distribute(results...)
+		switch {
+		case err != nil:
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		case !slices.Equal(chips, test.exp):
+			t.Errorf("test %d expected %v, got: %v", i, test.exp, chips)
+		}
+		var total int64
+		for _, c := range chips {
+			total += c
+		}
+		if total != pot.Total() {
+			t.Errorf("test %d expected total %d, got: %d", i, pot.Total(), total)
+		}
+	}
+}
+
+func TestPotAdd(t *testing.T) {
+	pot := NewPot(0)
+	pot.Add(2, 10)
+	pot.Add(0, 5)
+	pot.Add(2, 10)
+	if exp := []int64{5, 0, 20}; !slices.Equal(pot.Contributions, exp) {
+		t.Errorf("expected %v, got: %v", exp, pot.Contributions)
+	}
+	if total := pot.Total(); total != 25 {
+		t.Errorf("expected %d, got: %d", 25, total)
+	}
+	amounts, eligible := pot.SidePots(PositionsOf(0, 2))
+	switch {
+	case !slices.Equal(amounts, []int64{10, 15}):
+		t.Errorf("expected amounts %v, got: %v", []int64{10, 15}, amounts)
+	case !slices.Equal(eligible[0], []int{0, 2}) || !slices.Equal(eligible[1], []int{2}):
+		t.Errorf("expected eligible %v, got: %v", [][]int{{0, 2}, {2}}, eligible)
+	}
+}
+
+func TestPotDistributeInvalid(t *testing.T) {
+	run := NewRun(2)
+	run.Pockets, run.Hi = [][]Card{Must("Ah Ad"), Must("Kh Kd")}, Must("2c 7d 9h Ts 3s")
+	res := NewResult(Holdem, run, PositionsOf(0, 1), false)
+	tests := []struct {
+		pot     *Pot
+		results []*Result
+	}{
+		{NewPot(0, 10, 10), nil},
+		{NewPot(0, 10, -10), []*Result{res}},
+		{NewPot(0, 10, 10, 10), []*Result{res}},
+	}
+	for i, test := range tests {
+		if _, err := test.pot.Distribute(test.results...); !errors.Is(err, ErrInvalidCount) {
+			t.Errorf("test %d expected %v, got: %v", i, ErrInvalidCount, err)
+		}
+	}
+}
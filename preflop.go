@@ -0,0 +1,140 @@
+package cardrank
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// PreflopEquity returns the exact heads-up preflop all-in expected value of
+// the hero's starting pocket class against the villain's starting pocket
+// class (see [GridKey]), summed over every non-conflicting pair of combos in
+// the classes and every board. Only [Holdem] and [Short] are supported.
+//
+// Equities are precalculated (see preflopgen.go), and do not require any
+// enumeration at runtime.
+//
+// Returns [ErrInvalidType] when the type is not supported, or
+// [ErrInvalidPocket] when either class is invalid or not available in the
+// type's deck.
+func PreflopEquity(typ Type, hero, villain string) (*ExpValue, error) {
+	m, err := preflopTable(typ)
+	if err != nil {
+		return nil, err
+	}
+	h, err := preflopKey(hero)
+	if err != nil {
+		return nil, err
+	}
+	v, err := preflopKey(villain)
+	if err != nil {
+		return nil, err
+	}
+	if expv, ok := m[[2]string{h, v}]; ok {
+		return &expv, nil
+	}
+	if expv, ok := m[[2]string{v, h}]; ok {
+		expv.Wins, expv.Losses = expv.Losses, expv.Wins
+		return &expv, nil
+	}
+	return nil, ErrInvalidPocket
+}
+
+// PreflopGrid returns the exact heads-up preflop all-in equity of each
+// starting pocket class against the villain's starting pocket class (see
+// [PreflopEquity]). Classes not available in the type's deck have 0 equity.
+func PreflopGrid(typ Type, villain string) (Grid, error) {
+	var g Grid
+	if _, err := PreflopEquity(typ, villain, villain); err != nil {
+		return g, err
+	}
+	for i := range 13 {
+		for j := range 13 {
+			if expv, err := PreflopEquity(typ, GridKey(i, j), villain); err == nil {
+				g[i][j] = expv.Float64()
+			}
+		}
+	}
+	return g, nil
+}
+
+// preflopKey returns the normalized starting pocket class key.
+func preflopKey(key string) (string, error) {
+	i, j, err := GridPos(key)
+	if err != nil {
+		return "", err
+	}
+	return GridKey(i, j), nil
+}
+
+var (
+	// preflopHoldem is the map of Holdem class matchups.
+	preflopHoldem map[[2]string]ExpValue
+	// preflopShort is the map of Short class matchups.
+	preflopShort map[[2]string]ExpValue
+	// preflopOnce guards loading the preflop maps.
+	preflopOnce sync.Once
+)
+
+// preflopTable returns the preflop class matchups for the type.
+func preflopTable(typ Type) (map[[2]string]ExpValue, error) {
+	preflopOnce.Do(func() {
+		var err error
+		if preflopHoldem, err = loadPreflop(preflopHoldemData); err != nil {
+			panic(fmt.Sprintf("unable to load preflop holdem: %v", err))
+		}
+		if preflopShort, err = loadPreflop(preflopShortData); err != nil {
+			panic(fmt.Sprintf("unable to load preflop short: %v", err))
+		}
+	})
+	switch typ {
+	case Holdem:
+		return preflopHoldem, nil
+	case Short:
+		return preflopShort, nil
+	}
+	return nil, ErrInvalidType
+}
+
+// loadPreflop loads preflop class matchups.
+func loadPreflop(buf []byte) (map[[2]string]ExpValue, error) {
+	r := csv.NewReader(bytes.NewReader(buf))
+	r.FieldsPerRecord = 5
+	lines, err := r.ReadAll()
+	switch {
+	case err != nil:
+		return nil, err
+	case len(lines) < 2:
+		return nil, ErrInvalidData
+	}
+	m := make(map[[2]string]ExpValue, len(lines)-1)
+	for i, line := range lines[1:] {
+		var v [3]uint64
+		for k := range v {
+			if v[k], err = strconv.ParseUint(line[2+k], 10, 64); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+		}
+		m[[2]string{line[0], line[1]}] = ExpValue{
+			Opponents: 1,
+			Wins:      v[0],
+			Splits:    v[1],
+			Losses:    v[2],
+			Total:     v[0] + v[1] + v[2],
+		}
+	}
+	return m, nil
+}
+
+// preflopHoldemData is the embedded Holdem preflop class matchup data.
+//
+//go:embed preflopholdem.csv
+var preflopHoldemData []byte
+
+// preflopShortData is the embedded Short preflop class matchup data.
+//
+//go:embed preflopshort.csv
+var preflopShortData []byte
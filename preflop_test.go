@@ -0,0 +1,92 @@
+package cardrank
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPreflopEquity(t *testing.T) {
+	tests := []struct {
+		typ          Type
+		hero         string
+		villain      string
+		exp          float64
+		wins, losses uint64
+	}{
+		{Holdem, "AA", "KK", 0.8195, 50371344, 10986372},
+		{Holdem, "KK", "AA", 0.1805, 10986372, 50371344},
+		{Holdem, "AKs", "QQ", 0.4605, 18834720, 22082460},
+		{Holdem, "AA", "AA", 0.5, 223260, 223260},
+		{Holdem, "72o", "AA", 0.1180, 14288040, 108477540},
+		{Holdem, "TJs", "JTs", 0.5, 0, 0},
+		{Short, "AA", "KK", 0.7464, 5359968, 1787256},
+		{Short, "A6s", "KQo", 0.5105, 4877208, 4673472},
+		{Short, "KQo", "A6s", 0.4895, 4673472, 4877208},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.Name()+"/"+test.hero+"/"+test.villain, func(t *testing.T) {
+			expv, err := PreflopEquity(test.typ, test.hero, test.villain)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if f := expv.Float64(); math.Abs(f-test.exp) > 0.0001 {
+				t.Errorf("expected %f, got: %f", test.exp, f)
+			}
+			if test.wins != 0 && (expv.Wins != test.wins || expv.Losses != test.losses) {
+				t.Errorf("expected %d/%d, got: %d/%d", test.wins, test.losses, expv.Wins, expv.Losses)
+			}
+			if expv.Wins+expv.Splits+expv.Losses != expv.Total {
+				t.Errorf("expected total %d, got: %d", expv.Wins+expv.Splits+expv.Losses, expv.Total)
+			}
+		})
+	}
+}
+
+func TestPreflopEquityErrors(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		hero    string
+		villain string
+		err     error
+	}{
+		{Omaha, "AA", "KK", ErrInvalidType},
+		{Holdem, "AAs", "KK", ErrInvalidPocket},
+		{Holdem, "AA", "XY", ErrInvalidPocket},
+		{Short, "72o", "AA", ErrInvalidPocket},
+	}
+	for _, test := range tests {
+		if _, err := PreflopEquity(test.typ, test.hero, test.villain); err != test.err {
+			t.Errorf("%s %s vs %s expected error %v, got: %v", test.typ, test.hero, test.villain, test.err, err)
+		}
+	}
+}
+
+func TestPreflopGrid(t *testing.T) {
+	for _, typ := range []Type{Holdem, Short} {
+		g, err := PreflopGrid(typ, "AKo")
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		for i := range 13 {
+			for j := range 13 {
+				key := GridKey(i, j)
+				expv, err := PreflopEquity(typ, key, "AKo")
+				switch {
+				case err != nil && g[i][j] != 0:
+					t.Errorf("%s %s expected 0, got: %f", typ, key, g[i][j])
+				case err == nil && g[i][j] != expv.Float64():
+					t.Errorf("%s %s expected %f, got: %f", typ, key, expv.Float64(), g[i][j])
+				}
+			}
+		}
+		// symmetric matchups sum to 1
+		rev, err := PreflopEquity(typ, "AKo", "QQ")
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		i, j, _ := GridPos("QQ")
+		if f := g[i][j] + rev.Float64(); math.Abs(f-1) > 1e-9 {
+			t.Errorf("%s expected 1, got: %f", typ, f)
+		}
+	}
+}
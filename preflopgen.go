@@ -0,0 +1,294 @@
+//go:build ignore
+
+// preflopgen generates the exact heads-up preflop all-in tables for Holdem and
+// Short, enumerating every board for every pair of combos in each pair of
+// starting pocket classes.
+//
+// Ranks are looked up in the Two-Plus-Two table (see twoplustwo*.dat), with
+// Short ranks adjusted for the Ace-low Straight and Flush ranking over Full
+// House, and are checked against the type's eval before generating. Combo matchups that are equivalent under suit isomorphism are
+// calculated only once.
+//
+// Usage:
+//
+//	go run preflopgen.go -type holdem -out preflopholdem.csv
+//	go run preflopgen.go -type short -out preflopshort.csv
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/cardrank/cardrank"
+)
+
+func main() {
+	typ := flag.String("type", "holdem", "type (holdem or short)")
+	out := flag.String("out", "preflopholdem.csv", "out")
+	verbose := flag.Bool("v", true, "verbose")
+	flag.Parse()
+	if err := run(*typ, *out, *verbose); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// result is a matchup result.
+type result struct {
+	wins, splits, losses uint64
+}
+
+// run generates the table.
+func run(name, out string, verbose bool) error {
+	var typ cardrank.Type
+	if err := typ.UnmarshalText([]byte(name)); err != nil {
+		return err
+	}
+	if typ != cardrank.Holdem && typ != cardrank.Short {
+		return fmt.Errorf("type %s not supported", typ)
+	}
+	tbl, err := loadTwoPlusTwo(typ == cardrank.Short)
+	if err != nil {
+		return err
+	}
+	if err := tbl.check(typ); err != nil {
+		return err
+	}
+	// collect classes
+	deck := typ.DeckType().Unshuffled()
+	var keys []string
+	combos := make(map[string][]cardrank.Combo)
+	for i := range 13 {
+		for j := range 13 {
+			key := cardrank.GridKey(i, j)
+			v, _ := cardrank.KeyCombos(key)
+			if slices.Contains(deck, v[0][0]) && slices.Contains(deck, v[0][1]) {
+				keys, combos[key] = append(keys, key), v
+			}
+		}
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	fmt.Fprintln(w, "hero,villain,wins,splits,losses")
+	cache := make(map[[4]cardrank.Card]result)
+	start := time.Now()
+	for i, a := range keys {
+		for _, b := range keys[i:] {
+			var res result
+			for _, h := range combos[a] {
+				for _, v := range combos[b] {
+					if h[0] == v[0] || h[0] == v[1] || h[1] == v[0] || h[1] == v[1] {
+						continue
+					}
+					k := canonical(h, v)
+					r, ok := cache[k]
+					if !ok {
+						r = tbl.calc(typ.DeckType(), h, v)
+						cache[k] = r
+					}
+					res.wins, res.splits, res.losses = res.wins+r.wins, res.splits+r.splits, res.losses+r.losses
+				}
+			}
+			fmt.Fprintf(w, "%s,%s,%d,%d,%d\n", a, b, res.wins, res.splits, res.losses)
+		}
+		if verbose {
+			fmt.Fprintf(os.Stderr, "%s: %d/%d (%d matchups, %v)\n", a, i+1, len(keys), len(cache), time.Since(start))
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// canonical returns the canonical key for the matchup under suit isomorphism.
+func canonical(hero, villain cardrank.Combo) [4]cardrank.Card {
+	suits := []cardrank.Suit{cardrank.Spade, cardrank.Heart, cardrank.Diamond, cardrank.Club}
+	var key [4]cardrank.Card
+	first := true
+	permute(slices.Clone(suits), 0, func(perm []cardrank.Suit) {
+		f := func(c cardrank.Card) cardrank.Card {
+			return cardrank.New(c.Rank(), perm[slices.Index(suits, c.Suit())])
+		}
+		h0, h1, v0, v1 := f(hero[0]), f(hero[1]), f(villain[0]), f(villain[1])
+		if h0 < h1 {
+			h0, h1 = h1, h0
+		}
+		if v0 < v1 {
+			v0, v1 = v1, v0
+		}
+		k := [4]cardrank.Card{h0, h1, v0, v1}
+		if first || slices.Compare(k[:], key[:]) < 0 {
+			key, first = k, false
+		}
+	})
+	return key
+}
+
+// permute calls f with each permutation of v.
+func permute(v []cardrank.Suit, k int, f func([]cardrank.Suit)) {
+	if k == len(v) {
+		f(v)
+		return
+	}
+	for i := k; i < len(v); i++ {
+		v[k], v[i] = v[i], v[k]
+		permute(v, k+1, f)
+		v[k], v[i] = v[i], v[k]
+	}
+}
+
+// twoPlusTwo is the Two-Plus-Two lookup table.
+type twoPlusTwo struct {
+	tbl   []uint32
+	m     map[cardrank.Card]uint32
+	bits  map[cardrank.Card]uint64
+	short bool
+}
+
+// loadTwoPlusTwo loads the Two-Plus-Two lookup table, adjusting ranks for
+// Short when short is true.
+func loadTwoPlusTwo(short bool) (*twoPlusTwo, error) {
+	var tbl []uint32
+	for i := range 13 {
+		buf, err := os.ReadFile(fmt.Sprintf("twoplustwo%02d.dat", i))
+		if err != nil {
+			return nil, err
+		}
+		v := make([]uint32, len(buf)/4)
+		if err := binary.Read(bytes.NewReader(buf), binary.LittleEndian, v); err != nil {
+			return nil, err
+		}
+		tbl = append(tbl, v...)
+	}
+	m, bits := make(map[cardrank.Card]uint32, 52), make(map[cardrank.Card]uint64, 52)
+	for i, r := uint32(0), cardrank.Two; r <= cardrank.Ace; r++ {
+		for j, s := range []cardrank.Suit{cardrank.Spade, cardrank.Heart, cardrank.Club, cardrank.Diamond} {
+			c := cardrank.New(r, s)
+			m[c], bits[c] = i+1, 1<<(16*j+int(r))
+			i++
+		}
+	}
+	return &twoPlusTwo{
+		tbl:   tbl,
+		m:     m,
+		bits:  bits,
+		short: short,
+	}, nil
+}
+
+// aceLow is the rank bits for the Short Ace-low straight (A, 6, 7, 8, 9).
+const aceLow = 1<<cardrank.Ace | 1<<cardrank.Six | 1<<cardrank.Seven | 1<<cardrank.Eight | 1<<cardrank.Nine
+
+// adjust adjusts a Two-Plus-Two rank for Short, where bits are the hand's
+// card bits. Two-Plus-Two ranks are the category (1 for High Card, to 9 for
+// Straight Flush) shifted 12 bits, plus the rank within the category, with
+// higher ranks being better hands.
+func (t *twoPlusTwo) adjust(v uint32, bits uint64) uint32 {
+	if !t.short {
+		return v
+	}
+	cat := v >> 12
+	if cat < 9 {
+		for i := range 4 {
+			if bits>>(16*i)&aceLow == aceLow {
+				// lowest Straight Flush
+				return 9 << 12
+			}
+		}
+	}
+	switch {
+	case cat < 5 && (bits|bits>>16|bits>>32|bits>>48)&aceLow == aceLow:
+		// lowest Straight
+		return 5 << 12
+	case cat == 6:
+		// Flush over Full House
+		return 7<<12 | v&0xfff
+	case cat == 7:
+		return 6<<12 | v&0xfff
+	}
+	return v
+}
+
+// rank returns the adjusted Two-Plus-Two rank of the 7 card hand.
+func (t *twoPlusTwo) rank(v []cardrank.Card) uint32 {
+	i, bits := uint32(53), uint64(0)
+	for _, c := range v {
+		i, bits = t.tbl[i+t.m[c]], bits|t.bits[c]
+	}
+	return t.adjust(i, bits)
+}
+
+// check checks the adjusted Two-Plus-Two ranks against the type's eval for
+// random hands.
+func (t *twoPlusTwo) check(typ cardrank.Type) error {
+	r := rand.New(rand.NewPCG(1, 0))
+	a, b := cardrank.EvalOf(typ), cardrank.EvalOf(typ)
+	for range 1000000 {
+		v := typ.DeckType().New()
+		v.Shuffle(r, 1)
+		hand := v.Draw(9)
+		a.Reset(typ)
+		a.Eval(hand[:2], hand[4:])
+		b.Reset(typ)
+		b.Eval(hand[2:4], hand[4:])
+		h, o := t.rank(append(hand[:2:2], hand[4:]...)), t.rank(hand[2:])
+		if c := a.Comp(b, false); (c < 0) != (o < h) || (0 < c) != (h < o) {
+			return fmt.Errorf("%v vs %v on %v: eval comp %d, two-plus-two %d %d", hand[:2], hand[2:4], hand[4:], c, h, o)
+		}
+	}
+	return nil
+}
+
+// calc calculates the matchup, enumerating every board from the deck.
+func (t *twoPlusTwo) calc(deck cardrank.DeckType, hero, villain cardrank.Combo) result {
+	var u []uint32
+	var ubits []uint64
+	for _, c := range deck.Exclude(hero[:], villain[:]) {
+		u, ubits = append(u, t.m[c]), append(ubits, t.bits[c])
+	}
+	tbl, n := t.tbl, len(u)
+	h := tbl[tbl[53+t.m[hero[0]]]+t.m[hero[1]]]
+	v := tbl[tbl[53+t.m[villain[0]]]+t.m[villain[1]]]
+	hbits, vbits := t.bits[hero[0]]|t.bits[hero[1]], t.bits[villain[0]]|t.bits[villain[1]]
+	var res result
+	for i0 := 0; i0 < n; i0++ {
+		h0, v0, b0 := tbl[h+u[i0]], tbl[v+u[i0]], ubits[i0]
+		for i1 := i0 + 1; i1 < n; i1++ {
+			h1, v1, b1 := tbl[h0+u[i1]], tbl[v0+u[i1]], b0|ubits[i1]
+			for i2 := i1 + 1; i2 < n; i2++ {
+				h2, v2, b2 := tbl[h1+u[i2]], tbl[v1+u[i2]], b1|ubits[i2]
+				for i3 := i2 + 1; i3 < n; i3++ {
+					h3, v3, b3 := tbl[h2+u[i3]], tbl[v2+u[i3]], b2|ubits[i3]
+					for i4 := i3 + 1; i4 < n; i4++ {
+						h4, v4 := tbl[h3+u[i4]], tbl[v3+u[i4]]
+						if t.short {
+							b4 := b3 | ubits[i4]
+							h4, v4 = t.adjust(h4, hbits|b4), t.adjust(v4, vbits|b4)
+						}
+						switch {
+						case v4 < h4:
+							res.wins++
+						case h4 < v4:
+							res.losses++
+						default:
+							res.splits++
+						}
+					}
+				}
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,557 @@
+package cardrank
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Combo is a 2 card pocket combination.
+type Combo [2]Card
+
+// NewCombo creates a combo for the cards, ordering the higher ranked card
+// first.
+func NewCombo(c0, c1 Card) Combo {
+	if comboLess(c0, c1) {
+		c0, c1 = c1, c0
+	}
+	return Combo{c0, c1}
+}
+
+// Key returns the combo's starting pocket key (see [HashKey]).
+func (c Combo) Key() string {
+	return HashKey(c[0], c[1])
+}
+
+// Cards returns the combo's cards.
+func (c Combo) Cards() []Card {
+	return []Card{c[0], c[1]}
+}
+
+// Pair returns true when the combo is a pocket pair.
+func (c Combo) Pair() bool {
+	return c[0].Rank() == c[1].Rank()
+}
+
+// Suited returns true when the combo is suited.
+func (c Combo) Suited() bool {
+	return c[0].Suit() == c[1].Suit()
+}
+
+// Blocked returns true when either of the combo's cards are contained in dead.
+func (c Combo) Blocked(dead map[Card]bool) bool {
+	return dead[c[0]] || dead[c[1]]
+}
+
+// conflicts returns true when the combos share a card.
+func (c Combo) conflicts(o Combo) bool {
+	return c[0] == o[0] || c[0] == o[1] || c[1] == o[0] || c[1] == o[1]
+}
+
+// String satisfies the [fmt.Stringer] interface.
+func (c Combo) String() string {
+	return c[0].String() + c[1].String()
+}
+
+// Format satisfies the [fmt.Formatter] interface. Supports the same verbs as
+// [Card.Format], with the cards written without a separator.
+func (c Combo) Format(f fmt.State, verb rune) {
+	c[0].Format(f, verb)
+	c[1].Format(f, verb)
+}
+
+// MarshalText satisfies the [encoding.TextMarshaler] interface.
+func (c Combo) MarshalText() ([]byte, error) {
+	if c[0] == InvalidCard || c[1] == InvalidCard || c[0] == 0 || c[1] == 0 {
+		return nil, ErrInvalidCard
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText satisfies the [encoding.TextUnmarshaler] interface.
+func (c *Combo) UnmarshalText(buf []byte) error {
+	v, err := Parse(string(buf))
+	switch {
+	case err != nil:
+		return err
+	case len(v) != 2 || v[0] == v[1]:
+		return ErrInvalidCard
+	}
+	*c = NewCombo(v[0], v[1])
+	return nil
+}
+
+// KeyCombos returns all combos for a starting pocket key (see [HashKey]). A key
+// without a suited ('s') or offsuit ('o') suffix returns both suited and
+// offsuit combos.
+//
+// Examples:
+//
+//	AA  - 6 combos
+//	AKs - 4 combos
+//	AKo - 12 combos
+//	AK  - 16 combos
+func KeyCombos(key string) ([]Combo, error) {
+	if len(key) != 2 && len(key) != 3 {
+		return nil, ErrInvalidPocket
+	}
+	r0, r1 := RankFromRune(rune(key[0])), RankFromRune(rune(key[1]))
+	if r0 == InvalidRank || r1 == InvalidRank {
+		return nil, ErrInvalidPocket
+	}
+	if r0 < r1 {
+		r0, r1 = r1, r0
+	}
+	suited, offsuit := true, true
+	if len(key) == 3 {
+		switch key[2] {
+		case 's', 'S':
+			offsuit = false
+		case 'o', 'O':
+			suited = false
+		default:
+			return nil, ErrInvalidPocket
+		}
+	}
+	pair := r0 == r1
+	if pair && (!suited || !offsuit) {
+		return nil, ErrInvalidPocket
+	}
+	suits := []Suit{Spade, Heart, Diamond, Club}
+	var v []Combo
+	for i, s0 := range suits {
+		for j, s1 := range suits {
+			switch {
+			case pair && j <= i,
+				!pair && s0 == s1 && !suited,
+				!pair && s0 != s1 && !offsuit:
+				continue
+			}
+			v = append(v, NewCombo(New(r0, s0), New(r1, s1)))
+		}
+	}
+	sortCombos(v)
+	return v, nil
+}
+
+// DeadCombos returns the number of remaining combos for the starting pocket
+// key after removing dead cards (ie, the board and hero's pocket).
+func DeadCombos(key string, dead ...[]Card) int {
+	v, err := KeyCombos(key)
+	if err != nil {
+		return 0
+	}
+	m, n := deadMap(dead...), 0
+	for _, c := range v {
+		if !c.Blocked(m) {
+			n++
+		}
+	}
+	return n
+}
+
+// Range is a set of weighted pocket combos.
+type Range map[Combo]float64
+
+// NewRange creates a range containing the starting pocket keys (see
+// [KeyCombos]), with a weight of 1.
+func NewRange(keys ...string) (Range, error) {
+	r := make(Range)
+	for _, key := range keys {
+		if err := r.Add(key, 1); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// FullRange creates a range of all possible combos for the deck type.
+func FullRange(typ DeckType) Range {
+	v := typ.Unshuffled()
+	r := make(Range, len(v)*(len(v)-1)/2)
+	for i := range len(v) {
+		for j := i + 1; j < len(v); j++ {
+			r[NewCombo(v[i], v[j])] = 1
+		}
+	}
+	return r
+}
+
+// Add adds the combos for the starting pocket key (see [KeyCombos]) with the
+// weight.
+func (r Range) Add(key string, weight float64) error {
+	v, err := KeyCombos(key)
+	if err != nil {
+		return err
+	}
+	for _, c := range v {
+		r[c] = weight
+	}
+	return nil
+}
+
+// AddCombo adds the combo with the weight.
+func (r Range) AddCombo(c Combo, weight float64) {
+	r[NewCombo(c[0], c[1])] = weight
+}
+
+// Contains returns true when the range contains the combo with a non-zero
+// weight.
+func (r Range) Contains(c Combo) bool {
+	return r[NewCombo(c[0], c[1])] != 0
+}
+
+// Combos returns the range's combos having a non-zero weight, ordered by rank.
+func (r Range) Combos() []Combo {
+	v := make([]Combo, 0, len(r))
+	for c, w := range r {
+		if w != 0 {
+			v = append(v, c)
+		}
+	}
+	sortCombos(v)
+	return v
+}
+
+// Keys returns the starting pocket keys for the range's combos, ordered by
+// rank.
+func (r Range) Keys() []string {
+	var keys []string
+	m := make(map[string]bool)
+	for _, c := range r.Combos() {
+		if key := c.Key(); !m[key] {
+			keys, m[key] = append(keys, key), true
+		}
+	}
+	return keys
+}
+
+// Remaining returns the combos in the range not blocked by the dead cards.
+func (r Range) Remaining(dead ...[]Card) Range {
+	m := deadMap(dead...)
+	u := make(Range, len(r))
+	for c, w := range r {
+		if w != 0 && !c.Blocked(m) {
+			u[c] = w
+		}
+	}
+	return u
+}
+
+// Count returns the weighted count of combos in the range not blocked by the
+// dead cards.
+func (r Range) Count(dead ...[]Card) float64 {
+	m := deadMap(dead...)
+	var n float64
+	for c, w := range r {
+		if !c.Blocked(m) {
+			n += w
+		}
+	}
+	return n
+}
+
+// Frequencies returns the proportion of the range's weighted combos
+// attributed to each starting pocket key, after removing combos blocked by the
+// dead cards.
+func (r Range) Frequencies(dead ...[]Card) map[string]float64 {
+	m := deadMap(dead...)
+	f := make(map[string]float64)
+	var total float64
+	for c, w := range r {
+		if w != 0 && !c.Blocked(m) {
+			f[c.Key()] += w
+			total += w
+		}
+	}
+	if total != 0 {
+		for key := range f {
+			f[key] /= total
+		}
+	}
+	return f
+}
+
+// ParseRange parses a range in standard range notation, a comma separated
+// list of starting pocket keys (see [KeyCombos]), specific combos, or key
+// ranges, each optionally followed by a colon and a weight between 0 and 1
+// (as with [ParsePioRange]). Key ranges are written as:
+//
+//	22+     - pairs 22 through AA
+//	A2s+    - A2s through AKs
+//	KTo+    - KTo through KQo
+//	99-66   - pairs 99 through 66
+//	A5s-A2s - A5s through A2s
+//
+// Example:
+//
+//	AKs, 22+, A5s-A2s, KQo, T9s:0.5
+func ParseRange(s string) (Range, error) {
+	return parseRange(s, true)
+}
+
+// ParsePioRange parses a range in PioSOLVER's weighted text format, a comma
+// separated list of starting pocket keys (see [KeyCombos]) or specific combos,
+// each optionally followed by a colon and a weight between 0 and 1.
+//
+// Example:
+//
+//	AA,KK:0.5,AKs,AKo:0.25,AhQh:0.75
+func ParsePioRange(s string) (Range, error) {
+	return parseRange(s, false)
+}
+
+// parseRange parses a comma separated range of hands, each optionally
+// followed by a colon and weight, expanding key ranges when expand is true.
+func parseRange(s string, expand bool) (Range, error) {
+	r := make(Range)
+	for _, field := range strings.Split(s, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		hand, weight := field, 1.0
+		if i := strings.IndexByte(field, ':'); i != -1 {
+			var err error
+			if weight, err = strconv.ParseFloat(field[i+1:], 64); err != nil {
+				return nil, fmt.Errorf("invalid range weight %q: %w", field, err)
+			}
+			hand = strings.TrimSpace(field[:i])
+		}
+		hands := []string{hand}
+		if expand {
+			var err error
+			if hands, err = expandHand(hand); err != nil {
+				return nil, err
+			}
+		}
+		for _, hand := range hands {
+			if err := r.addHand(hand, weight); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
+
+// ParseGTOPlusRange parses a range in GTO+'s weighted text format, a comma
+// separated list of starting pocket keys (see [KeyCombos]) or specific combos,
+// where weighted hands are enclosed in percentage weight tags.
+//
+// Example:
+//
+//	AA,KK,[50.0]AKs,AQs[/50.0],[25]AhQh[/25]
+func ParseGTOPlusRange(s string) (Range, error) {
+	r := make(Range)
+	for s = strings.TrimLeft(s, ", \t\r\n"); s != ""; s = strings.TrimLeft(s, ", \t\r\n") {
+		if s[0] != '[' {
+			i := strings.IndexAny(s, ",[")
+			if i == -1 {
+				i = len(s)
+			}
+			if err := r.addHand(strings.TrimSpace(s[:i]), 1); err != nil {
+				return nil, err
+			}
+			s = s[i:]
+			continue
+		}
+		i := strings.IndexByte(s, ']')
+		if i == -1 {
+			return nil, fmt.Errorf("invalid range weight %q: %w", s, ErrInvalidPocket)
+		}
+		weight, err := strconv.ParseFloat(s[1:i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range weight %q: %w", s[:i+1], err)
+		}
+		s = s[i+1:]
+		j := strings.Index(s, "[/")
+		k := strings.IndexByte(s[max(j, 0):], ']')
+		if j == -1 || k == -1 {
+			return nil, fmt.Errorf("unterminated range weight %q: %w", s, ErrInvalidPocket)
+		}
+		for _, hand := range strings.Split(s[:j], ",") {
+			if hand = strings.TrimSpace(hand); hand != "" {
+				if err := r.addHand(hand, weight/100); err != nil {
+					return nil, err
+				}
+			}
+		}
+		s = s[j+k+1:]
+	}
+	return r, nil
+}
+
+// PioString returns the range in PioSOLVER's weighted text format (see
+// [ParsePioRange]). Starting pocket keys are used when all of a key's combos
+// have the same weight, otherwise specific combos are used.
+func (r Range) PioString() string {
+	var v []string
+	for _, g := range r.groups() {
+		for _, hand := range g.hands {
+			if g.weight != 1 {
+				hand += ":" + strconv.FormatFloat(math.Round(g.weight*1e6)/1e6, 'f', -1, 64)
+			}
+			v = append(v, hand)
+		}
+	}
+	return strings.Join(v, ",")
+}
+
+// GTOPlusString returns the range in GTO+'s weighted text format (see
+// [ParseGTOPlusRange]). Starting pocket keys are used when all of a key's
+// combos have the same weight, otherwise specific combos are used.
+func (r Range) GTOPlusString() string {
+	weights, hands := []float64(nil), make(map[float64][]string)
+	for _, g := range r.groups() {
+		if _, ok := hands[g.weight]; !ok {
+			weights = append(weights, g.weight)
+		}
+		hands[g.weight] = append(hands[g.weight], g.hands...)
+	}
+	var v []string
+	for _, weight := range weights {
+		s := strings.Join(hands[weight], ",")
+		if weight != 1 {
+			pct := strconv.FormatFloat(math.Round(weight*1e6)/1e4, 'f', -1, 64)
+			s = "[" + pct + "]" + s + "[/" + pct + "]"
+		}
+		v = append(v, s)
+	}
+	return strings.Join(v, ",")
+}
+
+// rangeGroup is a group of range hands having the same weight.
+type rangeGroup struct {
+	hands  []string
+	weight float64
+}
+
+// groups returns the range's hands grouped by starting pocket key, ordered
+// by rank. Keys having combos with differing weights are split into groups
+// of specific combos.
+func (r Range) groups() []rangeGroup {
+	var groups []rangeGroup
+	for _, key := range r.Keys() {
+		v, _ := KeyCombos(key)
+		weight, same := r[v[0]], true
+		for _, c := range v[1:] {
+			same = same && r[c] == weight
+		}
+		if same {
+			groups = append(groups, rangeGroup{[]string{key}, weight})
+			continue
+		}
+		for _, c := range v {
+			if w := r[c]; w != 0 {
+				groups = append(groups, rangeGroup{[]string{c[0].String() + c[1].String()}, w})
+			}
+		}
+	}
+	return groups
+}
+
+// addHand adds a starting pocket key or specific combo with the weight.
+func (r Range) addHand(hand string, weight float64) error {
+	if weight < 0 || 1 < weight {
+		return fmt.Errorf("invalid range weight %q: %v", hand, weight)
+	}
+	if len(hand) == 4 {
+		var c Combo
+		if err := c.UnmarshalText([]byte(hand)); err != nil {
+			return fmt.Errorf("invalid range hand %q: %w", hand, err)
+		}
+		r[c] = weight
+		return nil
+	}
+	if err := r.Add(hand, weight); err != nil {
+		return fmt.Errorf("invalid range hand %q: %w", hand, err)
+	}
+	return nil
+}
+
+// expandHand expands a key range (ie, 22+, A2s+, or A5s-A2s) into its
+// starting pocket keys. Other hands are returned unchanged.
+func expandHand(hand string) ([]string, error) {
+	var lo, hi string
+	switch i := strings.IndexByte(hand, '-'); {
+	case strings.HasSuffix(hand, "+"):
+		lo = hand[:len(hand)-1]
+	case i != -1:
+		lo, hi = hand[i+1:], hand[:i]
+	default:
+		return []string{hand}, nil
+	}
+	r0, r1, suffix, err := parseKey(lo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range hand %q: %w", hand, err)
+	}
+	// determine the last key, and the rank varying between keys
+	pair, last := r0 == r1, Ace
+	if !pair {
+		last = r0 - 1
+	}
+	if hi != "" {
+		h0, h1, s, err := parseKey(hi)
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("invalid range hand %q: %w", hand, err)
+		case s != suffix, pair != (h0 == h1), !pair && h0 != r0:
+			return nil, fmt.Errorf("invalid range hand %q: %w", hand, ErrInvalidPocket)
+		case pair && h0 < r0, !pair && h1 < r1:
+			r0, r1, h0, h1 = h0, h1, r0, r1
+		}
+		last = h1
+	}
+	var keys []string
+	for r := r1; r <= last; r++ {
+		k0 := r0
+		if pair {
+			k0 = r
+		}
+		keys = append(keys, string([]byte{k0.Byte(), r.Byte()})+suffix)
+	}
+	return keys, nil
+}
+
+// parseKey parses a starting pocket key (see [KeyCombos]), returning the
+// high and low ranks, and the suited or offsuit suffix.
+func parseKey(key string) (Rank, Rank, string, error) {
+	if _, err := KeyCombos(key); err != nil {
+		return 0, 0, "", err
+	}
+	r0, r1 := RankFromRune(rune(key[0])), RankFromRune(rune(key[1]))
+	if r0 < r1 {
+		r0, r1 = r1, r0
+	}
+	return r0, r1, strings.ToLower(key[2:]), nil
+}
+
+// deadMap returns a map of the dead cards.
+func deadMap(dead ...[]Card) map[Card]bool {
+	m := make(map[Card]bool)
+	for _, v := range dead {
+		for _, c := range v {
+			m[c] = true
+		}
+	}
+	return m
+}
+
+// comboLess returns true when a orders before b in a combo.
+func comboLess(a, b Card) bool {
+	if m, n := a.Rank(), b.Rank(); m != n {
+		return m < n
+	}
+	return b.Suit() < a.Suit()
+}
+
+// sortCombos sorts the combos, highest first.
+func sortCombos(v []Combo) {
+	sort.Slice(v, func(i, j int) bool {
+		if v[i][0] != v[j][0] {
+			return comboLess(v[j][0], v[i][0])
+		}
+		return comboLess(v[j][1], v[i][1])
+	})
+}
@@ -0,0 +1,254 @@
+package cardrank
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestKeyCombos(t *testing.T) {
+	tests := []struct {
+		key string
+		exp int
+		err error
+	}{
+		{"AA", 6, nil},
+		{"AKs", 4, nil},
+		{"AKo", 12, nil},
+		{"AK", 16, nil},
+		{"KA", 16, nil},
+		{"T9s", 4, nil},
+		{"72o", 12, nil},
+		{"AAs", 0, ErrInvalidPocket},
+		{"AKx", 0, ErrInvalidPocket},
+		{"Z2", 0, ErrInvalidPocket},
+		{"A", 0, ErrInvalidPocket},
+	}
+	for i, test := range tests {
+		v, err := KeyCombos(test.key)
+		switch {
+		case test.err != nil && !errors.Is(err, test.err):
+			t.Fatalf("test %d %q expected error %v, got: %v", i, test.key, test.err, err)
+		case test.err != nil:
+			continue
+		case err != nil:
+			t.Fatalf("test %d %q expected no error, got: %v", i, test.key, err)
+		}
+		if n := len(v); n != test.exp {
+			t.Errorf("test %d %q expected %d combos, got: %d", i, test.key, test.exp, n)
+		}
+		m := make(map[Combo]bool)
+		for _, c := range v {
+			if m[c] {
+				t.Errorf("test %d %q duplicate combo %s", i, test.key, c)
+			}
+			m[c] = true
+			if c[0].Rank() < c[1].Rank() {
+				t.Errorf("test %d %q expected combo %s to be ordered", i, test.key, c)
+			}
+		}
+	}
+}
+
+func TestDeadCombos(t *testing.T) {
+	tests := []struct {
+		key  string
+		dead string
+		exp  int
+	}{
+		{"AA", "", 6},
+		{"AA", "As", 3},
+		{"AA", "As Ah", 1},
+		{"AKs", "Ah Kd", 2},
+		{"AKo", "Ah", 9},
+		{"AK", "Ah Kh 2c", 9},
+		{"KK", "Ks Kh Kd", 0},
+	}
+	for i, test := range tests {
+		if n := DeadCombos(test.key, Must(test.dead)); n != test.exp {
+			t.Errorf("test %d %q/%q expected %d, got: %d", i, test.key, test.dead, test.exp, n)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	r, err := NewRange("AA", "KK", "AKs")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if n, exp := len(r.Combos()), 16; n != exp {
+		t.Errorf("expected %d combos, got: %d", exp, n)
+	}
+	if n, exp := r.Count(), 16.0; n != exp {
+		t.Errorf("expected %f, got: %f", exp, n)
+	}
+	if n, exp := r.Count(Must("As")), 12.0; n != exp {
+		t.Errorf("expected %f, got: %f", exp, n)
+	}
+	if n, exp := len(r.Remaining(Must("As Kd"))), 8; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+	if !r.Contains(NewCombo(New(King, Spade), New(Ace, Spade))) {
+		t.Errorf("expected range to contain AsKs")
+	}
+	if r.Contains(NewCombo(New(King, Spade), New(Ace, Heart))) {
+		t.Errorf("expected range to not contain AhKs")
+	}
+	keys := r.Keys()
+	if len(keys) != 3 || keys[0] != "AA" || keys[1] != "AKs" || keys[2] != "KK" {
+		t.Errorf("expected [AA AKs KK], got: %v", keys)
+	}
+	f := r.Frequencies(Must("Ah Ad Ac"))
+	for key, exp := range map[string]float64{"AA": 0, "AKs": 1.0 / 7.0, "KK": 6.0 / 7.0} {
+		if math.Abs(f[key]-exp) > 1e-9 {
+			t.Errorf("expected %s frequency %f, got: %f", key, exp, f[key])
+		}
+	}
+}
+
+func TestFullRange(t *testing.T) {
+	tests := []struct {
+		typ DeckType
+		exp int
+	}{
+		{DeckFrench, 1326},
+		{DeckShort, 630},
+		{DeckRoyal, 190},
+	}
+	for _, test := range tests {
+		if n := len(FullRange(test.typ)); n != test.exp {
+			t.Errorf("%s expected %d, got: %d", test.typ, test.exp, n)
+		}
+	}
+}
+
+func TestComboMarshal(t *testing.T) {
+	r, err := NewRange("QQ")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	buf, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var u Range
+	if err := json.Unmarshal(buf, &u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(u) != len(r) {
+		t.Fatalf("expected %d, got: %d", len(r), len(u))
+	}
+	for c, w := range r {
+		if u[c] != w {
+			t.Errorf("expected %s == %f, got: %f", c, w, u[c])
+		}
+	}
+}
+
+func TestSolverRange(t *testing.T) {
+	tests := []struct {
+		pio   string
+		gto   string
+		count float64
+		exp   string
+		expg  string
+	}{
+		{"AA,KK", "AA,KK", 12, "AA,KK", "AA,KK"},
+		{"AA, KK:0.5 ,AKs", "AA,[50]KK[/50],AKs", 13, "AA,AKs,KK:0.5", "AA,AKs,[50]KK[/50]"},
+		{"AK:0.25", "[25.0]AK[/25.0]", 4, "AKs:0.25,AKo:0.25", "[25]AKs,AKo[/25]"},
+		{"AhKh:0.75,QQ", "[75]AhKh[/75],QQ", 6.75, "AhKh:0.75,QQ", "[75]AhKh[/75],QQ"},
+		{"AKs,AsKs:0.5", "AKs,[50]AsKs[/50]", 3.5, "AsKs:0.5,AhKh,AdKd,AcKc", "[50]AsKs[/50],AhKh,AdKd,AcKc"},
+		{"T9s:0.333", "[33.3]T9s[/33.3]", 1.332, "T9s:0.333", "[33.3]T9s[/33.3]"},
+	}
+	for i, test := range tests {
+		r, err := ParsePioRange(test.pio)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		g, err := ParseGTOPlusRange(test.gto)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if n := r.Count(); math.Abs(n-test.count) > 1e-9 {
+			t.Errorf("test %d expected count %f, got: %f", i, test.count, n)
+		}
+		if n := g.Count(); math.Abs(n-test.count) > 1e-9 {
+			t.Errorf("test %d expected count %f, got: %f", i, test.count, n)
+		}
+		if s := r.PioString(); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+		if s := g.PioString(); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+		if s := r.GTOPlusString(); s != test.expg {
+			t.Errorf("test %d expected %q, got: %q", i, test.expg, s)
+		}
+	}
+}
+
+func TestSolverRangeErrors(t *testing.T) {
+	for i, s := range []string{"AA:2", "AA:x", "ZZ", "AhAh", "AAs"} {
+		if _, err := ParsePioRange(s); err == nil {
+			t.Errorf("test %d %q expected error", i, s)
+		}
+	}
+	for i, s := range []string{"[50AA", "[x]AA[/x]", "[50]AA", "[150]AA[/150]", "ZZ"} {
+		if _, err := ParseGTOPlusRange(s); err == nil {
+			t.Errorf("test %d %q expected error", i, s)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		s     string
+		count float64
+		exp   string
+	}{
+		{"AKs, 22+, A5s-A2s, KQo", 4 + 78 + 16 + 12, "AA,AKs,A5s,A4s,A3s,A2s,KK,KQo,QQ,JJ,TT,99,88,77,66,55,44,33,22"},
+		{"99-66", 24, "99,88,77,66"},
+		{"66-99", 24, "99,88,77,66"},
+		{"A2s-A5s", 16, "A5s,A4s,A3s,A2s"},
+		{"KTo+", 36, "KQo,KJo,KTo"},
+		{"A9+", 80, "AKs,AKo,AQs,AQo,AJs,AJo,ATs,ATo,A9s,A9o"},
+		{"AA+", 6, "AA"},
+		{"QQ+:0.5, AhKh", 10, "AA:0.5,AhKh,KK:0.5,QQ:0.5"},
+	}
+	for i, test := range tests {
+		r, err := ParseRange(test.s)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+		if n := r.Count(); math.Abs(n-test.count) > 1e-9 {
+			t.Errorf("test %d expected count %f, got: %f", i, test.count, n)
+		}
+		if s := r.PioString(); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+	}
+	r, err := ParseRange("JJ+, AQs+")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, test := range []struct {
+		c   string
+		exp bool
+	}{
+		{"Js Jh", true},
+		{"As Qs", true},
+		{"As Qh", false},
+		{"Ts Th", false},
+	} {
+		v := Must(test.c)
+		if b := r.Contains(NewCombo(v[0], v[1])); b != test.exp {
+			t.Errorf("%s expected %t, got: %t", test.c, test.exp, b)
+		}
+	}
+	for i, s := range []string{"ZZ+", "A5s-A2o", "A5s-K2s", "99-A2s", "A5s-", "22+:2"} {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("test %d %q expected error", i, s)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/cardrank/cardrank"
+)
+
+// GridImage draws the starting pocket grid to an image, with each class's
+// cell filled from the bottom in proportion to its value (clamped to 0-1).
+// Cells are two thirds of the card width.
+func (r *Renderer) GridImage(g cardrank.Grid) *image.RGBA {
+	return r.gridImage(g, func(v float64, y, cell int) color.RGBA {
+		if float64(cell)*(1-v) <= float64(y) {
+			return Green
+		}
+		return r.theme.Face
+	})
+}
+
+// GridPNG writes the starting pocket grid as a PNG image to w. See
+// [Renderer.GridImage] for the layout.
+func (r *Renderer) GridPNG(w io.Writer, g cardrank.Grid) error {
+	return png.Encode(w, r.GridImage(g))
+}
+
+// HeatmapImage draws the starting pocket grid to an image as a heatmap, such
+// as for equities (see [cardrank.EquityGrid]), with each class's cell colored
+// from [Red] for 0, through the face color for 0.5, to [Green] for 1 (values
+// are clamped to 0-1). Uses the same layout as [Renderer.GridImage].
+func (r *Renderer) HeatmapImage(g cardrank.Grid) *image.RGBA {
+	return r.gridImage(g, func(v float64, _, _ int) color.RGBA {
+		if v < 0.5 {
+			return lerp(Red, r.theme.Face, v*2)
+		}
+		return lerp(r.theme.Face, Green, v*2-1)
+	})
+}
+
+// HeatmapPNG writes the starting pocket grid as a PNG heatmap image to w. See
+// [Renderer.HeatmapImage].
+func (r *Renderer) HeatmapPNG(w io.Writer, g cardrank.Grid) error {
+	return png.Encode(w, r.HeatmapImage(g))
+}
+
+// gridImage draws the starting pocket grid to an image, using fill for the
+// color of each cell pixel, with the cell's clamped value, the pixel's y
+// offset in the cell, and the cell size.
+func (r *Renderer) gridImage(g cardrank.Grid, fill func(float64, int, int) color.RGBA) *image.RGBA {
+	cell := max(1, r.width*2/3)
+	size := 13*cell + 2*r.gap
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	ink, line := r.theme.Suits[0], Gray
+	px := float64(cell) * 0.25 / 7
+	for i := range 13 {
+		for j := range 13 {
+			key := cardrank.GridKey(i, j)
+			v := math.Min(1, math.Max(0, g[i][j]))
+			x0, y0 := r.gap+j*cell, r.gap+i*cell
+			for y := range cell {
+				for x := range cell {
+					var col color.RGBA
+					switch fx, fy := float64(x), float64(y); {
+					case x == 0 || y == 0 || x == cell-1 || y == cell-1:
+						col = line
+					case text(key, (fx-px*2)/px, (fy-px*2)/px):
+						col = ink
+					default:
+						col = fill(v, y, cell)
+					}
+					img.SetRGBA(x0+x, y0+y, col)
+				}
+			}
+		}
+	}
+	return img
+}
+
+// lerp linearly interpolates between the colors.
+func lerp(a, b color.RGBA, t float64) color.RGBA {
+	f := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + (float64(y)-float64(x))*t))
+	}
+	return color.RGBA{f(a.R, b.R), f(a.G, b.G), f(a.B, b.B), f(a.A, b.A)}
+}
+
+// text returns true when the point, in font pixel coordinates, is inside the
+// text.
+func text(s string, x, y float64) bool {
+	gx, gy := int(math.Floor(x)), int(math.Floor(y))
+	if gx < 0 || gy < 0 || 7 <= gy {
+		return false
+	}
+	i, col := gx/6, gx%6
+	return i < len(s) && col < 5 && glyphs[s[i]][gy]&(0x10>>col) != 0
+}
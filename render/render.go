@@ -0,0 +1,406 @@
+// Package render draws cards, pockets, and boards as SVG and PNG images.
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/cardrank/cardrank"
+)
+
+// Pattern is a card back pattern.
+type Pattern uint8
+
+// Card back patterns.
+const (
+	PatternSolid Pattern = iota
+	PatternStripes
+	PatternChecks
+	PatternDiamonds
+)
+
+// Back is a card back style.
+type Back struct {
+	// Primary is the primary color.
+	Primary color.RGBA
+	// Secondary is the pattern color.
+	Secondary color.RGBA
+	// Pattern is the pattern.
+	Pattern Pattern
+}
+
+// Theme is a rendering theme.
+type Theme struct {
+	// Face is the card face color.
+	Face color.RGBA
+	// Border is the card border color.
+	Border color.RGBA
+	// Suits are the suit colors, ordered by [cardrank.Suit.Index].
+	Suits [4]color.RGBA
+	// Back is the card back style.
+	Back Back
+}
+
+// Theme colors.
+var (
+	White = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	Black = color.RGBA{0x1a, 0x1a, 0x1a, 0xff}
+	Gray  = color.RGBA{0x9e, 0x9e, 0x9e, 0xff}
+	Red   = color.RGBA{0xd3, 0x2f, 0x2f, 0xff}
+	Blue  = color.RGBA{0x19, 0x5f, 0xc9, 0xff}
+	Green = color.RGBA{0x2e, 0x8b, 0x3a, 0xff}
+	Navy  = color.RGBA{0x1c, 0x2a, 0x5a, 0xff}
+)
+
+// DefaultTheme returns the default 2-color theme.
+func DefaultTheme() Theme {
+	return Theme{
+		Face:   White,
+		Border: Gray,
+		Suits:  [4]color.RGBA{Black, Red, Red, Black},
+		Back: Back{
+			Primary:   Navy,
+			Secondary: Blue,
+			Pattern:   PatternDiamonds,
+		},
+	}
+}
+
+// FourColorTheme returns a 4-color theme, with black spades, red hearts, blue
+// diamonds, and green clubs.
+func FourColorTheme() Theme {
+	theme := DefaultTheme()
+	theme.Suits = [4]color.RGBA{Black, Red, Blue, Green}
+	return theme
+}
+
+// Renderer renders cards.
+type Renderer struct {
+	theme  Theme
+	width  int
+	height int
+	gap    int
+}
+
+// Option is a renderer option.
+type Option func(*Renderer)
+
+// WithTheme is a renderer option to set the theme.
+func WithTheme(theme Theme) Option {
+	return func(r *Renderer) {
+		r.theme = theme
+	}
+}
+
+// WithSize is a renderer option to set the card width and height, in pixels.
+func WithSize(width, height int) Option {
+	return func(r *Renderer) {
+		r.width, r.height = width, height
+	}
+}
+
+// WithGap is a renderer option to set the gap between cards, in pixels.
+// Groups of cards are separated by twice the gap.
+func WithGap(gap int) Option {
+	return func(r *Renderer) {
+		r.gap = gap
+	}
+}
+
+// New creates a new renderer.
+func New(opts ...Option) *Renderer {
+	r := &Renderer{
+		theme:  DefaultTheme(),
+		width:  60,
+		height: 84,
+		gap:    6,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Size returns the width and height of the image for the groups of cards.
+func (r *Renderer) Size(groups ...[]cardrank.Card) (int, int) {
+	_, width := r.layout(groups)
+	return width, r.height + 2*r.gap
+}
+
+// renders is the count of rendered SVG images, used to give each image's
+// element ids a unique prefix.
+var renders atomic.Uint64
+
+// SVG writes the groups of cards as a SVG image to w. Each group of cards (ex:
+// a pocket or a board) is laid out horizontally, separated by twice the gap.
+// A [cardrank.InvalidCard] is drawn as a card back.
+//
+// Element ids are prefixed uniquely for each image, so that multiple images
+// can be inlined in the same HTML document.
+func (r *Renderer) SVG(w io.Writer, groups ...[]cardrank.Card) error {
+	pos, width := r.layout(groups)
+	height := r.height + 2*r.gap
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+	b.WriteString("\n")
+	prefix, n := "cardrank"+strconv.FormatUint(renders.Add(1), 10)+"-", 0
+	for i, v := range groups {
+		for j, c := range v {
+			x, y := float64(pos[i][j]), float64(r.gap)
+			if back(c) {
+				r.svgBack(b, prefix+"back"+strconv.Itoa(n), x, y)
+				n++
+			} else {
+				r.svgFace(b, c, x, y)
+			}
+		}
+	}
+	b.WriteString("</svg>\n")
+	return b.Flush()
+}
+
+// Image draws the groups of cards to an image. See [Renderer.SVG] for the
+// layout.
+func (r *Renderer) Image(groups ...[]cardrank.Card) *image.RGBA {
+	pos, width := r.layout(groups)
+	img := image.NewRGBA(image.Rect(0, 0, width, r.height+2*r.gap))
+	for i, v := range groups {
+		for j, c := range v {
+			r.draw(img, c, pos[i][j], r.gap)
+		}
+	}
+	return img
+}
+
+// PNG writes the groups of cards as a PNG image to w. See [Renderer.SVG] for
+// the layout.
+func (r *Renderer) PNG(w io.Writer, groups ...[]cardrank.Card) error {
+	return png.Encode(w, r.Image(groups...))
+}
+
+// layout returns the x offset of each card in the groups, and the total
+// width.
+func (r *Renderer) layout(groups [][]cardrank.Card) ([][]int, int) {
+	pos, x := make([][]int, len(groups)), r.gap
+	for i, v := range groups {
+		if i != 0 {
+			x += r.gap
+		}
+		pos[i] = make([]int, len(v))
+		for j := range v {
+			pos[i][j] = x
+			x += r.width + r.gap
+		}
+	}
+	return pos, max(x, 2*r.gap)
+}
+
+// metrics returns the border width, corner radius, and pattern step.
+func (r *Renderer) metrics() (float64, float64, float64) {
+	w := float64(r.width)
+	return math.Max(1, w/60), w / 10, w / 8
+}
+
+// svgFace writes the card face to b.
+func (r *Renderer) svgFace(b *bufio.Writer, c cardrank.Card, x, y float64) {
+	w, h := float64(r.width), float64(r.height)
+	bw, radius, _ := r.metrics()
+	fill := hex(r.theme.Suits[c.SuitIndex()])
+	fmt.Fprintf(b, `<g transform="translate(%s %s)">`, num(x), num(y))
+	fmt.Fprintf(b, `<rect x="%s" y="%s" width="%s" height="%s" rx="%s" fill="%s" stroke="%s" stroke-width="%s"/>`,
+		num(bw/2), num(bw/2), num(w-bw), num(h-bw), num(radius), hex(r.theme.Face), hex(r.theme.Border), num(bw))
+	corner := func(transform string) {
+		fmt.Fprintf(b, `<g%s fill="%s">`, transform, fill)
+		fmt.Fprintf(b, `<text x="%s" y="%s" font-family="sans-serif" font-weight="bold" font-size="%s">%s</text>`,
+			num(w*0.08), num(h*0.23), num(h*0.2), rankText(c.Rank()))
+		svgShape(b, suitShape(c.Suit()), w*0.08, h*0.28, w*0.18)
+		b.WriteString("</g>")
+	}
+	corner("")
+	corner(fmt.Sprintf(` transform="rotate(180 %s %s)"`, num(w/2), num(h/2)))
+	s := w * 0.45
+	fmt.Fprintf(b, `<g fill="%s">`, fill)
+	svgShape(b, suitShape(c.Suit()), (w-s)/2, (h-s)/2, s)
+	b.WriteString("</g></g>\n")
+}
+
+// svgBack writes a card back to b, using id for the back's pattern.
+func (r *Renderer) svgBack(b *bufio.Writer, id string, x, y float64) {
+	w, h := float64(r.width), float64(r.height)
+	bw, radius, step := r.metrics()
+	in := 3 * bw
+	back := r.theme.Back
+	p, s := hex(back.Primary), hex(back.Secondary)
+	fmt.Fprintf(b, `<g transform="translate(%s %s)">`, num(x), num(y))
+	switch back.Pattern {
+	case PatternStripes:
+		fmt.Fprintf(b, `<defs><pattern id="%s" patternUnits="userSpaceOnUse" width="%s" height="%s">`,
+			id, num(2*step), num(2*step))
+		fmt.Fprintf(b, `<rect width="%s" height="%s" fill="%s"/>`, num(2*step), num(2*step), p)
+		fmt.Fprintf(b, `<polygon points="%s,0 %s,0 0,%s 0,%s" fill="%s"/>`, num(step), num(2*step), num(2*step), num(step), s)
+		fmt.Fprintf(b, `<polygon points="%s,%s %s,%s %s,%s" fill="%s"/>`, num(step), num(2*step), num(2*step), num(step), num(2*step), num(2*step), s)
+		b.WriteString("</pattern></defs>")
+	case PatternChecks:
+		fmt.Fprintf(b, `<defs><pattern id="%s" patternUnits="userSpaceOnUse" width="%s" height="%s">`,
+			id, num(2*step), num(2*step))
+		fmt.Fprintf(b, `<rect width="%s" height="%s" fill="%s"/>`, num(2*step), num(2*step), p)
+		fmt.Fprintf(b, `<rect x="%s" width="%s" height="%s" fill="%s"/>`, num(step), num(step), num(step), s)
+		fmt.Fprintf(b, `<rect y="%s" width="%s" height="%s" fill="%s"/>`, num(step), num(step), num(step), s)
+		b.WriteString("</pattern></defs>")
+	case PatternDiamonds:
+		c, d := step/2, step*0.35
+		fmt.Fprintf(b, `<defs><pattern id="%s" patternUnits="userSpaceOnUse" width="%s" height="%s">`,
+			id, num(step), num(step))
+		fmt.Fprintf(b, `<rect width="%s" height="%s" fill="%s"/>`, num(step), num(step), p)
+		fmt.Fprintf(b, `<polygon points="%s,%s %s,%s %s,%s %s,%s" fill="%s"/>`,
+			num(c), num(c-d), num(c+d), num(c), num(c), num(c+d), num(c-d), num(c), s)
+		b.WriteString("</pattern></defs>")
+	}
+	fill := p
+	if back.Pattern != PatternSolid {
+		fill = "url(#" + id + ")"
+	}
+	fmt.Fprintf(b, `<rect x="%s" y="%s" width="%s" height="%s" rx="%s" fill="%s" stroke="%s" stroke-width="%s"/>`,
+		num(bw/2), num(bw/2), num(w-bw), num(h-bw), num(radius), hex(r.theme.Face), hex(r.theme.Border), num(bw))
+	fmt.Fprintf(b, `<rect x="%s" y="%s" width="%s" height="%s" rx="%s" fill="%s"/>`,
+		num(in), num(in), num(w-2*in), num(h-2*in), num(math.Max(0, radius-in)), fill)
+	b.WriteString("</g>\n")
+}
+
+// svgShape writes the shape scaled to size at x, y to b.
+func svgShape(b *bufio.Writer, s shape, x, y, size float64) {
+	for _, p := range s {
+		if p.r != 0 {
+			fmt.Fprintf(b, `<circle cx="%s" cy="%s" r="%s"/>`, num(x+p.cx*size), num(y+p.cy*size), num(p.r*size))
+			continue
+		}
+		b.WriteString(`<polygon points="`)
+		for i, pt := range p.pts {
+			if i != 0 {
+				b.WriteString(" ")
+			}
+			fmt.Fprintf(b, "%s,%s", num(x+pt.x*size), num(y+pt.y*size))
+		}
+		b.WriteString(`"/>`)
+	}
+}
+
+// draw draws the card to img at x, y.
+func (r *Renderer) draw(img *image.RGBA, c cardrank.Card, x, y int) {
+	paint := r.face(c)
+	if back(c) {
+		paint = r.back()
+	}
+	// 2x2 supersampling
+	offsets := [4]float64{0.25, 0.75}
+	for py := range r.height {
+		for px := range r.width {
+			var cr, cg, cb, ca float64
+			for _, dy := range offsets[:2] {
+				for _, dx := range offsets[:2] {
+					col := paint(float64(px)+dx, float64(py)+dy)
+					a := float64(col.A) / 255
+					cr, cg, cb, ca = cr+float64(col.R)*a, cg+float64(col.G)*a, cb+float64(col.B)*a, ca+a
+				}
+			}
+			if ca == 0 {
+				continue
+			}
+			img.SetRGBA(x+px, y+py, color.RGBA{
+				R: uint8(cr / ca),
+				G: uint8(cg / ca),
+				B: uint8(cb / ca),
+				A: uint8(ca / 4 * 255),
+			})
+		}
+	}
+}
+
+// face returns a paint func for the card face.
+func (r *Renderer) face(c cardrank.Card) func(float64, float64) color.RGBA {
+	w, h := float64(r.width), float64(r.height)
+	bw, radius, _ := r.metrics()
+	ink, s := r.theme.Suits[c.SuitIndex()], suitShape(c.Suit())
+	label, cell := rankText(c.Rank()), h*0.2/8
+	tx, ty := w*0.08, h*0.23-7*cell
+	pip, big := w*0.18, w*0.45
+	corner := func(x, y float64) bool {
+		return text(label, (x-tx)/cell, (y-ty)/cell) || s.in((x-w*0.08)/pip, (y-h*0.28)/pip)
+	}
+	return func(x, y float64) color.RGBA {
+		switch {
+		case !roundRect(x, y, 0, 0, w, h, radius):
+			return color.RGBA{}
+		case !roundRect(x, y, bw, bw, w-bw, h-bw, radius-bw):
+			return r.theme.Border
+		case corner(x, y), corner(w-x, h-y),
+			s.in((x-(w-big)/2)/big, (y-(h-big)/2)/big):
+			return ink
+		}
+		return r.theme.Face
+	}
+}
+
+// back returns a paint func for a card back.
+func (r *Renderer) back() func(float64, float64) color.RGBA {
+	w, h := float64(r.width), float64(r.height)
+	bw, radius, step := r.metrics()
+	in, back := 3*bw, r.theme.Back
+	return func(x, y float64) color.RGBA {
+		switch {
+		case !roundRect(x, y, 0, 0, w, h, radius):
+			return color.RGBA{}
+		case !roundRect(x, y, bw, bw, w-bw, h-bw, radius-bw):
+			return r.theme.Border
+		case !roundRect(x, y, in, in, w-in, h-in, radius-in):
+			return r.theme.Face
+		}
+		secondary := false
+		switch back.Pattern {
+		case PatternStripes:
+			secondary = int(math.Floor((x+y)/step))%2 == 1
+		case PatternChecks:
+			secondary = (int(math.Floor(x/step))+int(math.Floor(y/step)))%2 == 1
+		case PatternDiamonds:
+			fx, fy := math.Mod(x, step)-step/2, math.Mod(y, step)-step/2
+			secondary = math.Abs(fx)+math.Abs(fy) < step*0.35
+		}
+		if secondary {
+			return back.Secondary
+		}
+		return back.Primary
+	}
+}
+
+// roundRect returns true when x, y is inside the rounded rectangle.
+func roundRect(x, y, x0, y0, x1, y1, radius float64) bool {
+	if x < x0 || x1 < x || y < y0 || y1 < y {
+		return false
+	}
+	radius = math.Max(0, radius)
+	cx, cy := math.Min(math.Max(x, x0+radius), x1-radius), math.Min(math.Max(y, y0+radius), y1-radius)
+	dx, dy := x-cx, y-cy
+	return dx*dx+dy*dy <= radius*radius
+}
+
+// back returns true when the card should be drawn as a card back.
+func back(c cardrank.Card) bool {
+	return c == cardrank.InvalidCard || c == 0
+}
+
+// hex returns the hex representation of the color.
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// num formats f for use in a SVG attribute.
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,176 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cardrank/cardrank"
+)
+
+func TestSVG(t *testing.T) {
+	r := New()
+	pocket, board := cardrank.Must("Ah Ts"), cardrank.Must("Kd Qc 2h")
+	var buf bytes.Buffer
+	if err := r.SVG(&buf, pocket, board, []cardrank.Card{cardrank.InvalidCard}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	s := buf.String()
+	t.Logf("svg:\n%s", s)
+	w, h := r.Size(pocket, board, []cardrank.Card{cardrank.InvalidCard})
+	if exp := 6*60 + 9*6; w != exp {
+		t.Errorf("expected width %d, got: %d", exp, w)
+	}
+	if exp := 84 + 2*6; h != exp {
+		t.Errorf("expected height %d, got: %d", exp, h)
+	}
+	switch {
+	case !strings.HasPrefix(s, "<svg "), !strings.HasSuffix(s, "</svg>\n"):
+		t.Errorf("expected svg element")
+	case strings.Count(s, "<text ") != 10:
+		t.Errorf("expected 10 text elements, got: %d", strings.Count(s, "<text "))
+	case !strings.Contains(s, ">10</text>"):
+		t.Errorf("expected ten rank text")
+	case !strings.Contains(s, `back0)"`):
+		t.Errorf("expected back pattern")
+	}
+}
+
+func TestSVGIds(t *testing.T) {
+	re := regexp.MustCompile(`<pattern id="([^"]+)"`)
+	ids := make(map[string]bool)
+	for _, theme := range []Theme{DefaultTheme(), FourColorTheme()} {
+		var buf bytes.Buffer
+		if err := New(WithTheme(theme)).SVG(&buf, []cardrank.Card{cardrank.InvalidCard, cardrank.InvalidCard}); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		s := buf.String()
+		m := re.FindAllStringSubmatch(s, -1)
+		if len(m) != 2 {
+			t.Fatalf("expected 2 patterns, got: %d", len(m))
+		}
+		for _, v := range m {
+			if ids[v[1]] {
+				t.Errorf("expected unique id, got duplicate: %s", v[1])
+			}
+			ids[v[1]] = true
+			if !strings.Contains(s, "url(#"+v[1]+")") {
+				t.Errorf("expected fill url for %s", v[1])
+			}
+		}
+	}
+}
+
+func TestImage(t *testing.T) {
+	for _, theme := range []Theme{DefaultTheme(), FourColorTheme()} {
+		r := New(WithTheme(theme), WithSize(120, 168), WithGap(0))
+		cards := cardrank.Must("As Ah Ad Ac")
+		img := r.Image(append(cards, cardrank.InvalidCard))
+		if b := img.Bounds(); b.Dx() != 5*120 || b.Dy() != 168 {
+			t.Fatalf("expected 600x168, got: %v", b)
+		}
+		for i, c := range cards {
+			// center of the big pip
+			if col, exp := img.RGBAAt(i*120+60, 84), theme.Suits[c.SuitIndex()]; col != exp {
+				t.Errorf("card %s expected %v, got: %v", c, exp, col)
+			}
+			// corners are transparent
+			if col := img.RGBAAt(i*120, 0); col.A != 0 {
+				t.Errorf("card %s expected transparent corner, got: %v", c, col)
+			}
+		}
+		switch col := img.RGBAAt(4*120+60, 84); col {
+		case theme.Back.Primary, theme.Back.Secondary:
+		default:
+			t.Errorf("expected back color, got: %v", col)
+		}
+	}
+}
+
+func TestPNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New().PNG(&buf, cardrank.Must("Ah Kh"), cardrank.Must("Qh Jh Th")); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 5*60+7*6 {
+		t.Errorf("expected width %d, got: %d", 5*60+7*6, b.Dx())
+	}
+	if _, _, _, a := img.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("expected transparent margin")
+	}
+	if c := color.RGBAModel.Convert(img.At(6+30, 6+42)).(color.RGBA); c != Red {
+		t.Errorf("expected %v, got: %v", Red, c)
+	}
+}
+
+func TestGridImage(t *testing.T) {
+	g, err := cardrank.ParseGrid("AA KK:0.5 AKs")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	r := New()
+	img := r.GridImage(g)
+	if w, h := img.Bounds().Dx(), img.Bounds().Dy(); w != 13*40+12 || h != w {
+		t.Fatalf("expected %dx%d, got: %dx%d", 13*40+12, 13*40+12, w, h)
+	}
+	// bottom right of AA is filled, bottom right of KK is half filled, 22 is empty
+	for _, test := range []struct {
+		x, y int
+		exp  color.RGBA
+	}{
+		{6 + 35, 6 + 35, Green},
+		{6 + 40 + 35, 6 + 40 + 35, Green},
+		{6 + 40 + 35, 6 + 40 + 10, White},
+		{6 + 12*40 + 35, 6 + 12*40 + 35, White},
+	} {
+		if c := img.RGBAAt(test.x, test.y); c != test.exp {
+			t.Errorf("expected %d, %d to be %v, got: %v", test.x, test.y, test.exp, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := r.GridPNG(&buf, g); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if _, err := png.Decode(&buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestHeatmapImage(t *testing.T) {
+	g, err := cardrank.ParseGrid("AA KK:0.5 AKs:0.25")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	r := New()
+	img := r.HeatmapImage(g)
+	if w, h := img.Bounds().Dx(), img.Bounds().Dy(); w != 13*40+12 || h != w {
+		t.Fatalf("expected %dx%d, got: %dx%d", 13*40+12, 13*40+12, w, h)
+	}
+	for _, test := range []struct {
+		x, y int
+		exp  color.RGBA
+	}{
+		{6 + 35, 6 + 35, Green},
+		{6 + 40 + 35, 6 + 40 + 35, White},
+		{6 + 40 + 35, 6 + 35, color.RGBA{0xe9, 0x97, 0x97, 0xff}},
+		{6 + 12*40 + 35, 6 + 12*40 + 35, Red},
+	} {
+		if c := img.RGBAAt(test.x, test.y); c != test.exp {
+			t.Errorf("expected %d, %d to be %v, got: %v", test.x, test.y, test.exp, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := r.HeatmapPNG(&buf, g); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if _, err := png.Decode(&buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package render
+
+import (
+	"github.com/cardrank/cardrank"
+)
+
+// point is a point in unit coordinates.
+type point struct {
+	x, y float64
+}
+
+// prim is a shape primitive in unit coordinates, either a circle (when r is
+// non-zero), or a polygon.
+type prim struct {
+	cx, cy, r float64
+	pts       []point
+}
+
+// in returns true when the point is inside the primitive.
+func (p prim) in(x, y float64) bool {
+	if p.r != 0 {
+		dx, dy := x-p.cx, y-p.cy
+		return dx*dx+dy*dy <= p.r*p.r
+	}
+	// even-odd ray cast
+	in := false
+	for i, j := 0, len(p.pts)-1; i < len(p.pts); j, i = i, i+1 {
+		a, b := p.pts[i], p.pts[j]
+		if (a.y > y) != (b.y > y) && x < (b.x-a.x)*(y-a.y)/(b.y-a.y)+a.x {
+			in = !in
+		}
+	}
+	return in
+}
+
+// shape is a set of primitives.
+type shape []prim
+
+// in returns true when the point is inside any of the shape's primitives.
+func (s shape) in(x, y float64) bool {
+	for _, p := range s {
+		if p.in(x, y) {
+			return true
+		}
+	}
+	return false
+}
+
+// suit shapes, in unit coordinates.
+var (
+	spadeShape = shape{
+		{cx: 0.30, cy: 0.58, r: 0.21},
+		{cx: 0.70, cy: 0.58, r: 0.21},
+		{pts: []point{{0.10, 0.52}, {0.50, 0.02}, {0.90, 0.52}}},
+		{pts: []point{{0.50, 0.55}, {0.68, 0.98}, {0.32, 0.98}}},
+	}
+	heartShape = shape{
+		{cx: 0.29, cy: 0.32, r: 0.22},
+		{cx: 0.71, cy: 0.32, r: 0.22},
+		{pts: []point{{0.08, 0.40}, {0.92, 0.40}, {0.50, 0.95}}},
+	}
+	diamondShape = shape{
+		{pts: []point{{0.50, 0.02}, {0.90, 0.50}, {0.50, 0.98}, {0.10, 0.50}}},
+	}
+	clubShape = shape{
+		{cx: 0.50, cy: 0.27, r: 0.21},
+		{cx: 0.27, cy: 0.60, r: 0.21},
+		{cx: 0.73, cy: 0.60, r: 0.21},
+		{pts: []point{{0.50, 0.45}, {0.68, 0.98}, {0.32, 0.98}}},
+	}
+)
+
+// suitShape returns the shape for the suit.
+func suitShape(suit cardrank.Suit) shape {
+	switch suit {
+	case cardrank.Spade:
+		return spadeShape
+	case cardrank.Heart:
+		return heartShape
+	case cardrank.Diamond:
+		return diamondShape
+	case cardrank.Club:
+		return clubShape
+	}
+	return nil
+}
+
+// rankText returns the display text for the rank.
+func rankText(rank cardrank.Rank) string {
+	if rank == cardrank.Ten {
+		return "10"
+	}
+	return rank.String()
+}
+
+// glyphs is a 5x7 bitmap font for the rank characters, and the suited and
+// offsuit key suffixes.
+var glyphs = map[byte][7]uint8{
+	'A': {0x0e, 0x11, 0x11, 0x1f, 0x11, 0x11, 0x11},
+	'K': {0x11, 0x12, 0x14, 0x18, 0x14, 0x12, 0x11},
+	'Q': {0x0e, 0x11, 0x11, 0x11, 0x15, 0x12, 0x0d},
+	'J': {0x07, 0x02, 0x02, 0x02, 0x02, 0x12, 0x0c},
+	'T': {0x1f, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04},
+	'0': {0x0e, 0x11, 0x13, 0x15, 0x19, 0x11, 0x0e},
+	'1': {0x04, 0x0c, 0x04, 0x04, 0x04, 0x04, 0x0e},
+	'2': {0x0e, 0x11, 0x01, 0x02, 0x04, 0x08, 0x1f},
+	'3': {0x1f, 0x02, 0x04, 0x02, 0x01, 0x11, 0x0e},
+	'4': {0x02, 0x06, 0x0a, 0x12, 0x1f, 0x02, 0x02},
+	'5': {0x1f, 0x10, 0x1e, 0x01, 0x01, 0x11, 0x0e},
+	'6': {0x06, 0x08, 0x10, 0x1e, 0x11, 0x11, 0x0e},
+	'7': {0x1f, 0x01, 0x02, 0x04, 0x08, 0x08, 0x08},
+	'8': {0x0e, 0x11, 0x11, 0x0e, 0x11, 0x11, 0x0e},
+	'9': {0x0e, 0x11, 0x11, 0x0f, 0x01, 0x02, 0x0c},
+	's': {0x00, 0x00, 0x0e, 0x10, 0x0e, 0x01, 0x1e},
+	'o': {0x00, 0x00, 0x0e, 0x11, 0x11, 0x11, 0x0e},
+}
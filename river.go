@@ -0,0 +1,128 @@
+package cardrank
+
+import (
+	"slices"
+)
+
+// Matchups are the hand matchups between a hero and villain range on a
+// complete board, as needed for river subgame solving.
+//
+// Rows are hero combos and columns are villain combos. Matchups where the
+// combos share a card (ie, card removal) are blocked, and have 0 results and
+// payoffs.
+type Matchups struct {
+	// Type is the type.
+	Type Type
+	// Board is the board.
+	Board []Card
+	// Hero are the hero's combos not blocked by the board.
+	Hero []Combo
+	// HeroWeights are the hero's combo weights.
+	HeroWeights []float64
+	// Villain are the villain's combos not blocked by the board.
+	Villain []Combo
+	// VillainWeights are the villain's combo weights.
+	VillainWeights []float64
+	// Results are the showdown results for each hero and villain combo: 1
+	// when hero wins, -1 when villain wins, and 0 when tied or blocked.
+	Results [][]int8
+	// Blocked are the blocked matchups.
+	Blocked [][]bool
+}
+
+// NewMatchups enumerates the matchups between the hero and villain ranges on
+// the complete board. Combos blocked by the board are removed.
+//
+// Returns [ErrInvalidType] when the type does not have 2 card pockets,
+// [ErrInvalidBoard] when the board is not complete, or [ErrEmptyRange] when
+// either range has no combos remaining.
+func NewMatchups(typ Type, hero, villain Range, board []Card) (*Matchups, error) {
+	switch {
+	case typ.Pocket() != 2:
+		return nil, ErrInvalidType
+	case len(board) != typ.Board():
+		return nil, ErrInvalidBoard
+	}
+	m := &Matchups{
+		Type:  typ,
+		Board: slices.Clone(board),
+	}
+	m.Hero, m.HeroWeights = rangeCombos(hero, board)
+	m.Villain, m.VillainWeights = rangeCombos(villain, board)
+	if len(m.Hero) == 0 || len(m.Villain) == 0 {
+		return nil, ErrEmptyRange
+	}
+	ranks := make([]EvalRank, len(m.Villain))
+	for j, c := range m.Villain {
+		ranks[j] = typ.Eval(c[:], board).HiRank
+	}
+	m.Results, m.Blocked = make([][]int8, len(m.Hero)), make([][]bool, len(m.Hero))
+	for i, c := range m.Hero {
+		r := typ.Eval(c[:], board).HiRank
+		m.Results[i], m.Blocked[i] = make([]int8, len(m.Villain)), make([]bool, len(m.Villain))
+		for j, d := range m.Villain {
+			switch {
+			case c[0] == d[0] || c[0] == d[1] || c[1] == d[0] || c[1] == d[1]:
+				m.Blocked[i][j] = true
+			case r < ranks[j]:
+				m.Results[i][j] = 1
+			case ranks[j] < r:
+				m.Results[i][j] = -1
+			}
+		}
+	}
+	return m, nil
+}
+
+// rangeCombos returns the range's combos and weights not blocked by the
+// board.
+func rangeCombos(r Range, board []Card) ([]Combo, []float64) {
+	var combos []Combo
+	var weights []float64
+	for _, c := range r.Remaining(board).Combos() {
+		combos, weights = append(combos, c), append(weights, r[c])
+	}
+	return combos, weights
+}
+
+// Payoffs returns the hero's showdown payoff matrix for a pot of the
+// specified size, where each player has contributed half of the pot: half
+// the pot when hero wins, minus half the pot when villain wins, and 0 when
+// tied or blocked. The villain's payoffs are the negation.
+func (m *Matchups) Payoffs(pot float64) [][]float64 {
+	v := make([][]float64, len(m.Results))
+	for i, row := range m.Results {
+		v[i] = make([]float64, len(row))
+		for j, res := range row {
+			v[i][j] = float64(res) * pot / 2
+		}
+	}
+	return v
+}
+
+// Equity returns the hero's equity for each hero combo against the villain's
+// weighted range, excluding blocked matchups. Combos having no unblocked
+// matchups have 0 equity.
+func (m *Matchups) Equity() []float64 {
+	v := make([]float64, len(m.Hero))
+	for i, row := range m.Results {
+		var win, total float64
+		for j, res := range row {
+			if m.Blocked[i][j] {
+				continue
+			}
+			w := m.VillainWeights[j]
+			switch res {
+			case 1:
+				win += w
+			case 0:
+				win += w / 2
+			}
+			total += w
+		}
+		if total != 0 {
+			v[i] = win / total
+		}
+	}
+	return v
+}
@@ -0,0 +1,87 @@
+package cardrank
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMatchups(t *testing.T) {
+	hero, err := NewRange("AA", "KK", "QJs")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	villain, err := NewRange("KK", "AQo")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	villain[NewCombo(New(King, Heart), New(King, Diamond))] = 0.5
+	board := Must("Ks 7h 2c 3d 9s")
+	m, err := NewMatchups(Holdem, hero, villain, board)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// KK combos are blocked by the Ks
+	if n, exp := len(m.Hero), 6+3+4; n != exp {
+		t.Errorf("expected %d hero combos, got: %d", exp, n)
+	}
+	if n, exp := len(m.Villain), 12+3; n != exp {
+		t.Errorf("expected %d villain combos, got: %d", exp, n)
+	}
+	index := func(v []Combo, s string) int {
+		c := Must(s)
+		for i, d := range v {
+			if d == NewCombo(c[0], c[1]) {
+				return i
+			}
+		}
+		t.Fatalf("expected %s in %v", s, v)
+		return -1
+	}
+	tests := []struct {
+		hero, villain string
+		res           int8
+		blocked       bool
+	}{
+		{"Ac Ad", "Kh Kd", -1, false},
+		{"Ac Ad", "Ah Qd", 1, false},
+		{"Ac Ad", "Ac Qd", 0, true},
+		{"Kh Kd", "Kh Kc", 0, true},
+		{"Qs Js", "As Qh", -1, false},
+		{"Qh Jh", "Ad Qc", -1, false},
+	}
+	payoffs := m.Payoffs(10)
+	for _, test := range tests {
+		i, j := index(m.Hero, test.hero), index(m.Villain, test.villain)
+		if m.Results[i][j] != test.res || m.Blocked[i][j] != test.blocked {
+			t.Errorf("%s vs %s expected %d %t, got: %d %t", test.hero, test.villain, test.res, test.blocked, m.Results[i][j], m.Blocked[i][j])
+		}
+		if exp := float64(test.res) * 5; payoffs[i][j] != exp {
+			t.Errorf("%s vs %s expected %f, got: %f", test.hero, test.villain, exp, payoffs[i][j])
+		}
+	}
+	// AcAd blocks 6 of the 12 AQo combos, and the 3 KK combos weigh 0.5 + 1
+	// + 1
+	eq := m.Equity()
+	i := index(m.Hero, "Ac Ad")
+	if exp := 6.0 / (6 + 2.5); math.Abs(eq[i]-exp) > 1e-9 {
+		t.Errorf("expected %f, got: %f", exp, eq[i])
+	}
+}
+
+func TestMatchupsErrors(t *testing.T) {
+	r, _ := NewRange("AA")
+	tests := []struct {
+		typ   Type
+		board string
+		err   error
+	}{
+		{Omaha, "Ks 7h 2c 3d 9s", ErrInvalidType},
+		{Holdem, "Ks 7h 2c", ErrInvalidBoard},
+		{Holdem, "As Ah Ad 3d 9s", ErrEmptyRange},
+	}
+	for i, test := range tests {
+		if _, err := NewMatchups(test.typ, r, r, Must(test.board)); err != test.err {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package cardrank
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+// RNG is a seedable random source that can be split into independent
+// streams, allowing an entire simulation to be reproduced from a single
+// seed. Satisfies the [Rand] and [Shuffler] interfaces, and can be used
+// wherever a random source is needed, such as [Type.Deal], [Deck.Shuffle],
+// [NewHandGen], [SampleRandomEquity], and [WithRand].
+//
+// Each subsystem of a simulation should use its own stream (see
+// [RNG.Split]), so that changes to how one subsystem consumes random values
+// do not change the values seen by another:
+//
+//	r := cardrank.NewRNG(seed)
+//	pockets, board := cardrank.Holdem.Deal(r.Split(0), 1, 6)
+//	hi, lo, ok := cardrank.NewOddsCalc(cardrank.Holdem, cardrank.WithRand(r.Split(1)), ...).Calc(ctx)
+//
+// Not safe for concurrent use. Use a separate stream for each goroutine.
+type RNG struct {
+	*rand.Rand
+	key    [32]byte
+	chacha bool
+}
+
+// NewRNG creates a PCG random source for the seed. The source's sequence is
+// the same as rand.New(rand.NewPCG(seed, 0)).
+func NewRNG(seed uint64) *RNG {
+	return newRNG(seedKey(seed), false)
+}
+
+// NewChaChaRNG creates a ChaCha8 random source for the seed. ChaCha8 is
+// slower than PCG, and while the ChaCha8 stream cipher is cryptographically
+// strong, the seed is only 64 bits, so every possible sequence can be
+// enumerated. Use [CryptoShuffler] for unpredictable shuffles.
+func NewChaChaRNG(seed uint64) *RNG {
+	return newRNG(seedKey(seed), true)
+}
+
+// newRNG creates a random source for the key.
+func newRNG(key [32]byte, chacha bool) *RNG {
+	var src rand.Source
+	if chacha {
+		src = rand.NewChaCha8(key)
+	} else {
+		src = rand.NewPCG(binary.LittleEndian.Uint64(key[:8]), binary.LittleEndian.Uint64(key[8:16]))
+	}
+	return &RNG{
+		Rand:   rand.New(src),
+		key:    key,
+		chacha: chacha,
+	}
+}
+
+// Split returns a new random source of the same kind for the stream. The
+// returned source is derived from the source's seed and the stream, and not
+// from the source's current state, so the same stream always produces the same
+// sequence.
+func (r *RNG) Split(stream uint64) *RNG {
+	buf := binary.LittleEndian.AppendUint64(r.key[:], stream)
+	return newRNG(sha256.Sum256(buf), r.chacha)
+}
+
+// seedKey returns the key for the seed.
+func seedKey(seed uint64) [32]byte {
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:8], seed)
+	return key
+}
+
+// CryptoShuffler is a cryptographically secure [Shuffler], performing a
+// Fisher-Yates shuffle using random values read from crypto/rand. Use when
+// shuffles must not be predictable, such as when dealing real money games:
+//
+//	d := cardrank.Holdem.Dealer(cardrank.CryptoShuffler{}, 1, 6)
+//
+// Safe for concurrent use.
+type CryptoShuffler struct{}
+
+// Shuffle satisfies the [Shuffler] interface.
+func (CryptoShuffler) Shuffle(n int, swap func(int, int)) {
+	var buf [8]byte
+	fisherYates(n, swap, func() uint64 {
+		if _, err := crand.Read(buf[:]); err != nil {
+			panic(err)
+		}
+		return binary.LittleEndian.Uint64(buf[:])
+	})
+}
+
+// fisherYates performs a Fisher-Yates shuffle of n elements, using the
+// uniform random values returned by next. For i from n-1 down to 1, i is
+// swapped with j, where j is v mod (i+1) for the first value v from next less
+// than 2^64-1 - (2^64-1) mod (i+1), rejecting values that would bias j.
+func fisherYates(n int, swap func(int, int), next func() uint64) {
+	if n < 0 {
+		panic("invalid argument to Shuffle")
+	}
+	for i := n - 1; 0 < i; i-- {
+		swap(i, int(uint64n(uint64(i+1), next)))
+	}
+}
+
+// uint64n returns a uniform random value in [0, n) using the uniform random
+// values returned by next.
+func uint64n(n uint64, next func() uint64) uint64 {
+	limit := ^uint64(0) - ^uint64(0)%n
+	for {
+		if v := next(); v < limit {
+			return v % n
+		}
+	}
+}
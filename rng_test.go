@@ -0,0 +1,76 @@
+package cardrank
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestRNG(t *testing.T) {
+	r, exp := NewRNG(1), rand.New(rand.NewPCG(1, 0))
+	for range 10 {
+		if a, b := r.Uint64(), exp.Uint64(); a != b {
+			t.Fatalf("expected %d, got: %d", b, a)
+		}
+	}
+	deal := func(r Rand) []Card {
+		pockets, board := Holdem.Deal(r, 1, 2)
+		return slices.Concat(append(pockets, board)...)
+	}
+	for _, f := range []func(uint64) *RNG{NewRNG, NewChaChaRNG} {
+		a, b := f(1), f(1)
+		// splitting does not depend on the state
+		a.Uint64()
+		if !slices.Equal(deal(a.Split(1)), deal(b.Split(1))) {
+			t.Errorf("expected equal deals for the same stream")
+		}
+		if slices.Equal(deal(a.Split(1)), deal(a.Split(2))) {
+			t.Errorf("expected different deals for different streams")
+		}
+		if slices.Equal(deal(f(1)), deal(f(2))) {
+			t.Errorf("expected different deals for different seeds")
+		}
+		if !slices.Equal(deal(f(3)), deal(f(3))) {
+			t.Errorf("expected equal deals for the same seed")
+		}
+	}
+	if slices.Equal(deal(NewRNG(1)), deal(NewChaChaRNG(1))) {
+		t.Errorf("expected different deals for PCG and ChaCha8")
+	}
+}
+
+func TestCryptoShuffler(t *testing.T) {
+	var s Shuffler = CryptoShuffler{}
+	a, b := DeckFrench.Shuffle(s, 1).All(), DeckFrench.Shuffle(s, 1).All()
+	if slices.Equal(a, b) {
+		t.Errorf("expected different shuffles")
+	}
+	slices.Sort(a)
+	if exp := slices.Sorted(slices.Values(DeckFrench.Unshuffled())); !slices.Equal(a, exp) {
+		t.Errorf("expected %v, got: %v", exp, a)
+	}
+	// each permutation of 3 is equally likely
+	const count = 60000
+	m := make(map[[3]int]int)
+	for range count {
+		v := [3]int{0, 1, 2}
+		s.Shuffle(len(v), func(i, j int) {
+			v[i], v[j] = v[j], v[i]
+		})
+		m[v]++
+	}
+	if len(m) != 6 {
+		t.Fatalf("expected 6 permutations, got: %d", len(m))
+	}
+	for v, n := range m {
+		if n < count/6*9/10 || count/6*11/10 < n {
+			t.Errorf("expected %v to be uniform, got: %d", v, n)
+		}
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []uint64{1, 2, 3, 52, 1 << 63, ^uint64(0)} {
+		if v := uint64n(n, r.Uint64); n <= v {
+			t.Errorf("expected %d < %d", v, n)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package cardrank
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/cardrank/cardrank/combin"
+)
+
+// Runouts returns an iterator over every remaining runout of the board for
+// the type (ie, the turn and river from the flop), excluding any dead cards,
+// yielding the complete board and the runout's weight. Runouts are
+// combinations, so the order of the dealt cards is not significant.
+//
+// Without canonical dedup, each runout is yielded once with a weight of 1.
+// With canonical dedup, runouts that are equivalent under a permutation of
+// suits leaving the board and dead cards unchanged are yielded once, with a
+// weight of the number of equivalent runouts. Pass any known pockets as dead
+// cards, as only runouts that are equivalent for all known cards are
+// combined. Weights always sum to the number of runouts.
+//
+// The yielded board is reused between iterations, and must be copied when
+// retained. Yields nothing when the board has too many cards for the type.
+//
+// See [Deck.Runouts] for enumerating runouts of the remaining cards of a
+// deck.
+func Runouts(typ Type, board []Card, canonical bool, dead ...[]Card) iter.Seq2[[]Card, int] {
+	return func(yield func([]Card, int) bool) {
+		k := typ.Board() - len(board)
+		if k < 0 {
+			return
+		}
+		known := slices.Concat(append(slices.Clone(dead), board)...)
+		u := typ.DeckType().Exclude(known)
+		v := make([]Card, len(board), typ.Board())
+		copy(v, board)
+		var perms [][4]Suit
+		if canonical {
+			perms = suitPerms(known)
+		}
+		buf := make([]Card, k)
+		for g, runout := NewCombinGen(u, k); g.Next(); {
+			weight := 1
+			if 1 < len(perms) {
+				if weight = canonicalWeight(perms, runout, buf); weight == 0 {
+					continue
+				}
+			}
+			if !yield(append(v[:len(board)], runout...), weight) {
+				return
+			}
+		}
+	}
+}
+
+// Runouts returns an iterator over every combination of need cards from the
+// deck's remaining cards, excluding any dead cards, without advancing the
+// deck. The remaining cards are captured when called. Combinations are
+// generated as iterated, allowing per-runout analysis of the remaining board
+// without allocating the full enumeration up front.
+//
+// The yielded runout is reused between iterations, and must be copied when
+// retained. Yields nothing when there are fewer than need cards remaining.
+func (d *Deck) Runouts(need int, dead ...[]Card) iter.Seq[[]Card] {
+	return combin.Combinations(Exclude(d.v[min(d.i, d.l):d.l], dead...), need)
+}
+
+// suitPerms returns the permutations of suits, indexed by [Suit.Index], that
+// map the known cards to themselves.
+func suitPerms(known []Card) [][4]Suit {
+	suits := [4]Suit{Spade, Heart, Diamond, Club}
+	m := make(map[Card]bool, len(known))
+	for _, c := range known {
+		m[c] = true
+	}
+	var perms [][4]Suit
+	var permute func([4]Suit, int)
+	permute = func(p [4]Suit, i int) {
+		if i == len(p) {
+			for _, c := range known {
+				if !m[New(c.Rank(), p[c.Suit().Index()])] {
+					return
+				}
+			}
+			perms = append(perms, p)
+			return
+		}
+		for j := i; j < len(p); j++ {
+			p[i], p[j] = p[j], p[i]
+			permute(p, i+1)
+			p[i], p[j] = p[j], p[i]
+		}
+	}
+	permute(suits, 0)
+	return perms
+}
+
+// canonicalWeight returns the number of distinct runouts equivalent to the
+// runout under the suit permutations, or 0 when the runout is not the
+// canonical (ie, least) runout.
+func canonicalWeight(perms [][4]Suit, runout, buf []Card) int {
+	v := slices.Clone(runout)
+	slices.Sort(v)
+	var seen [][]Card
+	for _, p := range perms {
+		for i, c := range runout {
+			buf[i] = New(c.Rank(), p[c.Suit().Index()])
+		}
+		slices.Sort(buf)
+		switch c := slices.Compare(buf, v); {
+		case c < 0:
+			return 0
+		case c != 0 && !slices.ContainsFunc(seen, func(s []Card) bool {
+			return slices.Equal(s, buf)
+		}):
+			seen = append(seen, slices.Clone(buf))
+		}
+	}
+	return len(seen) + 1
+}
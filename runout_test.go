@@ -0,0 +1,153 @@
+package cardrank
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestRunouts(t *testing.T) {
+	tests := []struct {
+		typ       Type
+		board     string
+		dead      string
+		canonical bool
+		n         int
+		total     int
+	}{
+		{Holdem, "Ah Kh Qh", "", false, 1176, 1176},
+		{Holdem, "Ah Kh Qh", "", true, 1176, 1176},
+		{Holdem, "Ah Kh Qh Jh Th", "", true, 1, 1},
+		{Holdem, "Ah Kh Qh 2c", "Js Jd", false, 46, 46},
+		{Holdem, "Ah Kd Qc", "", false, 1176, 1176},
+		{Short, "Ah Kh Qh 9c", "", false, 32, 32},
+		{Omaha, "Ah Kh Qh", "Js Jd Ts Td", false, 990, 990},
+	}
+	for _, test := range tests {
+		t.Run(test.board, func(t *testing.T) {
+			board, dead := Must(test.board), Must(test.dead)
+			var n, total int
+			seen := make(map[string]bool)
+			for v, weight := range Runouts(test.typ, board, test.canonical, dead) {
+				if len(v) != test.typ.Board() || !slices.Equal(v[:len(board)], board) {
+					t.Fatalf("expected board starting with %s, got: %s", board, v)
+				}
+				for _, c := range v[len(board):] {
+					if slices.Contains(board, c) || slices.Contains(dead, c) {
+						t.Fatalf("expected %s to not contain dead or board cards", v)
+					}
+				}
+				key := fmt.Sprintf("%s", v)
+				if seen[key] {
+					t.Fatalf("expected %s to be yielded once", v)
+				}
+				seen[key] = true
+				n, total = n+1, total+weight
+			}
+			if test.canonical {
+				if test.n <= n && 1 < test.n {
+					t.Errorf("expected fewer than %d canonical runouts, got: %d", test.n, n)
+				}
+			} else if n != test.n {
+				t.Errorf("expected %d runouts, got: %d", test.n, n)
+			}
+			if total != test.total {
+				t.Errorf("expected total weight %d, got: %d", test.total, total)
+			}
+		})
+	}
+}
+
+func TestRunoutsCanonical(t *testing.T) {
+	// hearts flop, where spades, diamonds, and clubs are interchangeable
+	var n, total int
+	for v, weight := range Runouts(Holdem, Must("Ah Kh Qh"), true) {
+		var exp int
+		switch s0, s1 := v[3].Suit(), v[4].Suit(); {
+		case s0 == Heart && s1 == Heart:
+			exp = 1
+		case s0 == Heart || s1 == Heart, s0 == s1, v[3].Rank() == v[4].Rank():
+			exp = 3
+		default:
+			exp = 6
+		}
+		if weight != exp {
+			t.Errorf("expected %s to have weight %d, got: %d", v, exp, weight)
+		}
+		n, total = n+1, total+weight
+	}
+	if exp := 1176; total != exp {
+		t.Errorf("expected %d, got: %d", exp, total)
+	}
+	// hearts, heart and other, same other suit, different other suits
+	if exp := 45 + 10*13 + 78 + 78 + 13; n != exp {
+		t.Errorf("expected %d canonical runouts, got: %d", exp, n)
+	}
+	// rainbow flop with a known pocket, no symmetry remains
+	n = 0
+	for range Runouts(Holdem, Must("Ah Kd Qc"), true, Must("2s 3s")) {
+		n++
+	}
+	if exp := 1081; n != exp {
+		t.Errorf("expected %d, got: %d", exp, n)
+	}
+	// early break
+	n = 0
+	for range Runouts(Holdem, Must("Ah Kd Qc"), false) {
+		if n++; n == 5 {
+			break
+		}
+	}
+	if n != 5 {
+		t.Errorf("expected 5, got: %d", n)
+	}
+}
+
+func TestDeckRunouts(t *testing.T) {
+	tests := []struct {
+		draw int
+		need int
+		dead int
+		n    int
+	}{
+		{0, 2, 0, 1326},
+		{5, 2, 0, 1081},
+		{5, 2, 2, 990},
+		{5, 1, 3, 44},
+		{50, 2, 0, 1},
+		{50, 3, 0, 0},
+		{52, 0, 0, 1},
+	}
+	for i, test := range tests {
+		d := DeckFrench.Shuffle(rand.New(rand.NewPCG(1, 2)), 1)
+		// dead cards are the cards following the drawn cards
+		dead := d.All()[test.draw : test.draw+test.dead]
+		drawn := d.Draw(test.draw)
+		remaining := d.Remaining()
+		var n int
+		seen := make(map[string]bool)
+		for v := range d.Runouts(test.need, dead) {
+			if len(v) != test.need {
+				t.Fatalf("test %d expected %d cards, got: %d", i, test.need, len(v))
+			}
+			for _, c := range v {
+				if slices.Contains(drawn, c) || slices.Contains(dead, c) {
+					t.Fatalf("test %d expected %s to not contain drawn or dead cards", i, v)
+				}
+			}
+			key := fmt.Sprintf("%s", v)
+			if seen[key] {
+				t.Fatalf("test %d expected %s to be yielded once", i, v)
+			}
+			seen[key] = true
+			n++
+		}
+		if n != test.n {
+			t.Errorf("test %d expected %d runouts, got: %d", i, test.n, n)
+		}
+		if r := d.Remaining(); r != remaining {
+			t.Errorf("test %d expected %d remaining, got: %d", i, remaining, r)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cardrank
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+)
+
+// snapshotVersion is the dealer snapshot format version.
+const snapshotVersion = 1
+
+// Snapshot returns a snapshot of the dealer's whole deal state, allowing a
+// hand to be resumed exactly where it left off with [RestoreDealer], such as
+// after a crash. The snapshot contains a format version, the dealer's deck
+// (including the deck's position), positions, runs, results, and street and
+// run state (see [Dealer.MarshalBinary]), and the entries of the deck's audit
+// log when the deck is audited (see [Deck.Audit]). Event observers are not
+// included, and must be registered again after restoring.
+func (d *Dealer) Snapshot() ([]byte, error) {
+	data, err := d.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf := binary.AppendUvarint([]byte{snapshotVersion}, uint64(len(data)))
+	buf = append(buf, data...)
+	if d.Deck.audit == nil {
+		return binary.AppendUvarint(buf, gobLen(true, 0)), nil
+	}
+	entries := d.Deck.audit.Entries()
+	buf = binary.AppendUvarint(buf, gobLen(false, len(entries)))
+	for _, entry := range entries {
+		buf = append(buf, byte(entry.Kind))
+		buf = binary.AppendVarint(buf, int64(entry.Pos))
+		buf = gobAppendCards(buf, entry.Cards)
+		buf = binary.AppendUvarint(buf, uint64(len(entry.Data)))
+		buf = append(buf, entry.Data...)
+		buf = append(buf, entry.Hash[:]...)
+	}
+	return buf, nil
+}
+
+// RestoreDealer restores a dealer from a snapshot (see [Dealer.Snapshot]).
+// When the snapshot contains an audit log, the log is restored and continues
+// recording the deck's draws.
+//
+// Returns [ErrInvalidData] when the snapshot is invalid or has an unknown
+// version, [ErrInvalidType] when the dealer's type is not registered, or
+// [ErrInvalidAudit] when the audit log's hash chain is broken.
+func RestoreDealer(buf []byte) (*Dealer, error) {
+	dec := &gobDecoder{buf: buf}
+	if v := dec.byte(); dec.err == nil && v != snapshotVersion {
+		return nil, fmt.Errorf("%w: unknown snapshot version %d", ErrInvalidData, v)
+	}
+	data := dec.bytes()
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	d := new(Dealer)
+	if err := d.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	n, ok := dec.len()
+	if !ok {
+		if err := dec.done(); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+	log := NewAuditLog()
+	var prev [sha256.Size]byte
+	for i := range n {
+		entry := AuditEntry{
+			Seq:   i,
+			Kind:  AuditKind(dec.byte()),
+			Pos:   dec.varint(),
+			Cards: dec.cards(),
+			Data:  slices.Clone(dec.bytes()),
+			Prev:  prev,
+			Hash:  dec.sum(),
+		}
+		if dec.err == nil && entry.hash() != entry.Hash {
+			return nil, fmt.Errorf("entry %d: %w: broken chain", i, ErrInvalidAudit)
+		}
+		log.entries, prev = append(log.entries, entry), entry.Hash
+	}
+	if err := dec.done(); err != nil {
+		return nil, err
+	}
+	d.Deck.audit = log
+	return d, nil
+}
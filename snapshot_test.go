@@ -0,0 +1,88 @@
+package cardrank
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDealerSnapshot(t *testing.T) {
+	for _, audit := range []bool{false, true} {
+		for n := range len(Holdem.Streets()) + 1 {
+			a := Holdem.Dealer(rand.New(rand.NewPCG(5, 6)), 1, 4)
+			salt := []byte("salt")
+			if audit {
+				a.Deck.Audit(NewAuditLog(), salt)
+			}
+			for range n {
+				a.Next()
+			}
+			buf, err := a.Snapshot()
+			if err != nil {
+				t.Fatalf("n %d expected no error, got: %v", n, err)
+			}
+			b, err := RestoreDealer(buf)
+			if err != nil {
+				t.Fatalf("n %d expected no error, got: %v", n, err)
+			}
+			for ok := true; ok; {
+				ok = a.Next()
+				if b.Next() != ok {
+					t.Fatalf("n %d expected %t", n, ok)
+				}
+			}
+			for ok := true; ok; {
+				ok = a.NextResult()
+				if b.NextResult() != ok {
+					t.Fatalf("n %d expected %t", n, ok)
+				}
+			}
+			exp, _ := a.Snapshot()
+			if buf, _ = b.Snapshot(); !bytes.Equal(exp, buf) {
+				t.Errorf("n %d expected identical snapshots", n)
+			}
+			if !audit {
+				if b.Deck.audit != nil {
+					t.Errorf("n %d expected no audit log", n)
+				}
+				continue
+			}
+			a.Deck.audit.Reveal(a.Deck.All(), salt)
+			b.Deck.audit.Reveal(b.Deck.All(), salt)
+			switch {
+			case a.Deck.audit.Head() != b.Deck.audit.Head():
+				t.Errorf("n %d expected identical audit logs", n)
+			case b.Deck.audit.Verify() != nil:
+				t.Errorf("n %d expected no error, got: %v", n, b.Deck.audit.Verify())
+			}
+		}
+	}
+}
+
+func TestRestoreDealerInvalid(t *testing.T) {
+	d := Holdem.Dealer(rand.New(rand.NewPCG(5, 6)), 1, 4)
+	d.Deck.Audit(NewAuditLog(), []byte("salt"))
+	d.Next()
+	buf, err := d.Snapshot()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tampered := bytes.Clone(buf)
+	tampered[len(tampered)-1] ^= 1
+	tests := []struct {
+		buf []byte
+		err error
+	}{
+		{nil, ErrInvalidData},
+		{append([]byte{snapshotVersion + 1}, buf[1:]...), ErrInvalidData},
+		{buf[:len(buf)-1], ErrInvalidData},
+		{append(bytes.Clone(buf), 0), ErrInvalidData},
+		{tampered, ErrInvalidAudit},
+	}
+	for i, test := range tests {
+		if _, err := RestoreDealer(test.buf); !errors.Is(err, test.err) {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package cardrank
+
+import (
+	"context"
+	"encoding/binary"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// StartingTable is a table of the expected values of a type's starting
+// pockets against a single random opponent, keyed by the pocket's class (see
+// [StartingKey]). Used to calculate starting odds without enumerating runouts
+// for types other than [Holdem] (see [RegisterStartingTable]).
+//
+// Tables can be calculated with [NewStartingTable], and persisted with
+// [StartingTable.MarshalBinary] or [encoding/gob].
+type StartingTable struct {
+	Type   Type
+	Values map[string]ExpValue
+}
+
+// NewStartingTable calculates the starting table for the type, sampling the
+// random opponent pocket and board samples times for each of the type's
+// starting pocket classes. Only the Hi eval is used. Calculation time grows
+// with the number of pocket classes, so tables for types with larger pockets
+// (ie, [Omaha]) should be calculated once and persisted.
+//
+// Returns [ErrInvalidType] when the type is not registered, does not deal a
+// board, or has more than 6 pocket cards, [ErrInvalidCount] when samples is
+// less than 1, or the context's error when the context is done.
+func NewStartingTable(ctx context.Context, typ Type, samples int, r Rand) (*StartingTable, error) {
+	desc, ok := descs[typ]
+	switch {
+	case !ok, desc.board == 0, desc.pocket < 2, 6 < desc.pocket:
+		return nil, ErrInvalidType
+	case samples < 1:
+		return nil, ErrInvalidCount
+	}
+	f := calcs[typ]
+	evs := []*Eval{EvalOf(typ), EvalOf(typ)}
+	t := &StartingTable{
+		Type:   typ,
+		Values: make(map[string]ExpValue),
+	}
+	for g, v := NewCombinGen(desc.Deck.Unshuffled(), desc.pocket); g.Next(); {
+		key := StartingKey(v)
+		if _, ok := t.Values[key]; ok {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		pocket := slices.Clone(v)
+		gen := NewHandGen(desc.Deck, r, pocket)
+		expv := NewExpValue(1)
+		for range samples {
+			pockets, board := gen.Deal(1, desc.pocket, desc.board)
+			evs[0].Reset(typ)
+			evs[1].Reset(typ)
+			f(evs[0], pocket, board)
+			f(evs[1], pockets[0], board)
+			switch c := evs[0].Comp(evs[1], false); {
+			case c < 0:
+				expv.Wins++
+			case c == 0:
+				expv.Splits++
+			default:
+				expv.Losses++
+			}
+			expv.Total++
+		}
+		t.Values[key] = *expv
+	}
+	return t, nil
+}
+
+// ExpValue returns the expected value of the pocket, or nil when the pocket's
+// class is not in the table.
+func (t *StartingTable) ExpValue(pocket []Card) *ExpValue {
+	if expv, ok := t.Values[StartingKey(pocket)]; ok {
+		return &expv
+	}
+	return nil
+}
+
+// MarshalBinary satisfies the [encoding.BinaryMarshaler] interface.
+func (t *StartingTable) MarshalBinary() ([]byte, error) {
+	buf := binary.BigEndian.AppendUint16(nil, uint16(t.Type))
+	buf = binary.AppendUvarint(buf, uint64(len(t.Values)))
+	for _, key := range slices.Sorted(maps.Keys(t.Values)) {
+		expv := t.Values[key]
+		buf = binary.AppendUvarint(buf, uint64(len(key)))
+		buf = append(buf, key...)
+		buf = binary.AppendUvarint(buf, uint64(expv.Opponents))
+		for _, n := range [...]uint64{expv.Wins, expv.Splits, expv.Losses, expv.Total} {
+			buf = binary.AppendUvarint(buf, n)
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary satisfies the [encoding.BinaryUnmarshaler] interface.
+func (t *StartingTable) UnmarshalBinary(buf []byte) error {
+	dec := &gobDecoder{buf: buf}
+	typ, n := Type(dec.uint16()), dec.int()
+	if dec.err == nil && len(dec.buf) < n {
+		return ErrInvalidData
+	}
+	m := make(map[string]ExpValue, n)
+	for range n {
+		key := dec.string()
+		m[key] = ExpValue{
+			Opponents: dec.int(),
+			Wins:      dec.uvarint(),
+			Splits:    dec.uvarint(),
+			Losses:    dec.uvarint(),
+			Total:     dec.uvarint(),
+		}
+	}
+	if err := dec.done(); err != nil {
+		return err
+	}
+	t.Type, t.Values = typ, m
+	return nil
+}
+
+// startingTables are the registered starting tables.
+var startingTables sync.Map
+
+// RegisterStartingTable registers the starting table for the table's type,
+// replacing any previously registered table for the type. Registered tables
+// are used when calculating starting odds and expected values for the type
+// (see [Type.StartingExpValue]).
+//
+// Returns [ErrInvalidType] when the table's type is not registered.
+func RegisterStartingTable(t *StartingTable) error {
+	if _, ok := descs[t.Type]; !ok {
+		return ErrInvalidType
+	}
+	startingTables.Store(t.Type, t)
+	return nil
+}
+
+// StartingExpValue returns the starting expected value of the pocket for the
+// type, using the type's registered starting table (see
+// [RegisterStartingTable]). Uses the embedded [Holdem] starting pockets (see
+// [StartingExpValue]) when no table is registered for the type. Returns nil
+// when the pocket's class is not in the registered table.
+func (typ Type) StartingExpValue(pocket []Card) *ExpValue {
+	if v, ok := startingTables.Load(typ); ok {
+		return v.(*StartingTable).ExpValue(pocket)
+	}
+	return StartingExpValue(pocket)
+}
+
+// StartingKey returns the class key of the starting pocket, equal for all
+// pockets that are the same under a permutation of suits. 2 card pockets use
+// the pocket's [HashKey] (ie, "AKs"). Larger pockets use the least suit
+// permutation of the pocket's cards, ordered by rank (ie, "AsAhKsQh").
+func StartingKey(pocket []Card) string {
+	if len(pocket) == 2 {
+		return HashKey(pocket[0], pocket[1])
+	}
+	var best []Card
+	v := make([]Card, len(pocket))
+	for _, p := range suitPerms(nil) {
+		for i, c := range pocket {
+			v[i] = New(c.Rank(), p[c.Suit().Index()])
+		}
+		slices.SortFunc(v, func(a, b Card) int {
+			if a.Rank() != b.Rank() {
+				return int(b.Rank()) - int(a.Rank())
+			}
+			return a.Suit().Index() - b.Suit().Index()
+		})
+		// ranks are in the same order for all permutations, so compare suits
+		if best == nil || slices.CompareFunc(v, best, func(a, b Card) int {
+			return a.Suit().Index() - b.Suit().Index()
+		}) < 0 {
+			best = append(best[:0], v...)
+		}
+	}
+	var sb strings.Builder
+	for _, c := range best {
+		sb.WriteString(c.String())
+	}
+	return sb.String()
+}
@@ -0,0 +1,129 @@
+package cardrank
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestStartingKey(t *testing.T) {
+	tests := []struct {
+		pocket string
+		exp    string
+	}{
+		{"Ah Kh", "AKs"},
+		{"Kd As", "AKo"},
+		{"7c 7d", "77"},
+		{"Ah Kh Qd Jd", "AsKsQhJh"},
+		{"Jc Qc Ks As", "AsKsQhJh"},
+		{"Ah Kd Qh Jd", "AsKhQsJh"},
+		{"Ah Ad Kh Kd", "AsAhKsKh"},
+		{"2c 3c 4c 5c 6c", "6s5s4s3s2s"},
+	}
+	for i, test := range tests {
+		if s := StartingKey(Must(test.pocket)); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+	}
+}
+
+func TestStartingTable(t *testing.T) {
+	const samples = 200
+	tbl, err := NewStartingTable(context.Background(), Short, samples, rand.New(rand.NewPCG(1, 2)))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if n, exp := len(tbl.Values), 81; n != exp {
+		t.Fatalf("expected %d classes, got: %d", exp, n)
+	}
+	for key, expv := range tbl.Values {
+		if expv.Total != samples || expv.Wins+expv.Splits+expv.Losses != samples {
+			t.Errorf("%s expected total %d, got: %v", key, samples, expv)
+		}
+	}
+	aa, s76 := tbl.ExpValue(Must("Ah Ad")), tbl.ExpValue(Must("7h 6d"))
+	if aa == nil || s76 == nil || aa.Float64() <= s76.Float64() {
+		t.Errorf("expected AA to have more equity than 76o, got: %v %v", aa, s76)
+	}
+	if expv := tbl.ExpValue(Must("2h 2d")); expv != nil {
+		t.Errorf("expected nil, got: %v", expv)
+	}
+	// persist
+	buf, err := tbl.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var a StartingTable
+	if err := a.UnmarshalBinary(buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if a.Type != Short || len(a.Values) != len(tbl.Values) || *a.ExpValue(Must("Ah Ad")) != *aa {
+		t.Errorf("expected tables to be equal")
+	}
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(tbl); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var c StartingTable
+	if err := gob.NewDecoder(&b).Decode(&c); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if c.Type != Short || len(c.Values) != len(tbl.Values) {
+		t.Errorf("expected tables to be equal")
+	}
+	for _, v := range [][]byte{nil, buf[:len(buf)-1], append(buf, 0)} {
+		if err := new(StartingTable).UnmarshalBinary(v); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("expected %v, got: %v", ErrInvalidData, err)
+		}
+	}
+	// register
+	if err := RegisterStartingTable(tbl); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer startingTables.Delete(Short)
+	if expv := Short.StartingExpValue(Must("Ah Ad")); expv == nil || *expv != *aa {
+		t.Errorf("expected %v, got: %v", aa, expv)
+	}
+	run := NewRun(2)
+	run.Pockets = [][]Card{Must("Ah Ad"), Must("7h 6d")}
+	hi, lo := run.CalcStartType(Short, false)
+	switch {
+	case hi == nil || lo != nil:
+		t.Fatalf("expected hi odds only, got: %v %v", hi, lo)
+	case hi.Total != samples:
+		t.Errorf("expected total %d, got: %d", samples, hi.Total)
+	case hi.Counts[0] != int(aa.Wins+aa.Losses):
+		t.Errorf("expected count %d, got: %d", aa.Wins+aa.Losses, hi.Counts[0])
+	}
+	if hi, _ := run.CalcStart(false); hi == nil || hi.Total != startingTotal {
+		t.Errorf("expected holdem starting total %d, got: %v", startingTotal, hi)
+	}
+}
+
+func TestStartingTableInvalid(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := rand.New(rand.NewPCG(1, 2))
+	tests := []struct {
+		ctx     context.Context
+		typ     Type
+		samples int
+		err     error
+	}{
+		{context.Background(), Stud, 10, ErrInvalidType},
+		{context.Background(), Type(0), 10, ErrInvalidType},
+		{context.Background(), Holdem, 0, ErrInvalidCount},
+		{ctx, Holdem, 10, context.Canceled},
+	}
+	for i, test := range tests {
+		if _, err := NewStartingTable(test.ctx, test.typ, test.samples, r); !errors.Is(err, test.err) {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+	if err := RegisterStartingTable(&StartingTable{}); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("expected %v, got: %v", ErrInvalidType, err)
+	}
+}
@@ -0,0 +1,111 @@
+package cardrank
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// StreamWriter writes newline-delimited JSON (NDJSON), one [StreamRecord]
+// per line, for each simulated hand result. Records are written as they are
+// produced, allowing large simulations to be piped to analysis tools without
+// retaining results in memory.
+//
+// Wrap w with a [bufio.Writer] when writing large numbers of records.
+type StreamWriter struct {
+	enc  *json.Encoder
+	hand int
+}
+
+// NewStreamWriter creates a new NDJSON stream writer.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// Hands returns the number of hands written.
+func (w *StreamWriter) Hands() int {
+	return w.hand
+}
+
+// WriteDealer writes a record for each of the dealer's runs and results.
+// Should be called after the dealer has finished ([Dealer.NextResult]
+// returns false).
+func (w *StreamWriter) WriteDealer(d *Dealer) error {
+	if len(d.Runs) != len(d.Results) {
+		return fmt.Errorf("dealer has %d runs and %d results", len(d.Runs), len(d.Results))
+	}
+	for i := range len(d.Runs) {
+		if err := w.enc.Encode(NewStreamRecord(w.hand, i, d.Type, d.Runs[i], d.Results[i])); err != nil {
+			return err
+		}
+	}
+	w.hand++
+	return nil
+}
+
+// WriteResult writes a record for a single run and result.
+func (w *StreamWriter) WriteResult(typ Type, run *Run, res *Result) error {
+	if err := w.enc.Encode(NewStreamRecord(w.hand, 0, typ, run, res)); err != nil {
+		return err
+	}
+	w.hand++
+	return nil
+}
+
+// StreamRecord is a single hand result record written by a [StreamWriter].
+//
+// Rank and description slices are indexed by position, with inactive
+// positions having a rank of [Invalid] and an empty description. Win
+// slices contain the winning positions.
+type StreamRecord struct {
+	Hand    int        `json:"hand"`
+	Run     int        `json:"run"`
+	Type    Type       `json:"type"`
+	Pockets [][]Card   `json:"pockets"`
+	Hi      []Card     `json:"hi,omitempty"`
+	Lo      []Card     `json:"lo,omitempty"`
+	HiRanks []EvalRank `json:"hi_ranks"`
+	HiDescs []string   `json:"hi_descs"`
+	HiWin   []int      `json:"hi_win"`
+	LoRanks []EvalRank `json:"lo_ranks,omitempty"`
+	LoDescs []string   `json:"lo_descs,omitempty"`
+	LoWin   []int      `json:"lo_win,omitempty"`
+}
+
+// NewStreamRecord creates a new stream record for the run and result.
+func NewStreamRecord(hand, i int, typ Type, run *Run, res *Result) StreamRecord {
+	rec := StreamRecord{
+		Hand:    hand,
+		Run:     i,
+		Type:    typ,
+		Pockets: run.Pockets,
+		Hi:      run.Hi,
+		Lo:      run.Lo,
+	}
+	rec.HiRanks, rec.HiDescs = streamEvals(res.Evals, false)
+	rec.HiWin = res.HiOrder[:res.HiPivot]
+	if typ.Low() || typ.Double() {
+		rec.LoRanks, rec.LoDescs = streamEvals(res.Evals, true)
+		if res.LoOrder != nil {
+			rec.LoWin = res.LoOrder[:res.LoPivot]
+		}
+	}
+	return rec
+}
+
+// streamEvals returns the Hi or Lo ranks and descriptions of the evals.
+func streamEvals(evs []*Eval, low bool) ([]EvalRank, []string) {
+	ranks, descs := make([]EvalRank, len(evs)), make([]string, len(evs))
+	for i, ev := range evs {
+		ranks[i] = Invalid
+		if ev == nil {
+			continue
+		}
+		if d := ev.Desc(low); d != nil && d.Rank != 0 && d.Rank != Invalid {
+			ranks[i], descs[i] = d.Rank, fmt.Sprintf("%s", d)
+		}
+	}
+	return ranks, descs
+}
@@ -0,0 +1,55 @@
+package cardrank
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestStreamWriter(t *testing.T) {
+	for _, typ := range []Type{Holdem, OmahaHiLo, OmahaDouble, Razz} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			r := rand.New(rand.NewPCG(1, 2))
+			var buf bytes.Buffer
+			w := NewStreamWriter(&buf)
+			records := 0
+			for range 10 {
+				d := typ.Dealer(r, 1, 4)
+				d.ChangeRuns(2)
+				for d.Next() {
+				}
+				for d.NextResult() {
+				}
+				records += len(d.Runs)
+				if err := w.WriteDealer(d); err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+			}
+			if exp, n := 10, w.Hands(); n != exp {
+				t.Errorf("expected %d hands, got: %d", exp, n)
+			}
+			scanner, n := bufio.NewScanner(&buf), 0
+			for ; scanner.Scan(); n++ {
+				var rec StreamRecord
+				if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+					t.Fatalf("line %d expected no error, got: %v", n, err)
+				}
+				switch {
+				case rec.Type != typ:
+					t.Errorf("line %d expected type %s, got: %s", n, typ, rec.Type)
+				case len(rec.Pockets) != 4, len(rec.HiRanks) != 4, len(rec.HiDescs) != 4:
+					t.Errorf("line %d expected 4 pockets, ranks, and descs", n)
+				case len(rec.HiWin) == 0:
+					t.Errorf("line %d expected hi winners", n)
+				case (typ.Low() || typ.Double()) != (len(rec.LoRanks) == 4):
+					t.Errorf("line %d expected lo ranks %t, got: %d", n, typ.Low() || typ.Double(), len(rec.LoRanks))
+				}
+			}
+			if n != records {
+				t.Errorf("expected %d records, got: %d", records, n)
+			}
+		})
+	}
+}
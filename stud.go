@@ -0,0 +1,67 @@
+package cardrank
+
+// BringIn returns the position of the door card (the first face up card)
+// required to bring in on the first betting round of a [Stud] type, or -1
+// when there are no valid door cards. Positions not dealt a door card (ie,
+// inactive positions) should have an [InvalidCard].
+//
+// For Hi types (ie, [Stud] and [StudHiLo]), the lowest ranked door card
+// brings in, with [Ace]'s high. For low types (ie, [Razz]), the highest ranked
+// door card brings in, with [Ace]'s low. Ties are broken by suit, in order of
+// [Club], [Diamond], [Heart], then [Spade], with the lowest suit bringing in
+// for Hi types and the highest suit bringing in for low types.
+func BringIn(doors []Card, low bool) int {
+	pos, best := -1, -1
+	for i, c := range doors {
+		if c == 0 || c == InvalidCard {
+			continue
+		}
+		// order by rank, then suit
+		rank := int(c.Rank())
+		if low {
+			rank = (rank + 1) % 13
+		}
+		v := rank*4 + bringInSuit(c.Suit())
+		if !low {
+			v = 13*4 - v
+		}
+		if best < v {
+			pos, best = i, v
+		}
+	}
+	return pos
+}
+
+// bringInSuit returns the suit's bring in order.
+func bringInSuit(suit Suit) int {
+	switch suit {
+	case Diamond:
+		return 1
+	case Heart:
+		return 2
+	case Spade:
+		return 3
+	}
+	return 0
+}
+
+// BringIn returns the active position required to bring in, based on each
+// active position's door card in the first run (see [BringIn]). Returns -1
+// when the type does not deal face up pocket cards, or the door cards have
+// not been dealt.
+//
+// [Razz] is treated as a low type.
+func (d *Dealer) BringIn() int {
+	if len(d.Runs) == 0 || d.Runs[0].Up == nil {
+		return -1
+	}
+	run := d.Runs[0]
+	doors := make([]Card, len(run.Up))
+	for i, up := range run.Up {
+		doors[i] = InvalidCard
+		if d.Active.Has(i) && len(up) != 0 {
+			doors[i] = up[0]
+		}
+	}
+	return BringIn(doors, d.Eval == EvalRazz)
+}
@@ -0,0 +1,78 @@
+package cardrank
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBringIn(t *testing.T) {
+	tests := []struct {
+		doors string
+		low   bool
+		exp   int
+	}{
+		{"", false, -1},
+		{"", true, -1},
+		{"Ah Kh 2s 3c", false, 2},
+		{"Ah Kh 2s 2c", false, 3},
+		{"2h 2d 2s 2c", false, 3},
+		{"2h 2d 2s", false, 1},
+		{"Ah Kh 2s 3c", true, 1},
+		{"Ah Qh 2s 3c", true, 1},
+		{"Kh Kd Ks Kc", true, 2},
+		{"Ah As", true, 1},
+		{"Ac Ad", false, 0},
+	}
+	for i, test := range tests {
+		if pos := BringIn(Must(test.doors), test.low); pos != test.exp {
+			t.Errorf("test %d %q expected %d, got: %d", i, test.doors, test.exp, pos)
+		}
+	}
+	// invalid (ie, inactive) positions are skipped
+	if pos, exp := BringIn([]Card{InvalidCard, FromString("Kc"), FromString("Qd"), 0}, false), 2; pos != exp {
+		t.Errorf("expected %d, got: %d", exp, pos)
+	}
+}
+
+func TestDealerStud(t *testing.T) {
+	for _, typ := range []Type{Stud, StudHiLo, Razz} {
+		t.Run(typ.Name(), func(t *testing.T) {
+			d := typ.Dealer(rand.New(rand.NewSource(1)), 1, 6)
+			if pos := d.BringIn(); pos != -1 {
+				t.Errorf("expected -1 before dealing, got: %d", pos)
+			}
+			for d.Next() {
+				if d.Street() == 0 {
+					_, run := d.Run()
+					doors := make([]Card, len(run.Up))
+					for i := range run.Up {
+						if len(run.Up[i]) != 1 || len(run.Pockets[i]) != 3 || run.Up[i][0] != run.Pockets[i][2] {
+							t.Fatalf("expected door card %d to be last pocket card, got: %v %v", i, run.Up[i], run.Pockets[i])
+						}
+						doors[i] = run.Up[i][0]
+					}
+					if pos, exp := d.BringIn(), BringIn(doors, typ == Razz); pos != exp {
+						t.Errorf("expected bring in %d, got: %d", exp, pos)
+					}
+					d.Deactivate(0)
+					doors[0] = InvalidCard
+					if pos, exp := d.BringIn(), BringIn(doors, typ == Razz); pos != exp {
+						t.Errorf("expected bring in %d, got: %d", exp, pos)
+					}
+				}
+			}
+			_, run := d.Run()
+			for i := range run.Pockets {
+				if !slices.Equal(run.Up[i], run.Pockets[i][2:6]) {
+					t.Errorf("position %d expected up %v, got: %v", i, run.Pockets[i][2:6], run.Up[i])
+				}
+			}
+			dupe := run.Dupe()
+			dupe.Up[1][0] = InvalidCard
+			if run.Up[1][0] == InvalidCard {
+				t.Errorf("expected dupe to copy up cards")
+			}
+		})
+	}
+}
@@ -6,11 +6,20 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"sync"
 )
 
 func init() {
 	if twoplustwo01Dat != nil {
-		twoPlusTwo = NewTwoPlusTwoEval()
+		// lazily build lookup table on first use
+		var once sync.Once
+		var f func([]Card) EvalRank
+		twoPlusTwo = func(v []Card) EvalRank {
+			once.Do(func() {
+				f = NewTwoPlusTwoEval()
+			})
+			return f(v)
+		}
 	}
 }
 
@@ -28,8 +37,8 @@ func init() {
 //
 // [TwoPlusTwoHandEvaluator]: https://github.com/tangentforks/TwoPlusTwoHandEvaluator
 func NewTwoPlusTwoEval() func([]Card) EvalRank {
-	const total, chunk, last = 32487834, 2621440, 1030554
-	tbl, pos := make([]uint32, total), 0
+	const chunk, last = 2621440, 1030554
+	tbl, pos := make([]uint32, twoPlusTwoLen), 0
 	for i, buf := range [][]byte{
 		twoplustwo00Dat,
 		twoplustwo01Dat,
@@ -57,37 +66,8 @@ func NewTwoPlusTwoEval() func([]Card) EvalRank {
 		}
 		pos += n / 4
 	}
-	if pos != total {
+	if pos != twoPlusTwoLen {
 		panic("short read twoplustwo*.dat")
 	}
-	// build card map
-	m := make(map[Card]uint32, 52)
-	for i, r := uint32(0), Two; r <= Ace; r++ {
-		for _, s := range []Suit{Spade, Heart, Club, Diamond} {
-			m[New(r, s)] = i + 1
-			i++
-		}
-	}
-	ranks := [10]uint32{
-		uint32(Invalid),
-		uint32(HighCard),
-		uint32(Pair),
-		uint32(TwoPair),
-		uint32(ThreeOfAKind),
-		uint32(Straight),
-		uint32(Flush),
-		uint32(FullHouse),
-		uint32(FourOfAKind),
-		uint32(StraightFlush),
-	}
-	return func(v []Card) EvalRank {
-		i := uint32(53)
-		for _, c := range v {
-			i = tbl[i+m[c]]
-		}
-		if len(v) < 7 {
-			i = tbl[i]
-		}
-		return EvalRank(ranks[i>>12] - i&0xfff + 1)
-	}
+	return newTwoPlusTwoEval(tbl)
 }
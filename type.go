@@ -29,6 +29,9 @@ import (
 // [Short] is a [Holdem] variant using a Short deck of 36 cards, having only
 // cards with ranks of 6+ (see [DeckShort]). [Flush] ranks over [FullHouse].
 //
+// [ShortTrips] is a [Short] variant, additionally ranking [ThreeOfAKind] over
+// [Straight], as used by rooms playing Short deck as an ante game.
+//
 // [Manila] is a [Holdem] variant using a Manila deck of 32 cards, having only
 // cards with ranks of 7+ (see [DeckManila]), forcing the use of the 2 pocket
 // cards, adding a Drop street before the Flop, and with all 5 streets
@@ -108,6 +111,10 @@ import (
 // use of 2 of the 5 pocket cards and any 3 of the 5 board cards to make the
 // best-5.
 //
+// [OmahaFiveHiLo] is the Hi/Lo variant of [OmahaFive], using a
+// [Eight]-or-better qualifier (see [RankEightOrBetter]) for the Lo. Also known
+// as Big O.
+//
 // [OmahaSix] is a [Holdem]/[Omaha] variant with 6 pocket cards, requiring the
 // use of 2 of the 6 pocket cards and any 3 of the 5 board cards to make the
 // best-5.
@@ -141,7 +148,7 @@ import (
 // 6th, 7th, and River streets using a [Two]-to-[Seven] low inverted ranking
 // system, where [Ace]'s are always high, and non-[Flush], and non-[Straight]
 // lows are best. Up to 5 pocket cards may be drawn (exchanged) exactly once on
-// either the 6th, 7th, or River streets.
+// either the 6th, 7th, or River streets (see [Dealer.Draw]).
 //
 // [LowballTriple] is a [Lowball] variant, where up to 5 pocket cards may be
 // drawn (exchanged) on any of the 6th, 7th, or River streets.
@@ -156,13 +163,26 @@ import (
 // (exchanged) multiple times on the 5th, 6th, or River streets. See
 // [NewBadugiEval] for more details.
 //
+// [OFC] is an Open Face Chinese game using a standard deck of 52 cards (see
+// [DeckFrench]), where each position places 13 pocket cards into a top row of
+// 3 cards, and middle and bottom rows of 5 cards each (see [OFCHand]). 5
+// pocket cards are dealt on the 1st street, followed by 1 pocket card on each
+// of the 2nd through 9th streets. Rows must increase in strength from top to
+// bottom, and are scored row by row against each other position (see
+// [OFCScore]). Pocket cards are evaluated in placement order (see
+// [NewOFCEval]).
+//
+// [OFCPineapple] is a [OFC] variant, where 3 pocket cards are dealt on each
+// of the 2nd through 5th streets, with 2 cards placed and 1 card discarded.
+//
 // [Kuhn] is a best high card game, using a 3 card deck ([King], [Queen],
 // [Jack]), having 1 pocket card and no community board cards. Useful for game
 // tree testing. See [Kuhn poker].
 //
 // [Leduc] is a best high card game, using a 6 card deck ([King], [Queen],
 // [Jack] each of the [Spade] and [Heart]), having 1 pocket card and 1
-// community card. Useful for game tree testing. See [Deepstack Leduc].
+// community card, where a pocket card pairing the community card is best (see
+// [NewLeducEval]). Useful for game tree testing. See [Deepstack Leduc].
 //
 // [RhodeIsland] is a best-3 card game that is simplified version of [Holdem],
 // using a standard deck of 52 cards (see [DeckFrench]), having 1 pocket card,
@@ -180,6 +200,7 @@ const (
 	Holdem         Type = 'H'<<8 | 'h' // Hh
 	Split          Type = 'H'<<8 | 'l' // Hl
 	Short          Type = 'H'<<8 | 's' // Hs
+	ShortTrips     Type = 'H'<<8 | 'S' // HS
 	Manila         Type = 'H'<<8 | 'm' // Hm
 	Spanish        Type = 'H'<<8 | 'p' // Hp
 	Royal          Type = 'H'<<8 | 'r' // Hr
@@ -199,6 +220,7 @@ const (
 	OmahaHiLo      Type = 'O'<<8 | 'l' // Ol
 	OmahaDouble    Type = 'O'<<8 | 'd' // Od
 	OmahaFive      Type = 'O'<<8 | '5' // O5
+	OmahaFiveHiLo  Type = 'O'<<8 | 'b' // Ob
 	OmahaSix       Type = 'O'<<8 | '6' // O6
 	Jakarta        Type = 'O'<<8 | 'r' // Or
 	Courchevel     Type = 'O'<<8 | 'c' // Oc
@@ -211,6 +233,10 @@ const (
 	LowballTriple  Type = 'L'<<8 | '3' // L3
 	Razz           Type = 'R'<<8 | 'a' // Ra
 	Badugi         Type = 'B'<<8 | 'a' // Ba
+	OFC            Type = 'C'<<8 | 'o' // Co
+	OFCPineapple   Type = 'C'<<8 | 'p' // Cp
+	Kuhn           Type = 'K'<<8 | 'u' // Ku
+	Leduc          Type = 'L'<<8 | 'e' // Le
 )
 
 // DefaultTypes returns the default type descriptions. The returned
@@ -260,8 +286,12 @@ func DefaultTypes() []TypeDesc {
 		{"L3", LowballTriple, "LowballTriple", WithLowball(true)},
 		{"Ra", Razz, "Razz", WithRazz()},
 		{"Ba", Badugi, "Badugi", WithBadugi()},
-		// {"Ku", Kuhn, "Kuhn", WithKuhn()},
-		// {"Le", Leduc, "Leduc", WithLeduc()},
+		{"Co", OFC, "OFC", WithOFC(false)},
+		{"Cp", OFCPineapple, "OFCPineapple", WithOFC(true)},
+		{"Ku", Kuhn, "Kuhn", WithKuhn()},
+		{"Le", Leduc, "Leduc", WithLeduc()},
+		{"HS", ShortTrips, "ShortTrips", WithShortTrips()},
+		{"Ob", OmahaFiveHiLo, "OmahaFiveHiLo", WithOmahaFive(true)},
 		// {"RI", RhodeIsland, "RhodeIsland", WithRhodeIsland()},
 	} {
 		desc, err := NewType(d.id, d.typ, d.name, d.opt)
@@ -479,15 +509,36 @@ func (typ Type) FlushOver() bool {
 	return descs[typ].Eval.FlushOver()
 }
 
-// Eval creates a new eval for the type, evaluating the pocket and board.
+// TripsOver returns true when the type's eval is a TripsOver eval.
+func (typ Type) TripsOver() bool {
+	return descs[typ].Eval.TripsOver()
+}
+
+// Eval creates a new eval for the type, evaluating the pocket and board. The
+// pocket and board are not validated, see [Type.Validate].
 func (typ Type) Eval(pocket, board []Card) *Eval {
 	ev := EvalOf(typ)
 	evals[typ](ev, pocket, board)
 	return ev
 }
 
+// EvalInto resets the eval for the type, and evaluates the pocket and board
+// into the eval, using the same eval as the odds calculators. Use with a
+// reused eval (see [GetEval]) for evaluating in hot loops, such as a solver,
+// without allocating.
+//
+// Only the eval's ranks are comparable with [Type.Eval]. Best and unused cards
+// are not normalized, and are not set by some evals (ie, the Two-Plus-Two
+// eval, see [NewHybridEval]). Best and unused cards previously retrieved from
+// the eval must not be used after being evaluated into.
+func (typ Type) EvalInto(ev *Eval, pocket, board []Card) {
+	ev.Reset(typ)
+	calcs[typ](ev, pocket, board)
+}
+
 // EvalPockets creates new evals for the type, evaluating each of the pockets
-// and board.
+// and board. The pockets and board are not validated, see
+// [Type.ValidatePockets].
 func (typ Type) EvalPockets(pockets [][]Card, board []Card) []*Eval {
 	evs := make([]*Eval, len(pockets))
 	for i := range len(pockets) {
@@ -507,6 +558,63 @@ func (typ Type) ExpValue(ctx context.Context, pocket []Card, opts ...CalcOption)
 	return NewExpValueCalc(typ, pocket, opts...).Calc(ctx)
 }
 
+// Caps returns the type's capabilities.
+func (typ Type) Caps() TypeCaps {
+	desc, ok := descs[typ]
+	if !ok {
+		return TypeCaps{}
+	}
+	caps := TypeCaps{
+		Deck:      desc.Deck,
+		Eval:      desc.Eval,
+		Max:       desc.Max,
+		Pocket:    desc.pocket,
+		Board:     desc.board,
+		Streets:   len(desc.Streets),
+		Low:       desc.Low,
+		Double:    desc.Double,
+		Draw:      desc.draw,
+		Cactus:    desc.Eval.Cactus(),
+		FlushOver: desc.Eval.FlushOver(),
+		TripsOver: desc.Eval.TripsOver(),
+	}
+	for _, street := range desc.Streets {
+		caps.Up = caps.Up || 0 < street.PocketUp
+	}
+	return caps
+}
+
+// TypeCaps are a type's capabilities, for building generic interfaces
+// without hardcoding types.
+type TypeCaps struct {
+	// Deck is the deck type.
+	Deck DeckType
+	// Eval is the eval type.
+	Eval EvalType
+	// Max is the max number of players.
+	Max int
+	// Pocket is the total dealt pocket cards.
+	Pocket int
+	// Board is the total dealt board cards.
+	Board int
+	// Streets is the number of streets.
+	Streets int
+	// Low is true when the type has an 8-or-better Lo eval.
+	Low bool
+	// Double is true when the type has double boards.
+	Double bool
+	// Draw is true when one or more streets allows draws.
+	Draw bool
+	// Up is true when pocket cards are revealed (ie, [Stud]).
+	Up bool
+	// Cactus is true when the eval is a Cactus eval.
+	Cactus bool
+	// FlushOver is true when the eval is a FlushOver eval.
+	FlushOver bool
+	// TripsOver is true when the eval is a TripsOver eval.
+	TripsOver bool
+}
+
 // TypeDesc is a type description.
 type TypeDesc struct {
 	// Num is the registered number.
@@ -527,6 +635,11 @@ type TypeDesc struct {
 	Show bool
 	// Once is true when a draw can only occur once.
 	Once bool
+	// BoardOrder is the dealing order of double boards.
+	BoardOrder BoardOrder
+	// SharedBurn is true when double boards share a single board discard on
+	// each street, rather than each board discarding separately.
+	SharedBurn bool
 	// Blinds are the blind names.
 	Blinds []string
 	// Streets are the betting streets.
@@ -589,6 +702,31 @@ func (desc *TypeDesc) Apply(opts ...StreetOption) {
 	}
 }
 
+// BoardOrder is a dealing order for double boards.
+type BoardOrder uint8
+
+// Board orders.
+const (
+	// BoardInterleaved deals each street's cards for the Hi board and then
+	// the Lo board.
+	BoardInterleaved BoardOrder = iota
+	// BoardSequential deals the remaining cards for the Hi board and then the
+	// Lo board, when the first street having board cards is dealt. Board
+	// cards are still revealed (ie, appended to the run's boards) street by
+	// street.
+	BoardSequential
+)
+
+// WithBoardOrder is a type description option to set the dealing order for
+// double boards, and whether double boards share a single board discard on
+// each street.
+func WithBoardOrder(order BoardOrder, sharedBurn bool) TypeOption {
+	return func(desc *TypeDesc) {
+		desc.BoardOrder = order
+		desc.SharedBurn = sharedBurn
+	}
+}
+
 // StreetOption is a street option.
 type StreetOption func(int, *StreetDesc)
 
@@ -619,6 +757,20 @@ func WithShort(opts ...StreetOption) TypeOption {
 	}
 }
 
+// WithShortTrips is a type description option to set [ShortTrips]
+// definitions.
+func WithShortTrips(opts ...StreetOption) TypeOption {
+	return func(desc *TypeDesc) {
+		desc.Max = 6
+		desc.Blinds = HoldemBlinds()
+		desc.Streets = HoldemStreets(2, 1, 3, 1, 1)
+		desc.Deck = DeckShort
+		desc.Eval = EvalShortTrips
+		desc.HiDesc = DescShortTrips
+		desc.Apply(opts...)
+	}
+}
+
 // WithManila is a type description option to set [Manila] definitions.
 func WithManila(opts ...StreetOption) TypeOption {
 	return func(desc *TypeDesc) {
@@ -937,7 +1089,7 @@ func WithBadugi(opts ...StreetOption) TypeOption {
 		desc.Streets = NumberedStreets(4, 0, 0, 0)
 		desc.Blinds = HoldemBlinds()
 		desc.Eval = EvalBadugi
-		desc.HiDesc = DescLow
+		desc.HiDesc = DescBadugi
 		for i := 1; i < 4; i++ {
 			desc.Streets[i].PocketDraw = 4
 		}
@@ -945,6 +1097,21 @@ func WithBadugi(opts ...StreetOption) TypeOption {
 	}
 }
 
+// WithOFC is a type description option to set [OFC] definitions.
+func WithOFC(pineapple bool, opts ...StreetOption) TypeOption {
+	return func(desc *TypeDesc) {
+		desc.Max = 4
+		desc.Streets = OFCStreets(5, 1, 1, 1, 1, 1, 1, 1, 1)
+		if pineapple {
+			desc.Max = 3
+			desc.Streets = OFCStreets(5, 3, 3, 3, 3)
+		}
+		desc.Eval = EvalOFC
+		desc.HiDesc = DescOFC
+		desc.Apply(opts...)
+	}
+}
+
 // WithKuhn is a type description option to set [Kuhn] definitions.
 func WithKuhn(opts ...StreetOption) TypeOption {
 	return func(desc *TypeDesc) {
@@ -983,8 +1150,8 @@ func WithLeduc(opts ...StreetOption) TypeOption {
 				Board: 1,
 			},
 		}
-		desc.Eval = EvalHigh
-		desc.HiDesc = DescHigh
+		desc.Eval = EvalLeduc
+		desc.HiDesc = DescLeduc
 		desc.Apply(opts...)
 	}
 }
@@ -1101,6 +1268,19 @@ func StudStreets() []StreetDesc {
 	return v
 }
 
+// OFCStreets creates [OFC] streets (1st, 2nd, ...) for each of the pockets.
+func OFCStreets(pockets ...int) []StreetDesc {
+	v := make([]StreetDesc, len(pockets))
+	for i, n := range pockets {
+		v[i] = StreetDesc{
+			Id:     '1' + byte(i),
+			Name:   ordinal(i + 1),
+			Pocket: n,
+		}
+	}
+	return v
+}
+
 // NumberedStreets creates numbered streets (Ante, 1st, 2nd, ..., River) for
 // each of the pockets.
 func NumberedStreets(pockets ...int) []StreetDesc {
@@ -1130,6 +1310,123 @@ func NumberedStreets(pockets ...int) []StreetDesc {
 	return v
 }
 
+// StreetBuilder is a fluent builder for a type's streets, allowing custom
+// dealing sequences to be defined without using [StreetDesc] directly. Counts
+// set on the builder apply to the most recently added street.
+//
+// Example:
+//
+//	streets, err := cardrank.NewStreetBuilder().
+//		Street('p', "Pre-Flop").Pocket(3).
+//		Street('f', "Flop").BoardDiscard(1).Board(3).
+//		Street('r', "River").PocketDiscard(1).Pocket(1).Up(1).
+//		Build(cardrank.DeckFrench, 8)
+type StreetBuilder struct {
+	streets []StreetDesc
+	err     error
+}
+
+// NewStreetBuilder creates a new street builder.
+func NewStreetBuilder() *StreetBuilder {
+	return new(StreetBuilder)
+}
+
+// Street adds a street with the id and name.
+func (b *StreetBuilder) Street(id byte, name string) *StreetBuilder {
+	b.streets = append(b.streets, StreetDesc{
+		Id:   id,
+		Name: name,
+	})
+	return b
+}
+
+// Pocket sets the count of pocket cards dealt to each player on the street.
+func (b *StreetBuilder) Pocket(n int) *StreetBuilder {
+	return b.set(n, func(street *StreetDesc) { street.Pocket = n })
+}
+
+// Up sets the count of the street's pocket cards that are revealed.
+func (b *StreetBuilder) Up(n int) *StreetBuilder {
+	return b.set(n, func(street *StreetDesc) { street.PocketUp = n })
+}
+
+// PocketDiscard sets the count of cards discarded before pockets are dealt on
+// the street.
+func (b *StreetBuilder) PocketDiscard(n int) *StreetBuilder {
+	return b.set(n, func(street *StreetDesc) { street.PocketDiscard = n })
+}
+
+// Draw sets the count of pocket cards that can be drawn on the street.
+func (b *StreetBuilder) Draw(n int) *StreetBuilder {
+	return b.set(n, func(street *StreetDesc) { street.PocketDraw = n })
+}
+
+// Board sets the count of board cards dealt on the street.
+func (b *StreetBuilder) Board(n int) *StreetBuilder {
+	return b.set(n, func(street *StreetDesc) { street.Board = n })
+}
+
+// BoardDiscard sets the count of cards discarded before the board is dealt on
+// the street.
+func (b *StreetBuilder) BoardDiscard(n int) *StreetBuilder {
+	return b.set(n, func(street *StreetDesc) { street.BoardDiscard = n })
+}
+
+// set sets a count on the last street, recording an error when there is no
+// street or the count is negative.
+func (b *StreetBuilder) set(n int, f func(*StreetDesc)) *StreetBuilder {
+	switch {
+	case b.err != nil:
+	case len(b.streets) == 0, n < 0:
+		b.err = ErrInvalidStreet
+	default:
+		f(&b.streets[len(b.streets)-1])
+	}
+	return b
+}
+
+// Build returns the built streets, validating that each street has a unique
+// letter or number id, that no more pocket cards are revealed than dealt, and
+// that the deck has enough cards to deal every street to the max number of
+// players. Drawn cards are not counted, as draws may reuse discards.
+//
+// Returns [ErrInvalidStreet] when a street is not valid, or
+// [ErrNotEnoughCards] when the deck does not have enough cards.
+func (b *StreetBuilder) Build(deck DeckType, max int) ([]StreetDesc, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.streets) == 0 || max < 1 {
+		return nil, ErrInvalidStreet
+	}
+	m := make(map[byte]bool)
+	var count int
+	for _, street := range b.streets {
+		switch {
+		case (!unicode.IsLetter(rune(street.Id)) && !unicode.IsNumber(rune(street.Id))) || m[street.Id],
+			street.Pocket < street.PocketUp,
+			street.Pocket == 0 && street.PocketDiscard != 0,
+			street.Board == 0 && street.BoardDiscard != 0:
+			return nil, ErrInvalidStreet
+		}
+		m[street.Id] = true
+		count += street.PocketDiscard + max*street.Pocket + street.BoardDiscard + street.Board
+	}
+	if deck.Len() < count {
+		return nil, ErrNotEnoughCards
+	}
+	return slices.Clone(b.streets), nil
+}
+
+// WithStreets is a type description option to set the streets, such as those
+// created with a [StreetBuilder].
+func WithStreets(streets []StreetDesc, opts ...StreetOption) TypeOption {
+	return func(desc *TypeDesc) {
+		desc.Streets = slices.Clone(streets)
+		desc.Apply(opts...)
+	}
+}
+
 // EvalType is a eval type.
 type EvalType uint8
 
@@ -1138,6 +1435,7 @@ const (
 	EvalCactus        EvalType = 0
 	EvalJacksOrBetter EvalType = 'j'
 	EvalShort         EvalType = 't'
+	EvalShortTrips    EvalType = 'i'
 	EvalManila        EvalType = 'm'
 	EvalSpanish       EvalType = 'p'
 	EvalOmaha         EvalType = 'o'
@@ -1146,6 +1444,8 @@ const (
 	EvalRazz          EvalType = 'r'
 	EvalBadugi        EvalType = 'b'
 	EvalHigh          EvalType = 'h'
+	EvalLeduc         EvalType = 'e'
+	EvalOFC           EvalType = 'n'
 )
 
 // New creates a eval func for the type.
@@ -1157,6 +1457,10 @@ func (typ EvalType) New(board int, normalize, low bool) EvalFunc {
 		return NewJacksOrBetterEval(normalize)
 	case EvalShort:
 		return NewModifiedEval(RankShort, Rank(DeckShort), EvalRank.FromFlushOver, normalize, false)
+	case EvalShortTrips:
+		return NewModifiedEval(RankShortTrips, Rank(DeckShort), func(r EvalRank) EvalRank {
+			return r.FromTripsOver().FromFlushOver()
+		}, normalize, false)
 	case EvalManila:
 		return NewOmahaEval(RankManila, Rank(DeckManila), EvalRank.FromFlushOver, normalize, false)
 	case EvalSpanish:
@@ -1173,6 +1477,10 @@ func (typ EvalType) New(board int, normalize, low bool) EvalFunc {
 		return NewBadugiEval(normalize)
 	case EvalHigh:
 		return NewHighEval()
+	case EvalLeduc:
+		return NewLeducEval()
+	case EvalOFC:
+		return NewOFCEval()
 		/*
 			case EvalThree:
 				return NewThreeEval()
@@ -1186,6 +1494,7 @@ func (typ EvalType) Cactus() bool {
 	switch typ {
 	case EvalCactus,
 		EvalShort,
+		EvalShortTrips,
 		EvalManila,
 		EvalSpanish,
 		EvalOmaha,
@@ -1198,12 +1507,18 @@ func (typ EvalType) Cactus() bool {
 // FlushOver returns true when a cactus eval's [Flush] ranks over a [FullHouse].
 func (typ EvalType) FlushOver() bool {
 	switch typ {
-	case EvalShort, EvalManila, EvalSpanish:
+	case EvalShort, EvalShortTrips, EvalManila, EvalSpanish:
 		return true
 	}
 	return false
 }
 
+// TripsOver returns true when a cactus eval's [ThreeOfAKind] ranks over a
+// [Straight].
+func (typ EvalType) TripsOver() bool {
+	return typ == EvalShortTrips
+}
+
 // Format satisfies the [fmt.Formatter] interface.
 func (typ EvalType) Format(f fmt.State, verb rune) {
 	var buf []byte
@@ -1227,6 +1542,7 @@ func (typ EvalType) Byte() byte {
 		return 'c'
 	case EvalJacksOrBetter,
 		EvalShort,
+		EvalShortTrips,
 		EvalManila,
 		EvalSpanish,
 		EvalOmaha,
@@ -1234,7 +1550,9 @@ func (typ EvalType) Byte() byte {
 		EvalLowball,
 		EvalRazz,
 		EvalBadugi,
-		EvalHigh:
+		EvalHigh,
+		EvalLeduc,
+		EvalOFC:
 		// EvalThree:
 		return byte(typ)
 	}
@@ -1250,6 +1568,8 @@ func (typ EvalType) Name() string {
 		return "JacksOrBetter"
 	case EvalShort:
 		return "Short"
+	case EvalShortTrips:
+		return "ShortTrips"
 	case EvalManila:
 		return "Manila"
 	case EvalSpanish:
@@ -1266,6 +1586,10 @@ func (typ EvalType) Name() string {
 		return "Badugi"
 	case EvalHigh:
 		return "High"
+	case EvalLeduc:
+		return "Leduc"
+	case EvalOFC:
+		return "OFC"
 		/*
 			case EvalThree:
 				return "Three"
@@ -1279,14 +1603,18 @@ type DescType uint8
 
 // Description types.
 const (
-	DescCactus    DescType = 0
-	DescFlushOver DescType = 'f'
-	DescSoko      DescType = 'k'
-	DescLow       DescType = 'l'
-	DescLowball   DescType = 'b'
-	DescRazz      DescType = 'r'
-	DescHigh      DescType = 'h'
-	DescThree     DescType = '3'
+	DescCactus     DescType = 0
+	DescFlushOver  DescType = 'f'
+	DescShortTrips DescType = 'i'
+	DescSoko       DescType = 'k'
+	DescLow        DescType = 'l'
+	DescLowball    DescType = 'b'
+	DescRazz       DescType = 'r'
+	DescHigh       DescType = 'h'
+	DescThree      DescType = '3'
+	DescLeduc      DescType = 'e'
+	DescBadugi     DescType = 'g'
+	DescOFC        DescType = 'n'
 )
 
 // Format satisfies the [fmt.Formatter] interface.
@@ -1311,17 +1639,40 @@ func (typ DescType) Byte() byte {
 	case DescCactus:
 		return 'c'
 	case DescFlushOver,
+		DescShortTrips,
 		DescSoko,
 		DescLow,
 		DescLowball,
 		DescRazz,
 		DescHigh,
-		DescThree:
+		DescThree,
+		DescLeduc,
+		DescBadugi,
+		DescOFC:
 		return byte(typ)
 	}
 	return ' '
 }
 
+// MarshalText satisfies the [encoding.TextMarshaler] interface.
+func (typ DescType) MarshalText() ([]byte, error) {
+	if name := typ.Name(); name != "" {
+		return []byte(name), nil
+	}
+	return nil, ErrInvalidType
+}
+
+// UnmarshalText satisfies the [encoding.TextUnmarshaler] interface.
+func (typ *DescType) UnmarshalText(buf []byte) error {
+	for _, t := range []DescType{DescCactus, DescFlushOver, DescShortTrips, DescSoko, DescLow, DescLowball, DescRazz, DescHigh, DescThree, DescLeduc, DescBadugi, DescOFC} {
+		if t.Name() == string(buf) {
+			*typ = t
+			return nil
+		}
+	}
+	return ErrInvalidType
+}
+
 // Name returns the description type name.
 func (typ DescType) Name() string {
 	switch typ {
@@ -1329,6 +1680,8 @@ func (typ DescType) Name() string {
 		return "Cactus"
 	case DescFlushOver:
 		return "FlushOver"
+	case DescShortTrips:
+		return "ShortTrips"
 	case DescSoko:
 		return "Soko"
 	case DescLow:
@@ -1341,6 +1694,12 @@ func (typ DescType) Name() string {
 		return "High"
 	case DescThree:
 		return "Three"
+	case DescLeduc:
+		return "Leduc"
+	case DescBadugi:
+		return "Badugi"
+	case DescOFC:
+		return "OFC"
 	}
 	return ""
 }
@@ -1369,6 +1728,8 @@ func (typ DescType) Desc(f fmt.State, verb rune, rank EvalRank, best, unused []C
 			LowDesc(f, verb, rank, best, unused)
 		case DescFlushOver:
 			FlushOverDesc(f, verb, rank, best, unused)
+		case DescShortTrips:
+			ShortTripsDesc(f, verb, rank, best, unused)
 		case DescRazz:
 			RazzDesc(f, verb, rank, best, unused)
 		case DescLowball:
@@ -1379,6 +1740,12 @@ func (typ DescType) Desc(f fmt.State, verb rune, rank EvalRank, best, unused []C
 			HighDesc(f, verb, rank, best, unused)
 		case DescThree:
 			ThreeDesc(f, verb, rank, best, unused)
+		case DescLeduc:
+			LeducDesc(f, verb, rank, best, unused)
+		case DescBadugi:
+			BadugiDesc(f, verb, rank, best, unused)
+		case DescOFC:
+			OFCDesc(f, verb, rank, best, unused)
 		}
 	}
 }
@@ -1480,6 +1847,12 @@ func FlushOverDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
 	CactusDesc(f, verb, rank.FromFlushOver(), best, unused)
 }
 
+// ShortTripsDesc writes a [ShortTrips] description to f for the rank, best,
+// and unused cards.
+func ShortTripsDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
+	CactusDesc(f, verb, rank.FromTripsOver().FromFlushOver(), best, unused)
+}
+
 // SokoDesc writes a [Soko] description to f for the rank, best, and unused cards.
 func SokoDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
 	switch {
@@ -1564,6 +1937,57 @@ func HighDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
 	}
 }
 
+// LeducDesc writes a [Leduc] description to f for the rank, best, and unused
+// cards.
+//
+// Examples:
+//
+//	Pair, Kings
+//	Queen-high
+func LeducDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
+	switch {
+	case rank == 0 || rank == Invalid || 2*leducPairs < rank:
+		fmt.Fprintf(f, "%s", Invalid)
+	case rank <= leducPairs && (verb == 'e' || verb == 'S'):
+		fmt.Fprint(f, "Pair")
+	case rank <= leducPairs:
+		fmt.Fprintf(f, "Pair, %s", (Ace - Rank(rank-1)).PluralName())
+	default:
+		fmt.Fprintf(f, "%s-high", (Ace - Rank(rank-leducPairs-1)).Name())
+	}
+}
+
+// BadugiDesc writes a [Badugi] description to f for the rank, best, and
+// unused cards.
+//
+// Examples:
+//
+//	Badugi: 4-3-2-A
+//	Three-card badugi: 7-5-2
+//	Two-card badugi: J-9
+func BadugiDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
+	switch n := len(best); {
+	case rank == 0, rank == Invalid, n == 0, 4 < n:
+		_, _ = f.Write([]byte("None"))
+		return
+	case n == 4:
+		_, _ = f.Write([]byte("Badugi"))
+	default:
+		_, _ = f.Write([]byte([...]string{"One", "Two", "Three"}[n-1] + "-card badugi"))
+	}
+	if verb == 'e' || verb == 'S' {
+		return
+	}
+	buf := []byte(": ")
+	for i, c := range best {
+		if i != 0 {
+			buf = append(buf, '-')
+		}
+		buf = append(buf, c.Rank().Byte())
+	}
+	_, _ = f.Write(buf)
+}
+
 // ThreeDesc writes a [Three] description to f for the rank, best, and unused
 // cards.
 func ThreeDesc(f fmt.State, verb rune, rank EvalRank, best, unused []Card) {
@@ -218,6 +218,31 @@ func TestRazz(t *testing.T) {
 	}
 }
 
+func TestLeduc(t *testing.T) {
+	tests := []struct {
+		v   string
+		b   string
+		exp EvalRank
+		s   string
+	}{
+		{"Ks", "", 15, "King-high [Ks]"},
+		{"Js", "", 17, "Jack-high [Js]"},
+		{"Ks", "Kh", 2, "Pair, Kings [Ks Kh]"},
+		{"Jh", "Js", 4, "Pair, Jacks [Jh Js]"},
+		{"Qh", "Ks", 16, "Queen-high [Qh]"},
+		{"Kh", "Js", 15, "King-high [Kh]"},
+	}
+	for i, test := range tests {
+		ev := Leduc.Eval(Must(test.v), Must(test.b))
+		if ev.HiRank != test.exp {
+			t.Errorf("test %d expected rank %d, got: %d", i, test.exp, ev.HiRank)
+		}
+		if s := fmt.Sprintf("%s", ev); s != test.s {
+			t.Errorf("test %d expected %q, got: %q", i, test.s, s)
+		}
+	}
+}
+
 func TestBadugi(t *testing.T) {
 	tests := []struct {
 		v   string
@@ -226,25 +251,26 @@ func TestBadugi(t *testing.T) {
 		exp EvalRank
 		s   string
 	}{
-		{"Kh Qh Jh Th", "Th", "Kh Qh Jh", 25088, "Ten-low [Th]"},
-		{"Kh Qh Jd Th", "Jd Th", "Kh Qh", 17920, "Jack, Ten-low [Jd Th]"},
-		{"Kh Qc Jd Th", "Qc Jd Th", "Kh", 11776, "Queen, Jack, Ten-low [Qc Jd Th]"},
-		{"Ks Qc Jd Th", "Ks Qc Jd Th", "", 7680, "King, Queen, Jack, Ten-low [Ks Qc Jd Th]"},
-		{"2h 2c 2d 2s", "2s", "2c 2d 2h", 24578, "Two-low [2s]"},
-		{"Ah Kh Qh Jh", "Ah", "Kh Qh Jh", 24577, "Ace-low [Ah]"},
-		{"Kh Kd Qd Qs", "Kh Qs", "Kd Qd", 22528, "King, Queen-low [Kh Qs]"},
-		{"Ah Ac Ad Ks", "Ks Ah", "Ac Ad", 20481, "King, Ace-low [Ks Ah]"},
-		{"3h 3c Kh Qd", "Kh Qd 3c", "3h", 14340, "King, Queen, Three-low [Kh Qd 3c]"},
-		{"2h 2c Kh Qd", "Kh Qd 2c", "2h", 14338, "King, Queen, Two-low [Kh Qd 2c]"},
-		{"3h 2c Kh Ks", "Ks 3h 2c", "Kh", 12294, "King, Three, Two-low [Ks 3h 2c]"},
-		{"3h 2c Kh Qd", "Qd 3h 2c", "Kh", 10246, "Queen, Three, Two-low [Qd 3h 2c]"},
-		{"Ah 2c 4s 6d", "6d 4s 2c Ah", "", 43, "Six, Four, Two, Ace-low [6d 4s 2c Ah]"},
-		{"Ac 2h 4d 6s", "6s 4d 2h Ac", "", 43, "Six, Four, Two, Ace-low [6s 4d 2h Ac]"},
-		{"Ah 2c 3s 6d", "6d 3s 2c Ah", "", 39, "Six, Three, Two, Ace-low [6d 3s 2c Ah]"},
-		{"Ah 2c 4s 5d", "5d 4s 2c Ah", "", 27, "Five, Four, Two, Ace-low [5d 4s 2c Ah]"},
-		{"Ah 2c 3s 5d", "5d 3s 2c Ah", "", 23, "Five, Three, Two, Ace-low [5d 3s 2c Ah]"},
-		{"Ah 2c 3s 4d", "4d 3s 2c Ah", "", 15, "Four, Three, Two, Ace-low [4d 3s 2c Ah]"},
-		{"Ac 2h 3s 4d", "4d 3s 2h Ac", "", 15, "Four, Three, Two, Ace-low [4d 3s 2h Ac]"},
+		{"Kh Qh Jh Th", "Th", "Kh Qh Jh", 25088, "One-card badugi: T [Th]"},
+		{"Kh Qh Jd Th", "Jd Th", "Kh Qh", 17920, "Two-card badugi: J-T [Jd Th]"},
+		{"Kh Qc Jd Th", "Qc Jd Th", "Kh", 11776, "Three-card badugi: Q-J-T [Qc Jd Th]"},
+		{"Ks Qc Jd Th", "Ks Qc Jd Th", "", 7680, "Badugi: K-Q-J-T [Ks Qc Jd Th]"},
+		{"2h 2c 2d 2s", "2s", "2c 2d 2h", 24578, "One-card badugi: 2 [2s]"},
+		{"Ah Kh Qh Jh", "Ah", "Kh Qh Jh", 24577, "One-card badugi: A [Ah]"},
+		{"Kh Kd Qd Qs", "Kh Qs", "Kd Qd", 22528, "Two-card badugi: K-Q [Kh Qs]"},
+		{"Ah Ac Ad Ks", "Ks Ah", "Ac Ad", 20481, "Two-card badugi: K-A [Ks Ah]"},
+		{"Ks 6h As Ah", "6h As", "Ah Ks", 16417, "Two-card badugi: 6-A [6h As]"},
+		{"3h 3c Kh Qd", "Kh Qd 3c", "3h", 14340, "Three-card badugi: K-Q-3 [Kh Qd 3c]"},
+		{"2h 2c Kh Qd", "Kh Qd 2c", "2h", 14338, "Three-card badugi: K-Q-2 [Kh Qd 2c]"},
+		{"3h 2c Kh Ks", "Ks 3h 2c", "Kh", 12294, "Three-card badugi: K-3-2 [Ks 3h 2c]"},
+		{"3h 2c Kh Qd", "Qd 3h 2c", "Kh", 10246, "Three-card badugi: Q-3-2 [Qd 3h 2c]"},
+		{"Ah 2c 4s 6d", "6d 4s 2c Ah", "", 43, "Badugi: 6-4-2-A [6d 4s 2c Ah]"},
+		{"Ac 2h 4d 6s", "6s 4d 2h Ac", "", 43, "Badugi: 6-4-2-A [6s 4d 2h Ac]"},
+		{"Ah 2c 3s 6d", "6d 3s 2c Ah", "", 39, "Badugi: 6-3-2-A [6d 3s 2c Ah]"},
+		{"Ah 2c 4s 5d", "5d 4s 2c Ah", "", 27, "Badugi: 5-4-2-A [5d 4s 2c Ah]"},
+		{"Ah 2c 3s 5d", "5d 3s 2c Ah", "", 23, "Badugi: 5-3-2-A [5d 3s 2c Ah]"},
+		{"Ah 2c 3s 4d", "4d 3s 2c Ah", "", 15, "Badugi: 4-3-2-A [4d 3s 2c Ah]"},
+		{"Ac 2h 3s 4d", "4d 3s 2h Ac", "", 15, "Badugi: 4-3-2-A [4d 3s 2h Ac]"},
 	}
 	for i, test := range tests {
 		pocket, best, unused := Must(test.v), Must(test.b), Must(test.u)
@@ -403,6 +429,14 @@ func TestTypeUnmarshal(t *testing.T) {
 		{"razz", Razz},
 		{"BaDUGI", Badugi},
 		{"fusIon", Fusion},
+		{"kuhn", Kuhn},
+		{"Le", Leduc},
+		{"ofc", OFC},
+		{"Cp", OFCPineapple},
+		{"shorttrips", ShortTrips},
+		{"omahafivehilo", OmahaFiveHiLo},
+		{"Ob", OmahaFiveHiLo},
+		{"HS", ShortTrips},
 	}
 	for i, test := range tests {
 		var typ Type
@@ -452,6 +486,10 @@ func TestDescType(t *testing.T) {
 		{Short, "7h 2h 2c 7s 7c", "%e", "Full House"},
 		{Short, "Ah 6c 7c 8s 9d", "%s", "Straight, Nine-high"},
 		{Short, "Ah 6c 7c 8s 9d", "%S", "Straight, Nine-high"},
+		{ShortTrips, "Ah 6c 7c 8s 9d", "%s", "Straight, Nine-high"},
+		{ShortTrips, "7h 7d 7c Ks 9d", "%s", "Three of a Kind, Sevens, kickers King, Nine"},
+		{ShortTrips, "7h 2h 2c 7s 7c", "%s", "Full House, Sevens full of Twos"},
+		{ShortTrips, "Ah Kh Qh 9h 8h", "%s", "Flush, Ace-high, kickers King, Queen, Nine, Eight"},
 		{Short, "Ah 6c 7c 8s 9d", "%e", "Straight"},
 		{Holdem, "6h As Qc Qd Qs", "%s", "Three of a Kind, Queens, kickers Ace, Six"},
 		{Holdem, "6h As Qc Qd Qs", "%S", "Three of a Kind, Queens"},
@@ -483,6 +521,11 @@ func TestDescType(t *testing.T) {
 		{Soko, "5c Qh 4h 3c 2c", "%s", "Four Straight, Five-high, kicker Queen"},
 		{Soko, "5c Qh 4h 3c 2c", "%S", "Four Straight, Five-high"},
 		{Soko, "5c Qh 4h 3c 2c", "%e", "Four Straight"},
+		{Badugi, "7h 5c 2d 7s", "%s", "Three-card badugi: 7-5-2"},
+		{Badugi, "7h 5c 2d 7s", "%S", "Three-card badugi"},
+		{Badugi, "7h 5c 2d 7s", "%e", "Three-card badugi"},
+		{Badugi, "4h 3c 2d As", "%s", "Badugi: 4-3-2-A"},
+		{Badugi, "4h 3c 2d As", "%S", "Badugi"},
 	}
 	for i, test := range tests {
 		ev := test.typ.Eval(Must(test.v), nil)
@@ -492,3 +535,87 @@ func TestDescType(t *testing.T) {
 		}
 	}
 }
+
+func TestTypesFilter(t *testing.T) {
+	tests := []struct {
+		filters []TypeFilter
+		exp     []Type
+	}{
+		{[]TypeFilter{FilterDeck(DeckShort)}, []Type{Short, ShortTrips}},
+		{[]TypeFilter{FilterDouble(true)}, []Type{Double, OmahaDouble}},
+		{[]TypeFilter{FilterPocket(4), FilterLow(true)}, []Type{OmahaHiLo, FusionHiLo}},
+		{[]TypeFilter{FilterPocket(5), FilterBoard(true), FilterLow(true)}, []Type{CourchevelHiLo, OmahaFiveHiLo}},
+		{[]TypeFilter{FilterBoard(false), FilterLow(true)}, []Type{DrawHiLo, StudHiLo, SokoHiLo}},
+		{[]TypeFilter{FilterDraw(true), FilterCactus(false)}, []Type{Video, Lowball, LowballTriple, Badugi}},
+	}
+	for i, test := range tests {
+		if v := Types(test.filters...); !slices.Equal(v, test.exp) {
+			t.Errorf("test %d expected %v, got: %v", i, test.exp, v)
+		}
+	}
+	if v := Types(); len(v) != len(DefaultTypes()) {
+		t.Errorf("expected %d, got: %d", len(DefaultTypes()), len(v))
+	}
+}
+
+func TestTypeCaps(t *testing.T) {
+	tests := []struct {
+		typ Type
+		exp TypeCaps
+	}{
+		{Holdem, TypeCaps{Deck: DeckFrench, Eval: EvalCactus, Max: 10, Pocket: 2, Board: 5, Streets: 4, Cactus: true}},
+		{Short, TypeCaps{Deck: DeckShort, Eval: EvalShort, Max: 6, Pocket: 2, Board: 5, Streets: 4, Cactus: true, FlushOver: true}},
+		{ShortTrips, TypeCaps{Deck: DeckShort, Eval: EvalShortTrips, Max: 6, Pocket: 2, Board: 5, Streets: 4, Cactus: true, FlushOver: true, TripsOver: true}},
+		{StudHiLo, TypeCaps{Deck: DeckFrench, Eval: EvalCactus, Max: 7, Pocket: 7, Streets: 5, Low: true, Up: true, Cactus: true}},
+		{Type(0), TypeCaps{}},
+	}
+	for _, test := range tests {
+		if caps := test.typ.Caps(); caps != test.exp {
+			t.Errorf("%s expected %+v, got: %+v", test.typ, test.exp, caps)
+		}
+	}
+}
+
+func TestStreetBuilder(t *testing.T) {
+	streets, err := NewStreetBuilder().
+		Street('p', "Pre-Flop").Pocket(2).
+		Street('f', "Flop").BoardDiscard(1).Board(3).
+		Street('t', "Turn").BoardDiscard(1).Board(1).
+		Street('r', "River").BoardDiscard(1).Board(1).
+		Build(DeckFrench, 10)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if exp := HoldemStreets(2, 1, 3, 1, 1); !slices.Equal(streets, exp) {
+		t.Errorf("expected %v, got: %v", exp, streets)
+	}
+	desc, err := NewType("Xh", 'X'<<8|'h', "Home", WithHoldem(false), WithStreets(streets))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if desc.pocket != 2 || desc.board != 5 || desc.boardDiscard != 3 {
+		t.Errorf("expected 2/5/3, got: %d/%d/%d", desc.pocket, desc.board, desc.boardDiscard)
+	}
+	tests := []struct {
+		b   *StreetBuilder
+		max int
+		err error
+	}{
+		{NewStreetBuilder(), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Pocket(1), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(-1), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(1).Up(2), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(1).Street('p', "Flop").Board(1), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Street(' ', "Pre-Flop").Pocket(1), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Street('f', "Flop").BoardDiscard(1), 2, ErrInvalidStreet},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(1), 0, ErrInvalidStreet},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(1), 3, nil},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(1), 4, ErrNotEnoughCards},
+		{NewStreetBuilder().Street('p', "Pre-Flop").Pocket(1).Street('f', "Flop").Board(1), 3, ErrNotEnoughCards},
+	}
+	for i, test := range tests {
+		if _, err := test.b.Build(DeckKuhn, test.max); err != test.err {
+			t.Errorf("test %d expected error %v, got: %v", i, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package cardrank
+
+import (
+	"fmt"
+)
+
+// Validate validates the pocket and board for the type, returning an error
+// when the pocket or board are not valid inputs for [Type.Eval].
+//
+// The pocket must not be empty or have more cards than the type's dealt
+// pocket, and the board must not have more cards than the type's dealt board.
+// When the board is complete, the pocket must also be complete. Partial
+// pockets are allowed for incomplete boards, as some types (ie, [Fusion])
+// deal pocket cards on later streets.
+//
+// Returns [ErrInvalidType] when the type is not registered,
+// [ErrWrongPocketSize] or [ErrWrongBoardSize] when the pocket or board have
+// the wrong number of cards, [ErrInvalidCard] or [ErrCardNotInDeck] when a
+// card is invalid or not in the type's deck, or [ErrDuplicateCard] when a card
+// is used more than once.
+func (typ Type) Validate(pocket, board []Card) error {
+	desc, ok := descs[typ]
+	if !ok {
+		return ErrInvalidType
+	}
+	if err := desc.validateBoard(board); err != nil {
+		return err
+	}
+	m := make(map[Card]bool)
+	if err := desc.validatePocket(pocket, len(board), m); err != nil {
+		return err
+	}
+	return validateCards(desc.Deck, m, board)
+}
+
+// ValidatePockets validates the pockets and board for the type, returning an
+// error when the pockets or board are not valid inputs for
+// [Type.EvalPockets]. Validates each of the pockets as with [Type.Validate],
+// and that no card is used in more than one pocket.
+//
+// Returns [ErrInvalidCount] when there are no pockets, or more pockets than
+// the type's max players. Errors for a pocket are wrapped with the pocket's
+// position.
+func (typ Type) ValidatePockets(pockets [][]Card, board []Card) error {
+	desc, ok := descs[typ]
+	if !ok {
+		return ErrInvalidType
+	}
+	if len(pockets) == 0 || desc.Max < len(pockets) {
+		return fmt.Errorf("%w %d", ErrInvalidCount, len(pockets))
+	}
+	if err := desc.validateBoard(board); err != nil {
+		return err
+	}
+	m := make(map[Card]bool)
+	for i, pocket := range pockets {
+		if err := desc.validatePocket(pocket, len(board), m); err != nil {
+			return fmt.Errorf("pocket %d: %w", i, err)
+		}
+	}
+	return validateCards(desc.Deck, m, board)
+}
+
+// validatePocket validates the pocket for a board having n cards, adding the
+// pocket's cards to m.
+func (desc TypeDesc) validatePocket(pocket []Card, n int, m map[Card]bool) error {
+	switch p := len(pocket); {
+	case p == 0, desc.pocket < p, n == desc.board && p != desc.pocket:
+		return fmt.Errorf("%w %d", ErrWrongPocketSize, p)
+	}
+	return validateCards(desc.Deck, m, pocket)
+}
+
+// validateBoard validates the board size.
+func (desc TypeDesc) validateBoard(board []Card) error {
+	if desc.board < len(board) {
+		return fmt.Errorf("%w %d", ErrWrongBoardSize, len(board))
+	}
+	return nil
+}
+
+// validateCards validates that the cards are valid, in the deck, and not
+// already in m, adding the cards to m.
+func validateCards(deck DeckType, m map[Card]bool, v []Card) error {
+	for _, c := range v {
+		switch {
+		case !c.Valid():
+			return ErrInvalidCard
+		case deck.Index(c) == -1:
+			return fmt.Errorf("%w %s", ErrCardNotInDeck, c)
+		case m[c]:
+			return fmt.Errorf("%w %s", ErrDuplicateCard, c)
+		}
+		m[c] = true
+	}
+	return nil
+}
+
+// Validate validates the calc's runs, returning an error when the runs are
+// not valid inputs for [OddsCalc.Calc]. Validates the last run's pockets and
+// boards as with [Type.ValidatePockets], skipping empty pockets for inactive
+// positions and the pockets of positions having a range (see [WithRange]),
+// and that no card is used more than once in the last run (including
+// discards, when enabled with [WithDiscard]).
+//
+// Returns [ErrInvalidCount] when there are no runs, or the last run has no
+// pockets or more pockets than the type's max players, or
+// [ErrWrongPocketSize] when a range is set for a type not having 2 card
+// pockets.
+func (c *OddsCalc) Validate() error {
+	desc, ok := descs[c.typ]
+	switch {
+	case !ok:
+		return ErrInvalidType
+	case len(c.runs) == 0:
+		return fmt.Errorf("no runs: %w", ErrInvalidCount)
+	}
+	run := c.runs[len(c.runs)-1]
+	n := len(run.Pockets)
+	for pos := range c.ranges {
+		switch {
+		case desc.pocket != 2:
+			return fmt.Errorf("range %d: %w %d", pos, ErrWrongPocketSize, desc.pocket)
+		case pos < 0:
+			return fmt.Errorf("range %d: %w", pos, ErrInvalidCount)
+		}
+		n = max(n, pos+1)
+	}
+	if n == 0 || desc.Max < n {
+		return fmt.Errorf("%w %d", ErrInvalidCount, n)
+	}
+	if err := desc.validateBoard(run.Hi); err != nil {
+		return err
+	}
+	m := make(map[Card]bool)
+	for i, pocket := range run.Pockets {
+		if _, ok := c.ranges[i]; ok || len(pocket) == 0 && !c.active.Has(i) {
+			continue
+		}
+		if err := desc.validatePocket(pocket, len(run.Hi), m); err != nil {
+			return fmt.Errorf("pocket %d: %w", i, err)
+		}
+	}
+	if err := validateCards(desc.Deck, m, run.Hi); err != nil {
+		return err
+	}
+	if desc.Double {
+		if len(run.Lo) != len(run.Hi) {
+			return fmt.Errorf("%w %d", ErrWrongBoardSize, len(run.Lo))
+		}
+		if err := validateCards(desc.Deck, m, run.Lo); err != nil {
+			return err
+		}
+	}
+	if c.discard {
+		return validateCards(desc.Deck, m, run.Discard)
+	}
+	return nil
+}
+
+// Validate validates the calc's pocket and board, returning an error when the
+// pocket or board are not valid inputs for [ExpValueCalc.Calc]. Validates the
+// pocket and board as with [Type.Validate].
+//
+// Returns [ErrInvalidCount] when there are no opponents.
+func (c *ExpValueCalc) Validate() error {
+	if c.opponents < 1 {
+		return fmt.Errorf("opponents %d: %w", c.opponents, ErrInvalidCount)
+	}
+	return c.typ.Validate(c.pocket, c.board)
+}
+
+// Validate validates the dealer's pocket count and deck, returning an error
+// when the dealer cannot deal its remaining streets.
+//
+// Returns [ErrInvalidStreet] when the dealer has no streets,
+// [ErrInvalidCount] when the pocket count is less than 1 or greater than the
+// type's max players, or [ErrNotEnoughCards] when the deck does not have
+// enough cards remaining to deal the remaining streets for every run. Drawn
+// cards are not counted, as draws may reuse discards.
+func (d *Dealer) Validate() error {
+	switch {
+	case len(d.Streets) == 0:
+		return ErrInvalidStreet
+	case d.Count < 1 || d.Max < d.Count:
+		return fmt.Errorf("%w %d", ErrInvalidCount, d.Count)
+	case d.Deck == nil:
+		return ErrNotEnoughCards
+	}
+	// cards for the remaining streets of the current run, and the streets
+	// after the run change for each remaining run
+	var count int
+	runs := d.runs - 1 - max(d.r, 0)
+	for i, street := range d.Streets {
+		n := street.PocketDiscard + d.Count*street.Pocket
+		if 0 < street.Board {
+			n += street.BoardDiscard + street.Board
+			if d.Double {
+				n += street.Board
+				if !d.SharedBurn {
+					n += street.BoardDiscard
+				}
+			}
+		}
+		if d.s < i {
+			count += n
+		}
+		if d.st < i {
+			count += runs * n
+		}
+	}
+	if n := d.Deck.Remaining(); n < count {
+		return fmt.Errorf("%w: %d < %d", ErrNotEnoughCards, n, count)
+	}
+	return nil
+}
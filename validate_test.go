@@ -0,0 +1,156 @@
+package cardrank
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestTypeValidate(t *testing.T) {
+	tests := []struct {
+		typ    Type
+		pocket string
+		board  string
+		exp    error
+	}{
+		{Holdem, "As Ks", "", nil},
+		{Holdem, "As Ks", "Qs Js Ts", nil},
+		{Holdem, "As Ks", "Qs Js Ts 9s 8s", nil},
+		{Holdem, "As", "", nil},
+		{Holdem, "As", "Qs Js Ts 9s 8s", ErrWrongPocketSize},
+		{Holdem, "", "Qs Js Ts", ErrWrongPocketSize},
+		{Holdem, "As Ks Qs", "", ErrWrongPocketSize},
+		{Holdem, "As Ks", "Qs Js Ts 9s 8s 7s", ErrWrongBoardSize},
+		{Holdem, "As Ks", "Qs Js As", ErrDuplicateCard},
+		{Holdem, "As As", "", ErrDuplicateCard},
+		{Short, "As 2s", "", ErrCardNotInDeck},
+		{Short, "As Ks", "Qs Js 5h", ErrCardNotInDeck},
+		{Omaha, "As Ks Qs Js", "2c 3c 4c", nil},
+		{Omaha, "As Ks", "2c 3c 4c 5c 6c", ErrWrongPocketSize},
+		{Stud, "As Ks Qs Js Ts 9s 8s", "", nil},
+		{Stud, "As Ks Qs Js Ts", "", ErrWrongPocketSize},
+		{Stud, "As Ks Qs Js Ts 9s 8s", "2c", ErrWrongBoardSize},
+		{Badugi, "As 2c 3h 4d", "", nil},
+		{Type('X'<<8 | 'x'), "As Ks", "", ErrInvalidType},
+	}
+	for i, test := range tests {
+		pocket, board := Must(test.pocket), Must(test.board)
+		if err := test.typ.Validate(pocket, board); !errors.Is(err, test.exp) {
+			t.Errorf("test %d %s %v %v expected %v, got: %v", i, test.typ, pocket, board, test.exp, err)
+		}
+	}
+	if err := Holdem.Validate([]Card{InvalidCard, New(Ace, Spade)}, nil); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("expected %v, got: %v", ErrInvalidCard, err)
+	}
+}
+
+func TestTypeValidatePockets(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 0))
+	for _, typ := range Types() {
+		for count := min(2, typ.Max()); count <= typ.Max(); count++ {
+			pockets, board := typ.Deal(r, 1, count)
+			if err := typ.ValidatePockets(pockets, board); err != nil {
+				t.Errorf("%s %d expected no error, got: %v", typ, count, err)
+			}
+		}
+		pockets, board := typ.Deal(r, 1, typ.Max())
+		if err := typ.ValidatePockets(append(pockets, pockets[0]), board); !errors.Is(err, ErrInvalidCount) {
+			t.Errorf("%s expected %v, got: %v", typ, ErrInvalidCount, err)
+		}
+		if 1 < len(pockets) {
+			pockets[1][0] = pockets[0][0]
+			if err := typ.ValidatePockets(pockets, board); !errors.Is(err, ErrDuplicateCard) {
+				t.Errorf("%s expected %v, got: %v", typ, ErrDuplicateCard, err)
+			}
+		}
+	}
+	if err := Holdem.ValidatePockets(nil, nil); !errors.Is(err, ErrInvalidCount) {
+		t.Errorf("expected %v, got: %v", ErrInvalidCount, err)
+	}
+}
+
+func TestOddsCalcValidate(t *testing.T) {
+	tests := []struct {
+		typ     Type
+		pockets []string
+		board   string
+		opts    []CalcOption
+		exp     error
+	}{
+		{Holdem, []string{"As Ks", "Qh Qd"}, "", nil, nil},
+		{Holdem, []string{"As Ks", "Qh Qd"}, "2c 3c 4c", nil, nil},
+		{Holdem, []string{"As Ks", "Qh Ks"}, "2c 3c 4c", nil, ErrDuplicateCard},
+		{Holdem, []string{"As Ks", "Qh"}, "2c 3c 4c 5c 6c", nil, ErrWrongPocketSize},
+		{Holdem, []string{"As Ks", ""}, "2c 3c 4c", nil, ErrWrongPocketSize},
+		{Holdem, []string{"As Ks", ""}, "2c 3c 4c", []CalcOption{WithActive(PositionsOf(0), false)}, nil},
+		{Holdem, nil, "", nil, ErrInvalidCount},
+		{Short, []string{"As Ks", "Qh 2d"}, "", nil, ErrCardNotInDeck},
+		{Holdem, []string{"", "Ks Kd"}, "Qh 7c 2d", []CalcOption{WithRange(0, Range{NewCombo(Must("As")[0], Must("Ad")[0]): 1})}, nil},
+		{Holdem, []string{"Ks Kd"}, "Qh 7c 2d", []CalcOption{WithRange(1, Range{NewCombo(Must("As")[0], Must("Ad")[0]): 1})}, nil},
+		{Holdem, []string{"Ks Kd"}, "Qh 7c 2d", []CalcOption{WithRange(-1, Range{})}, ErrInvalidCount},
+		{Omaha, []string{"", "Ks Kd Qs Qd"}, "", []CalcOption{WithRange(0, Range{})}, ErrWrongPocketSize},
+	}
+	for i, test := range tests {
+		pockets := make([][]Card, len(test.pockets))
+		for j, s := range test.pockets {
+			pockets[j] = Must(s)
+		}
+		c := NewOddsCalc(test.typ, append(test.opts, WithPocketsBoard(pockets, Must(test.board)))...)
+		if err := c.Validate(); !errors.Is(err, test.exp) {
+			t.Errorf("test %d expected %v, got: %v", i, test.exp, err)
+		}
+	}
+	if err := NewOddsCalc(Holdem).Validate(); !errors.Is(err, ErrInvalidCount) {
+		t.Errorf("expected %v, got: %v", ErrInvalidCount, err)
+	}
+	if err := NewExpValueCalc(Holdem, Must("As Ks"), WithBoard(Must("As 2c 3c"))).Validate(); !errors.Is(err, ErrDuplicateCard) {
+		t.Errorf("expected %v, got: %v", ErrDuplicateCard, err)
+	}
+	if err := NewExpValueCalc(Holdem, Must("As Ks"), WithOpponents(0)).Validate(); !errors.Is(err, ErrInvalidCount) {
+		t.Errorf("expected %v, got: %v", ErrInvalidCount, err)
+	}
+}
+
+func TestDealerValidate(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 0))
+	for _, typ := range Types() {
+		d := typ.Dealer(r, 1, typ.Max())
+		if err := d.Validate(); err != nil {
+			t.Errorf("%s expected no error, got: %v", typ, err)
+		}
+		for d.Next() {
+			if err := d.Validate(); err != nil {
+				t.Errorf("%s street %d expected no error, got: %v", typ, d.Street(), err)
+			}
+		}
+		if err := typ.Dealer(r, 1, typ.Max()+1).Validate(); !errors.Is(err, ErrInvalidCount) {
+			t.Errorf("%s expected %v, got: %v", typ, ErrInvalidCount, err)
+		}
+		if err := typ.Dealer(r, 1, 0).Validate(); !errors.Is(err, ErrInvalidCount) {
+			t.Errorf("%s expected %v, got: %v", typ, ErrInvalidCount, err)
+		}
+	}
+	// not enough cards
+	d := NewDealer(Holdem.Desc(), DeckOf(Must("As Ks Qs Js Ts 9s 8s 7s 6s 5s")...), 2)
+	if err := d.Validate(); !errors.Is(err, ErrNotEnoughCards) {
+		t.Errorf("expected %v, got: %v", ErrNotEnoughCards, err)
+	}
+	// run change
+	d = Holdem.Dealer(r, 1, 10)
+	for d.Next() {
+		if d.Id() == 'f' {
+			break
+		}
+	}
+	if !d.ChangeRuns(3) {
+		t.Fatalf("expected run change")
+	}
+	if err := d.Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	// 24 cards dealt, with 2 streets of 2 cards remaining for 3 runs
+	d.Deck.Limit(24 + 11)
+	if err := d.Validate(); !errors.Is(err, ErrNotEnoughCards) {
+		t.Errorf("expected %v, got: %v", ErrNotEnoughCards, err)
+	}
+}
@@ -0,0 +1,183 @@
+package cardrank
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// TestVector is a reference eval test vector, the expected ranks and
+// descriptions for a type's pocket and board. Test vectors can be used to
+// validate ports of the package, or regenerated lookup tables.
+type TestVector struct {
+	// Type is the type.
+	Type Type
+	// Pocket is the pocket.
+	Pocket []Card
+	// Board is the board.
+	Board []Card
+	// HiRank is the expected Hi rank.
+	HiRank EvalRank
+	// HiDesc is the expected Hi description.
+	HiDesc string
+	// LoRank is the expected Lo rank.
+	LoRank EvalRank
+	// LoDesc is the expected Lo description, for types having a Lo.
+	LoDesc string
+}
+
+// NewTestVectors generates count deterministic test vectors for each of the
+// types (or all registered types when none are provided) from the seed. Each
+// type's hands are dealt from a deck shuffled by a PCG source seeded by the
+// seed and the type, so that vectors for a type do not depend on the other
+// types.
+func NewTestVectors(seed uint64, count int, types ...Type) []TestVector {
+	if len(types) == 0 {
+		types = Types()
+	}
+	var v []TestVector
+	for _, typ := range types {
+		r := rand.New(rand.NewPCG(seed, uint64(typ)))
+		pocket, board := typ.Pocket(), typ.Board()
+		if typ.DeckType().Len() < pocket+board {
+			continue
+		}
+		for range count {
+			d := typ.DeckType().Shuffle(r, 1)
+			v = append(v, newTestVector(typ, d.Draw(pocket), d.Draw(board)))
+		}
+	}
+	return v
+}
+
+// newTestVector creates a test vector for the type, pocket, and board.
+func newTestVector(typ Type, pocket, board []Card) TestVector {
+	ev := typ.Eval(pocket, board)
+	vec := TestVector{
+		Type:   typ,
+		Pocket: pocket,
+		Board:  board,
+		HiRank: ev.HiRank,
+		HiDesc: fmt.Sprintf("%s", ev.Desc(false)),
+		LoRank: ev.LoRank,
+	}
+	if typ.Low() || typ.Double() {
+		vec.LoDesc = fmt.Sprintf("%s", ev.Desc(true))
+	}
+	return vec
+}
+
+// Verify evaluates the test vector's pocket and board, returning an error
+// when the ranks or descriptions do not match. Descriptions are formatted
+// with the package translator, so test vectors should be generated and
+// verified without a translator set (see [SetTranslator]).
+func (vec TestVector) Verify() error {
+	if _, ok := descs[vec.Type]; !ok {
+		return ErrInvalidType
+	}
+	exp := newTestVector(vec.Type, vec.Pocket, vec.Board)
+	switch {
+	case exp.HiRank != vec.HiRank, exp.HiDesc != vec.HiDesc:
+		return fmt.Errorf("%s %s %s: expected hi %d %q, got: %d %q", vec.Type, vec.Pocket, vec.Board, vec.HiRank, vec.HiDesc, exp.HiRank, exp.HiDesc)
+	case exp.LoRank != vec.LoRank, exp.LoDesc != vec.LoDesc:
+		return fmt.Errorf("%s %s %s: expected lo %d %q, got: %d %q", vec.Type, vec.Pocket, vec.Board, vec.LoRank, vec.LoDesc, exp.LoRank, exp.LoDesc)
+	}
+	return nil
+}
+
+// WriteTestVectors writes the test vectors to w as CSV, with a header.
+func WriteTestVectors(w io.Writer, vectors []TestVector) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"type", "pocket", "board", "hi", "hi_desc", "lo", "lo_desc"}); err != nil {
+		return err
+	}
+	for _, vec := range vectors {
+		if err := cw.Write([]string{
+			vec.Type.Id(),
+			joinCards(vec.Pocket),
+			joinCards(vec.Board),
+			strconv.Itoa(int(vec.HiRank)),
+			vec.HiDesc,
+			strconv.Itoa(int(vec.LoRank)),
+			vec.LoDesc,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadTestVectors reads test vectors written by [WriteTestVectors] from r.
+func ReadTestVectors(r io.Reader) ([]TestVector, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 7
+	records, err := cr.ReadAll()
+	switch {
+	case err != nil:
+		return nil, err
+	case len(records) == 0:
+		return nil, ErrInvalidData
+	}
+	var v []TestVector
+	for i, rec := range records[1:] {
+		typ, err := IdToType(rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+		pocket, err := Parse(rec[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+		board, err := Parse(rec[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, err)
+		}
+		hi, err := strconv.ParseUint(rec[3], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, ErrInvalidData)
+		}
+		lo, err := strconv.ParseUint(rec[5], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+2, ErrInvalidData)
+		}
+		v = append(v, TestVector{
+			Type:   typ,
+			Pocket: pocket,
+			Board:  board,
+			HiRank: EvalRank(hi),
+			HiDesc: rec[4],
+			LoRank: EvalRank(lo),
+			LoDesc: rec[6],
+		})
+	}
+	return v, nil
+}
+
+// VerifyTestVectors reads test vectors written by [WriteTestVectors] from r,
+// verifying each, and returning the number of vectors verified and the first
+// error encountered.
+func VerifyTestVectors(r io.Reader) (int, error) {
+	vectors, err := ReadTestVectors(r)
+	if err != nil {
+		return 0, err
+	}
+	for i, vec := range vectors {
+		if err := vec.Verify(); err != nil {
+			return i, fmt.Errorf("vector %d: %w", i, err)
+		}
+	}
+	return len(vectors), nil
+}
+
+// joinCards joins the cards with a space.
+func joinCards(v []Card) string {
+	s := make([]string, len(v))
+	for i, c := range v {
+		s[i] = c.String()
+	}
+	return strings.Join(s, " ")
+}
@@ -0,0 +1,66 @@
+package cardrank
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTestVectors(t *testing.T) {
+	v := NewTestVectors(1, 5)
+	if n, exp := len(v), 5*len(Types()); n != exp {
+		t.Errorf("expected %d vectors, got: %d", exp, n)
+	}
+	if u := NewTestVectors(1, 5); !reflect.DeepEqual(u, v) {
+		t.Errorf("expected deterministic vectors")
+	}
+	buf := new(bytes.Buffer)
+	if err := WriteTestVectors(buf, v); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	u, err := ReadTestVectors(bytes.NewReader(buf.Bytes()))
+	switch {
+	case err != nil:
+		t.Fatalf("expected no error, got: %v", err)
+	case len(u) != len(v):
+		t.Fatalf("expected %d vectors, got: %d", len(v), len(u))
+	}
+	for i := range v {
+		if err := u[i].Verify(); err != nil {
+			t.Errorf("vector %d expected no error, got: %v", i, err)
+		}
+	}
+	// mismatch
+	u[3].HiRank++
+	buf.Reset()
+	if err := WriteTestVectors(buf, u); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if n, err := VerifyTestVectors(buf); n != 3 || err == nil {
+		t.Errorf("expected mismatch at vector 3, got: %d %v", n, err)
+	}
+}
+
+func TestVerifyTestVectors(t *testing.T) {
+	if s := os.Getenv("TESTS"); strings.Contains(s, "vectors") {
+		buf := new(bytes.Buffer)
+		if err := WriteTestVectors(buf, NewTestVectors(1, 50)); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if err := os.WriteFile("testdata/vectors.csv", buf.Bytes(), 0o644); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+	}
+	f, err := os.Open("testdata/vectors.csv")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer f.Close()
+	n, err := VerifyTestVectors(f)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	t.Logf("verified %d vectors", n)
+}
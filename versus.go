@@ -0,0 +1,153 @@
+package cardrank
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Versus is the breakdown of every opponent pocket that currently beats,
+// ties, or loses to a hero's hand on a board (ie, "what beats me"), given
+// card removal.
+type Versus struct {
+	// Type is the type.
+	Type Type
+	// Pocket is the hero's pocket.
+	Pocket []Card
+	// Board is the board.
+	Board []Card
+	// Beat are the opponent pockets that beat the hero.
+	Beat []VersusGroup
+	// Tie are the opponent pockets that tie the hero.
+	Tie []VersusGroup
+	// Lose are the opponent pockets that lose to the hero.
+	Lose []VersusGroup
+}
+
+// VersusGroup is a group of opponent pockets having the same Hi category.
+type VersusGroup struct {
+	// Desc is the category description (ie, Flush, Two Pair, 8-Low).
+	Desc string
+	// Rank is the best Hi rank in the group.
+	Rank EvalRank
+	// Pockets are the opponent pockets, ordered best to worst.
+	Pockets [][]Card
+}
+
+// NewVersus enumerates every opponent pocket not containing the hero's
+// pocket, the board, or any dead cards, grouping them by whether they beat,
+// tie, or lose to the hero's Hi, and by the category of the opponent's Hi.
+// Groups are ordered best to worst.
+//
+// Returns [ErrInvalidType] when the type does not have a board,
+// [ErrInvalidPocket] or [ErrInvalidBoard] when the pocket or board length is
+// not valid for the type (the board must have at least 3 cards), or
+// [ErrDuplicateCard] when a card is used more than once.
+func NewVersus(typ Type, pocket, board []Card, dead ...[]Card) (*Versus, error) {
+	if _, ok := descs[typ]; !ok || typ.Board() == 0 {
+		return nil, ErrInvalidType
+	}
+	deck := typ.DeckType()
+	switch {
+	case len(pocket) != typ.Pocket() || !inDeck(deck, pocket):
+		return nil, ErrInvalidPocket
+	case len(board) < 3 || typ.Board() < len(board) || !inDeck(deck, board):
+		return nil, ErrInvalidBoard
+	}
+	m := deadMap(dead...)
+	for _, c := range slices.Concat(pocket, board) {
+		if m[c] {
+			return nil, ErrDuplicateCard
+		}
+		m[c] = true
+	}
+	v := &Versus{
+		Type:   typ,
+		Pocket: slices.Clone(pocket),
+		Board:  slices.Clone(board),
+	}
+	hero, opp := EvalOf(typ), EvalOf(typ)
+	hero.Eval(pocket, board)
+	type entry struct {
+		pocket []Card
+		rank   EvalRank
+		desc   string
+	}
+	var beat, tie, lose []entry
+	u := deck.Exclude(append(dead, pocket, board)...)
+	for g, p := NewCombinGen(u, len(pocket)); g.Next(); {
+		opp.Reset(typ)
+		opp.Eval(p, board)
+		e := entry{
+			pocket: slices.Clone(p),
+			rank:   opp.HiRank,
+			desc:   fmt.Sprintf("%e", opp.Desc(false)),
+		}
+		switch c := hero.Comp(opp, false); {
+		case 0 < c:
+			beat = append(beat, e)
+		case c == 0:
+			tie = append(tie, e)
+		default:
+			lose = append(lose, e)
+		}
+	}
+	group := func(entries []entry) []VersusGroup {
+		slices.SortStableFunc(entries, func(a, b entry) int {
+			return int(a.rank) - int(b.rank)
+		})
+		var groups []VersusGroup
+		for _, e := range entries {
+			if n := len(groups); n == 0 || groups[n-1].Desc != e.desc {
+				groups = append(groups, VersusGroup{
+					Desc: e.desc,
+					Rank: e.rank,
+				})
+			}
+			groups[len(groups)-1].Pockets = append(groups[len(groups)-1].Pockets, e.pocket)
+		}
+		return groups
+	}
+	v.Beat, v.Tie, v.Lose = group(beat), group(tie), group(lose)
+	return v, nil
+}
+
+// Counts returns the number of opponent pockets that beat, tie, or lose to
+// the hero.
+func (v *Versus) Counts() (int, int, int) {
+	count := func(groups []VersusGroup) int {
+		var n int
+		for _, g := range groups {
+			n += len(g.Pockets)
+		}
+		return n
+	}
+	return count(v.Beat), count(v.Tie), count(v.Lose)
+}
+
+// Format satisfies the [fmt.Formatter] interface.
+//
+// Supported verbs:
+//
+//	s - opponent pocket counts for each group
+//	v - same as s
+func (v *Versus) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'v':
+		beat, tie, lose := v.Counts()
+		fmt.Fprintf(f, "%s %s (beat: %d, tie: %d, lose: %d)", v.Pocket, v.Board, beat, tie, lose)
+		for _, s := range []struct {
+			name   string
+			groups []VersusGroup
+		}{
+			{"beat", v.Beat},
+			{"tie", v.Tie},
+			{"lose", v.Lose},
+		} {
+			for _, g := range s.groups {
+				fmt.Fprintf(f, "\n%s: %s (%d)", s.name, g.Desc, len(g.Pockets))
+			}
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(ERROR=unknown verb, versus)", verb)
+	}
+}
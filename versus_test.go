@@ -0,0 +1,86 @@
+package cardrank
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewVersus(t *testing.T) {
+	v, err := NewVersus(Holdem, Must("As Ad"), Must("Ks Kd 7c 2h 3c"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	beat, tie, lose := v.Counts()
+	if beat != 96 || tie != 1 || lose != 893 {
+		t.Errorf("expected 96/1/893, got: %d/%d/%d", beat, tie, lose)
+	}
+	var groups []string
+	for _, g := range v.Beat {
+		groups = append(groups, fmt.Sprintf("%s (%d)", g.Desc, len(g.Pockets)))
+	}
+	if s, exp := fmt.Sprintf("%v", groups), "[Four of a Kind (1) Full House (27) Three of a Kind (68)]"; s != exp {
+		t.Errorf("expected %s, got: %s", exp, s)
+	}
+	if len(v.Tie) != 1 || v.Tie[0].Desc != "Two Pair" || fmt.Sprintf("%s", v.Tie[0].Pockets) != "[[Ah Ac]]" {
+		t.Errorf("expected tie Two Pair [[Ah Ac]], got: %v", v.Tie)
+	}
+	hero := Holdem.Eval(v.Pocket, v.Board)
+	for _, test := range []struct {
+		groups []VersusGroup
+		exp    int
+	}{
+		{v.Beat, +1},
+		{v.Tie, 0},
+		{v.Lose, -1},
+	} {
+		for _, g := range test.groups {
+			for i, p := range g.Pockets {
+				opp := Holdem.Eval(p, v.Board)
+				if c := hero.Comp(opp, false); c != test.exp {
+					t.Errorf("%s expected comp %d, got: %d", p, test.exp, c)
+				}
+				if i == 0 && opp.HiRank != g.Rank {
+					t.Errorf("%s expected rank %d, got: %d", g.Desc, g.Rank, opp.HiRank)
+				}
+			}
+		}
+	}
+	// dead cards
+	v, err = NewVersus(Holdem, Must("As Ad"), Must("Ks Kd 7c 2h 3c"), Must("Kc Kh"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if beat, tie, lose := v.Counts(); beat != 9 || beat+tie+lose != 903 {
+		t.Errorf("expected 9 beat of 903, got: %d/%d/%d", beat, tie, lose)
+	}
+	// omaha
+	v, err = NewVersus(Omaha, Must("Ah Kh 2c 3d"), Must("Qh Jh 9s"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if beat, tie, lose := v.Counts(); beat+tie+lose != 148995 {
+		t.Errorf("expected 148995 pockets, got: %d/%d/%d", beat, tie, lose)
+	}
+}
+
+func TestNewVersusErrors(t *testing.T) {
+	tests := []struct {
+		typ    Type
+		pocket string
+		board  string
+		dead   string
+		err    error
+	}{
+		{Stud, "As Ad Kc Kh 2c 3d 4s", "", "", ErrInvalidType},
+		{Holdem, "As", "Ks Kd 7c", "", ErrInvalidPocket},
+		{Holdem, "As Ad", "Ks Kd", "", ErrInvalidBoard},
+		{Short, "As Ad", "Ks Kd 2c", "", ErrInvalidBoard},
+		{Holdem, "As Ad", "Ks Kd As", "", ErrDuplicateCard},
+		{Holdem, "As Ad", "Ks Kd 7c", "7c", ErrDuplicateCard},
+	}
+	for i, test := range tests {
+		if _, err := NewVersus(test.typ, Must(test.pocket), Must(test.board), Must(test.dead)); err != test.err {
+			t.Errorf("test %d expected error %v, got: %v", i, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// TypeScript definitions for the cardrank wasm bindings.
+
+/** An error result. */
+export interface CardrankError {
+  error: string;
+}
+
+/** A Hi or Lo eval description. */
+export interface EvalDesc {
+  /** The eval rank (lower is better). */
+  rank: number;
+  /** The description (ex: "Four of a Kind, Nines, kicker Jack"). */
+  desc: string;
+  /** The best cards (ex: ["9s", "9h", "9d", "9c", "Js"]). */
+  best: string[];
+  /** The unused cards. */
+  unused: string[];
+}
+
+/** An eval result. */
+export interface EvalResult {
+  hi: EvalDesc;
+  /** Set for Hi/Lo and double board types. */
+  lo?: EvalDesc;
+}
+
+/** Odds for each pocket. */
+export interface Odds {
+  /** The total number of outcomes. */
+  total: number;
+  /** Each pocket's win and split count. */
+  counts: number[];
+  /** Each pocket's odds, as a percent. */
+  percents: number[];
+}
+
+/** An odds result. */
+export interface OddsResult {
+  hi: Odds;
+  /** Set for Hi/Lo types. */
+  lo?: Odds;
+}
+
+/** A deal result. */
+export interface DealResult {
+  pockets: string[][];
+  board: string[];
+}
+
+/** The cardrank API, defined globally once the wasm module is running. */
+export interface Cardrank {
+  /**
+   * Evaluates the pocket and board for the type, specified by id ("Hh") or
+   * name ("Holdem").
+   */
+  eval(type: string, pocket: string, board: string): EvalResult | CardrankError;
+  /** Calculates the odds for the pockets and board for the type. */
+  odds(type: string, pockets: string[], board: string): OddsResult | CardrankError;
+  /** Deals count pockets and a board for the type, using seed. */
+  deal(type: string, count: number, seed: number): DealResult | CardrankError;
+}
+
+declare global {
+  // eslint-disable-next-line no-var
+  var cardrank: Cardrank;
+}
@@ -0,0 +1,185 @@
+//go:build js && wasm
+
+// Command wasm exposes a small JavaScript API for the cardrank package, for use
+// by browser poker clients.
+//
+// Build with:
+//
+//	GOOS=js GOARCH=wasm go build -o cardrank.wasm ./wasm
+//
+// and load using the wasm_exec.js support file distributed with Go. Once
+// loaded, a global cardrank object is defined with the following functions
+// (see cardrank.d.ts for the TypeScript definitions):
+//
+//	cardrank.eval(type, pocket, board)
+//	cardrank.odds(type, pockets, board)
+//	cardrank.deal(type, count, seed)
+//
+// Types may be specified by either id ("Hh") or name ("Holdem"), and cards are
+// parsed using [cardrank.Parse].
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"syscall/js"
+
+	"github.com/cardrank/cardrank"
+)
+
+func main() {
+	js.Global().Set("cardrank", js.ValueOf(map[string]any{
+		"eval": js.FuncOf(evalFunc),
+		"odds": js.FuncOf(oddsFunc),
+		"deal": js.FuncOf(dealFunc),
+	}))
+	select {}
+}
+
+// evalFunc evaluates a pocket and board.
+func evalFunc(_ js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return errorf("eval: expected 3 arguments, got: %d", len(args))
+	}
+	typ, err := parseType(args[0])
+	if err != nil {
+		return errorf("eval: %v", err)
+	}
+	pocket, err := cardrank.Parse(args[1].String())
+	if err != nil {
+		return errorf("eval: pocket: %v", err)
+	}
+	board, err := cardrank.Parse(args[2].String())
+	if err != nil {
+		return errorf("eval: board: %v", err)
+	}
+	if err := typ.Validate(pocket, board); err != nil {
+		return errorf("eval: %v", err)
+	}
+	ev := typ.Eval(pocket, board)
+	res := map[string]any{
+		"hi": descOf(ev, false),
+	}
+	if typ.Low() || typ.Double() {
+		res["lo"] = descOf(ev, true)
+	}
+	return res
+}
+
+// oddsFunc calculates the odds for pockets and a board.
+func oddsFunc(_ js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return errorf("odds: expected 3 arguments, got: %d", len(args))
+	}
+	typ, err := parseType(args[0])
+	if err != nil {
+		return errorf("odds: %v", err)
+	}
+	pockets := make([][]cardrank.Card, args[1].Length())
+	for i := range pockets {
+		if pockets[i], err = cardrank.Parse(args[1].Index(i).String()); err != nil {
+			return errorf("odds: pocket %d: %v", i, err)
+		}
+	}
+	board, err := cardrank.Parse(args[2].String())
+	if err != nil {
+		return errorf("odds: board: %v", err)
+	}
+	if err := typ.ValidatePockets(pockets, board); err != nil {
+		return errorf("odds: %v", err)
+	}
+	hi, lo, ok := typ.Odds(context.Background(), pockets, board)
+	if !ok {
+		return errorf("odds: unable to calculate odds")
+	}
+	res := map[string]any{
+		"hi": oddsOf(hi),
+	}
+	if lo != nil {
+		res["lo"] = oddsOf(lo)
+	}
+	return res
+}
+
+// dealFunc deals pockets and a board.
+func dealFunc(_ js.Value, args []js.Value) any {
+	if len(args) != 3 {
+		return errorf("deal: expected 3 arguments, got: %d", len(args))
+	}
+	typ, err := parseType(args[0])
+	if err != nil {
+		return errorf("deal: %v", err)
+	}
+	count := args[1].Int()
+	if count < 1 || typ.Max() < count {
+		return errorf("deal: invalid count %d", count)
+	}
+	seed := uint64(args[2].Float())
+	r := rand.New(rand.NewPCG(seed, seed))
+	pockets, board := typ.Deal(r, 1, count)
+	v := make([]any, len(pockets))
+	for i, pocket := range pockets {
+		v[i] = cards(pocket)
+	}
+	return map[string]any{
+		"pockets": v,
+		"board":   cards(board),
+	}
+}
+
+// parseType parses the type from v.
+func parseType(v js.Value) (cardrank.Type, error) {
+	var typ cardrank.Type
+	if err := typ.UnmarshalText([]byte(v.String())); err != nil {
+		return 0, err
+	}
+	return typ, nil
+}
+
+// descOf returns the description for the eval.
+func descOf(ev *cardrank.Eval, low bool) map[string]any {
+	rank, best, unused := ev.HiRank, ev.HiBest, ev.HiUnused
+	if low {
+		rank, best, unused = ev.LoRank, ev.LoBest, ev.LoUnused
+	}
+	return map[string]any{
+		"rank":   int(rank),
+		"desc":   fmt.Sprintf("%s", ev.Desc(low)),
+		"best":   cards(best),
+		"unused": cards(unused),
+	}
+}
+
+// oddsOf returns the odds.
+func oddsOf(odds *cardrank.Odds) map[string]any {
+	counts, percents := make([]any, len(odds.Counts)), make([]any, len(odds.Counts))
+	outright, chop := make([]any, len(odds.Counts)), make([]any, len(odds.Counts))
+	for i, n := range odds.Counts {
+		counts[i], percents[i] = n, float64(odds.Percent(i))
+		outright[i], chop[i] = odds.Outright(i), odds.AtLeastChop(i)
+	}
+	return map[string]any{
+		"total":    odds.Total,
+		"counts":   counts,
+		"percents": percents,
+		"outright": outright,
+		"chop":     chop,
+	}
+}
+
+// cards returns the cards as strings.
+func cards(v []cardrank.Card) []any {
+	s := make([]any, len(v))
+	for i, c := range v {
+		s[i] = c.String()
+	}
+	return s
+}
+
+// errorf returns an error result.
+func errorf(s string, v ...any) map[string]any {
+	return map[string]any{
+		"error": fmt.Sprintf(s, v...),
+	}
+}
@@ -4,7 +4,4 @@ package cardrank
 
 func init() {
 	Init()
-	if err := RegisterDefaultTypes(); err != nil {
-		panic(err)
-	}
 }